@@ -13,6 +13,9 @@ go install -mod=readonly github.com/golang/protobuf/protoc-gen-go
 rm -rf controller/gen/common controller/gen/config controller/gen/controller controller/gen/public
 
 gen proto/common/healthcheck.proto \
+    proto/controller/identity.proto \
+    proto/controller/policy.proto \
+    proto/controller/signer.proto \
     proto/controller/tap.proto \
     proto/public.proto \
     proto/config/config.proto
@@ -20,10 +23,16 @@ gen proto/common/healthcheck.proto \
 # TODO: Re-organize the top-level /proto directory to mirror output packages.
 # As a work-around, manually move files after generation.
 mkdir -p controller/gen/common/healthcheck
+mkdir -p controller/gen/controller/identity
+mkdir -p controller/gen/controller/policy
+mkdir -p controller/gen/controller/signer
 mkdir -p controller/gen/controller/tap
 mkdir -p controller/gen/public
 
 mv controller/gen/common/healthcheck.pb.go   controller/gen/common/healthcheck/
+mv controller/gen/controller/identity.pb.go  controller/gen/controller/identity/
+mv controller/gen/controller/policy.pb.go    controller/gen/controller/policy/
+mv controller/gen/controller/signer.pb.go    controller/gen/controller/signer/
 mv controller/gen/controller/tap.pb.go       controller/gen/controller/tap/
 mv controller/gen/public.pb.go               controller/gen/public/
 
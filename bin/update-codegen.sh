@@ -4,18 +4,22 @@ set -eu
 
 GO111MODULE=on go mod vendor
 git clone https://github.com/kubernetes/code-generator.git vendor/k8s.io/code-generator
-# check out code-generator at the revision Gopkg.lock had previously referenced
-git -C vendor/k8s.io/code-generator checkout aae79feb89bdded3679da91fd8c19b6dfcbdb79a
+# check out code-generator at the version matching the client-go dependency
+git -C vendor/k8s.io/code-generator checkout v0.17.0
 
 # ROOT_PACKAGE :: the package that is the target for code generation
 ROOT_PACKAGE=github.com/linkerd/linkerd2
-# CUSTOM_RESOURCE_NAME :: the name of the custom resource that we're generating client code for
-CUSTOM_RESOURCE_NAME=serviceprofile
-# CUSTOM_RESOURCE_VERSION :: the version of the resource
-CUSTOM_RESOURCE_VERSION=v1alpha2
+# GROUPS_WITH_VERSIONS :: the custom resources that we're generating client
+# code for, along with their version
+GROUPS_WITH_VERSIONS="serviceprofile:v1alpha2 serverauthorization:v1alpha1 proxyconfig:v1alpha1"
 
 bindir=$( cd "${0%/*}" && pwd )
 rootdir=$( cd "$bindir"/.. && pwd )
 
 # run the code-generator entrypoint script
-"$rootdir"/vendor/k8s.io/code-generator/generate-groups.sh all "$ROOT_PACKAGE/controller/gen/client" "$ROOT_PACKAGE/controller/gen/apis" "$CUSTOM_RESOURCE_NAME:$CUSTOM_RESOURCE_VERSION"
+"$rootdir"/vendor/k8s.io/code-generator/generate-groups.sh all "$ROOT_PACKAGE/controller/gen/client" "$ROOT_PACKAGE/controller/gen/apis" "$GROUPS_WITH_VERSIONS"
+
+# the SMI TrafficSplit client is generated here against the SMI SDK's API
+# types, as the SMI SDK's own client relies on the DirectCodecFactory that
+# apimachinery v0.17 removes
+"$rootdir"/vendor/k8s.io/code-generator/generate-groups.sh client,lister,informer "$ROOT_PACKAGE/controller/gen/client/split" "github.com/deislabs/smi-sdk-go/pkg/apis" "split:v1alpha1"
@@ -7,7 +7,16 @@
     "trustDomain": "{{.Values.global.identityTrustDomain}}",
     "trustAnchorsPem": "{{required "Please provide the identity trust anchors" .Values.global.identityTrustAnchorsPEM | trim | replace "\n" "\\n"}}",
     "issuanceLifetime": "{{.Values.identity.issuer.issuanceLifetime}}",
+    {{- if .Values.identity.issuer.maxIssuanceLifetime }}
+    "maxIssuanceLifetime": "{{.Values.identity.issuer.maxIssuanceLifetime}}",
+    {{- end }}
     "clockSkewAllowance": "{{.Values.identity.issuer.clockSkewAllowance}}",
+    {{- if .Values.global.identityTokenAudience }}
+    "tokenAudience": "{{.Values.global.identityTokenAudience}}",
+    {{- end }}
+    {{- if .Values.identity.spiffeIdentities }}
+    "spiffeIdentities": true,
+    {{- end }}
     "scheme": "{{.Values.identity.issuer.scheme}}"
   },
   "autoInjectContext": null,
@@ -62,6 +71,9 @@
     "pullPolicy":"{{.Values.debugContainer.image.pullPolicy}}"
   },
   "debugImageVersion": "{{.Values.debugContainer.image.version}}"
+  {{- if .Values.proxyInjector.injectionPolicy }},
+  "injectionPolicy": {{ toJson .Values.proxyInjector.injectionPolicy }}
+  {{- end }}
 }
 {{- end -}}
 
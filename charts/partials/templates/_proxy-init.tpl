@@ -7,7 +7,11 @@ args:
 - --proxy-uid
 - {{.Values.global.proxy.uid | quote}}
 - --inbound-ports-to-ignore
+{{- if .Values.global.proxyInit.outboundOnly }}
+- "1-65535"
+{{- else }}
 - {{.Values.global.proxy.ports.control}},{{.Values.global.proxy.ports.admin}}{{ternary (printf ",%s" .Values.global.proxyInit.ignoreInboundPorts) "" (not (empty .Values.global.proxyInit.ignoreInboundPorts)) }}
+{{- end }}
 {{- if hasPrefix "linkerd-" .Values.global.proxy.component }}
 - --outbound-ports-to-ignore
 - {{ternary (printf "443,%s" .Values.global.proxyInit.ignoreOutboundPorts) (quote "443") (not (empty .Values.global.proxyInit.ignoreOutboundPorts)) }}
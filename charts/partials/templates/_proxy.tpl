@@ -26,8 +26,12 @@ env:
   valueFrom:
     fieldRef:
       fieldPath: metadata.namespace
+- name: _pod_nodeName
+  valueFrom:
+    fieldRef:
+      fieldPath: spec.nodeName
 - name: LINKERD2_PROXY_DESTINATION_CONTEXT
-  value: ns:$(_pod_ns)
+  value: ns:$(_pod_ns),node:$(_pod_nodeName)
 {{ if eq .Values.global.proxy.component "linkerd-prometheus" -}}
 - name: LINKERD2_PROXY_OUTBOUND_ROUTER_CAPACITY
   value: "10000"
@@ -42,7 +46,11 @@ env:
   value: |
   {{- required "Please provide the identity trust anchors" .Values.global.identityTrustAnchorsPEM | trim | nindent 4 }}
 - name: LINKERD2_PROXY_IDENTITY_TOKEN_FILE
+  {{- if .Values.global.identityTokenAudience }}
+  value: /var/run/linkerd/identity/token/token
+  {{- else }}
   value: /var/run/secrets/kubernetes.io/serviceaccount/token
+  {{- end }}
 - name: LINKERD2_PROXY_IDENTITY_SVC_ADDR
   {{- $identitySvcAddr := printf "linkerd-identity.%s.svc.%s:8080" .Values.global.namespace .Values.global.clusterDomain }}
   value: {{ternary "localhost.:8080" $identitySvcAddr (eq .Values.global.proxy.component "linkerd-identity")}}
@@ -81,6 +89,12 @@ env:
   value: {{ .Values.global.proxy.trace.collectorSvcAccount }}.serviceaccount.identity.$(_l5d_ns).$(_l5d_trustdomain)
 {{ end -}}
 {{ end -}}
+{{ if .Values.global.proxy.shutdownContainers -}}
+- name: LINKERD2_PROXY_SHUTDOWN_SIGNAL_DIR
+  value: /var/run/linkerd/shutdown
+- name: LINKERD2_PROXY_SHUTDOWN_CONTAINERS
+  value: {{ join " " .Values.global.proxy.shutdownContainers | quote }}
+{{ end -}}
 image: {{.Values.global.proxy.image.name}}:{{.Values.global.proxy.image.version}}
 imagePullPolicy: {{.Values.global.proxy.image.pullPolicy}}
 livenessProbe:
@@ -119,16 +133,26 @@ lifecycle:
         - -c
         - sleep {{.Values.global.proxy.waitBeforeExitSeconds}}
 {{- end }}
-{{- if or (not .Values.global.proxy.disableIdentity) (.Values.global.proxy.saMountPath) }}
+{{- if or (not .Values.global.proxy.disableIdentity) (.Values.global.proxy.saMountPath) (.Values.global.proxy.shutdownContainers) }}
 volumeMounts:
 {{- if not .Values.global.proxy.disableIdentity }}
 - mountPath: /var/run/linkerd/identity/end-entity
   name: linkerd-identity-end-entity
+{{- if .Values.global.identityTokenAudience }}
+- mountPath: /var/run/linkerd/identity/token
+  name: linkerd-identity-token
+  readOnly: true
+{{- end -}}
 {{- end -}}
 {{- if .Values.global.proxy.saMountPath }}
 - mountPath: {{.Values.global.proxy.saMountPath.mountPath}}
   name: {{.Values.global.proxy.saMountPath.name}}
   readOnly: {{.Values.global.proxy.saMountPath.readOnly}}
 {{- end -}}
+{{- if .Values.global.proxy.shutdownContainers }}
+- mountPath: /var/run/linkerd/shutdown
+  name: linkerd-shutdown
+  readOnly: true
+{{- end -}}
 {{- end -}}
 {{- end }}
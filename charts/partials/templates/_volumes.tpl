@@ -3,3 +3,12 @@ emptyDir:
   medium: Memory
 name: linkerd-identity-end-entity
 {{- end -}}
+
+{{ define "partials.proxy.volumes.identity-token" -}}
+name: linkerd-identity-token
+projected:
+  sources:
+  - serviceAccountToken:
+      audience: {{.Values.global.identityTokenAudience}}
+      path: token
+{{- end -}}
@@ -185,6 +185,9 @@ func configureAndRunChecks(wout io.Writer, werr io.Writer, stage string, options
 			}
 			checks = append(checks, healthcheck.LinkerdCNIPluginChecks)
 			checks = append(checks, healthcheck.LinkerdHAChecks)
+			checks = append(checks, healthcheck.LinkerdMulticlusterChecks)
+			checks = append(checks, healthcheck.LinkerdPolicyChecks)
+			checks = append(checks, healthcheck.LinkerdControlPlaneMTLSChecks)
 		}
 	}
 
@@ -223,5 +223,9 @@ func generateAnnotationsDocs() []annotationDoc {
 			Name:        k8s.ProxyWaitBeforeExitSecondsAnnotation,
 			Description: "The proxy sidecar will stay alive for at least the given period before receiving SIGTERM signal from Kubernetes but no longer than pod's `terminationGracePeriodSeconds`. If not provided, it will be defaulted to `0`",
 		},
+		{
+			Name:        k8s.ProxyShutdownCoordinationAnnotation,
+			Description: "Only for Jobs and CronJobs. When set to `true`, the proxy exits once the containers complete, so that the pods can complete. Every container must set a `command`, as the image entrypoints aren't known at injection time; otherwise the coordination is skipped, which is reported by `linkerd inject` and by a warning event",
+		},
 	}
 }
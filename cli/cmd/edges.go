@@ -12,6 +12,9 @@ import (
 
 	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/spf13/cobra"
 )
 
@@ -104,7 +107,18 @@ func newCmdEdges() *cobra.Command {
 				}
 			}
 
-			output := renderEdgeStats(totalRows, options)
+			// the edges are still displayed when the deny list can't be read,
+			// e.g. because of the RBAC of the current user
+			var denyList *identity.DenyList
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err == nil {
+				denyList, err = healthcheck.FetchIdentityDenyList(k8sAPI, controlPlaneNamespace)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Unable to read the identity deny list: %s\n", err)
+			}
+
+			output := renderEdgeStats(totalRows, options, denyList)
 			_, err = fmt.Print(output)
 
 			return err
@@ -185,10 +199,10 @@ func requestEdgesFromAPI(client pb.ApiClient, req *pb.EdgesRequest) (*pb.EdgesRe
 	return resp, nil
 }
 
-func renderEdgeStats(rows []*pb.Edge, options *edgesOptions) string {
+func renderEdgeStats(rows []*pb.Edge, options *edgesOptions, denyList *identity.DenyList) string {
 	var buffer bytes.Buffer
 	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', tabwriter.AlignRight)
-	writeEdgesToBuffer(rows, w, options)
+	writeEdgesToBuffer(rows, w, options, denyList)
 	w.Flush()
 
 	return renderEdges(buffer, options)
@@ -202,6 +216,7 @@ type edgeRow struct {
 	client       string
 	server       string
 	msg          string
+	denied       []string
 }
 
 const (
@@ -214,7 +229,7 @@ const (
 	msgHeader          = "SECURED"
 )
 
-func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOptions) {
+func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOptions, denyList *identity.DenyList) {
 	maxSrcLength := len(srcHeader)
 	maxDstLength := len(dstHeader)
 	maxSrcNamespaceLength := len(srcNamespaceHeader)
@@ -229,8 +244,20 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 			clientID := r.ClientId
 			serverID := r.ServerId
 			msg := r.NoIdentityMsg
+
+			// the proxies using a denied identity keep their current
+			// certificate until it expires
+			denied := []string{}
+			for _, id := range []string{clientID, serverID} {
+				if _, ok := denyList.DeniesIdentity(id); id != "" && ok {
+					denied = append(denied, id)
+				}
+			}
 			if len(msg) == 0 && options.outputFormat != jsonOutput {
 				msg = okStatus
+				if len(denied) > 0 {
+					msg = fmt.Sprintf("%s denied identity", warnStatus)
+				}
 			}
 			if len(clientID) > 0 {
 				parts := strings.Split(clientID, ".")
@@ -245,6 +272,7 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 				client:       clientID,
 				server:       serverID,
 				msg:          msg,
+				denied:       denied,
 				src:          r.Src.Name,
 				srcNamespace: r.Src.Namespace,
 				dst:          r.Dst.Name,
@@ -356,13 +384,14 @@ func renderEdges(buffer bytes.Buffer, options *edgesOptions) string {
 }
 
 type edgesJSONStats struct {
-	Src          string `json:"src"`
-	SrcNamespace string `json:"src_namespace"`
-	Dst          string `json:"dst"`
-	DstNamespace string `json:"dst_namespace"`
-	Client       string `json:"client_id"`
-	Server       string `json:"server_id"`
-	Msg          string `json:"no_tls_reason"`
+	Src          string   `json:"src"`
+	SrcNamespace string   `json:"src_namespace"`
+	Dst          string   `json:"dst"`
+	DstNamespace string   `json:"dst_namespace"`
+	Client       string   `json:"client_id"`
+	Server       string   `json:"server_id"`
+	Msg          string   `json:"no_tls_reason"`
+	Denied       []string `json:"denied_identities,omitempty"`
 }
 
 func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
@@ -377,7 +406,8 @@ func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
 			DstNamespace: row.dstNamespace,
 			Client:       row.client,
 			Server:       row.server,
-			Msg:          row.msg}
+			Msg:          row.msg,
+			Denied:       row.denied}
 		entries = append(entries, entry)
 	}
 
@@ -4,11 +4,13 @@ import (
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	"github.com/linkerd/linkerd2/pkg/identity"
 )
 
 type edgesParamsExp struct {
 	options      *edgesOptions
 	resourceType string
+	denyList     *identity.DenyList
 	file         string
 }
 
@@ -42,6 +44,30 @@ func TestEdges(t *testing.T) {
 		}, t)
 	})
 
+	denyList, err := identity.NewDenyList([]string{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local"}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Run("Returns edges with denied identities", func(t *testing.T) {
+		options.outputFormat = tableOutput
+		testEdgesCall(edgesParamsExp{
+			options:      options,
+			resourceType: "deployment",
+			denyList:     denyList,
+			file:         "edges_denied_output.golden",
+		}, t)
+	})
+
+	t.Run("Returns edges with denied identities (json)", func(t *testing.T) {
+		options.outputFormat = jsonOutput
+		testEdgesCall(edgesParamsExp{
+			options:      options,
+			resourceType: "deployment",
+			denyList:     denyList,
+			file:         "edges_denied_output_json.golden",
+		}, t)
+	})
+
 	t.Run("Returns an error if outputFormat specified is not wide, table or json", func(t *testing.T) {
 		options.outputFormat = "test"
 		args := []string{"deployment"}
@@ -116,7 +142,7 @@ func testEdgesCall(exp edgesParamsExp, t *testing.T) {
 	}
 
 	rows := edgesRespToRows(resp)
-	output := renderEdgeStats(rows, exp.options)
+	output := renderEdgeStats(rows, exp.options, exp.denyList)
 
 	diffTestdata(t, exp.file, output)
 }
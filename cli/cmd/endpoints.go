@@ -3,10 +3,8 @@ package cmd
 import (
 	"bytes"
 	"context"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
-	"net"
 	"os"
 	"sort"
 	"strings"
@@ -14,8 +12,8 @@ import (
 	"text/tabwriter"
 
 	destinationPb "github.com/linkerd/linkerd2-proxy-api/go/destination"
-	netPb "github.com/linkerd/linkerd2-proxy-api/go/net"
 	"github.com/linkerd/linkerd2/controller/api/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -150,19 +148,19 @@ func requestEndpointsFromAPI(client public.APIClient, authorities []string) (end
 				info[serviceID] = make(map[uint32][]podData)
 			}
 
-			for _, addr := range addressSet.GetAddrs() {
-				tcpAddr := addr.GetAddr()
+			for _, weightedAddr := range addressSet.GetAddrs() {
+				tcpAddr := weightedAddr.GetAddr()
 				port := tcpAddr.GetPort()
 
 				if info[serviceID][port] == nil {
 					info[serviceID][port] = make([]podData, 0)
 				}
 
-				labels := addr.GetMetricLabels()
+				labels := weightedAddr.GetMetricLabels()
 				info[serviceID][port] = append(info[serviceID][port], podData{
 					name:    labels["pod"],
-					address: tcpAddr.String(),
-					ip:      getIP(tcpAddr),
+					address: addr.ProxyAddressToString(tcpAddr),
+					ip:      addr.ProxyIPToString(tcpAddr.GetIp()),
 				})
 			}
 		}
@@ -171,13 +169,6 @@ func requestEndpointsFromAPI(client public.APIClient, authorities []string) (end
 	return info, nil
 }
 
-func getIP(tcpAddr *netPb.TcpAddress) string {
-	ip := tcpAddr.GetIp().GetIpv4()
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, ip)
-	return net.IP(b).String()
-}
-
 func renderEndpoints(endpoints endpointsInfo, options *endpointsOptions) string {
 	var buffer bytes.Buffer
 	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', 0)
@@ -0,0 +1,827 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/api/util"
+	configPb "github.com/linkerd/linkerd2/controller/gen/config"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/config"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultRotatedIssuerLifetime = 365 * 24 * time.Hour
+	rotationCheckInterval        = 10 * time.Second
+
+	// the proxies renew their certificate once 70% of its lifetime has
+	// elapsed, i.e. with ~7h left for the default 24h lifetime, so a
+	// certificate expiring within 6h is overdue for its renewal
+	defaultExpiringWithin = 6 * time.Hour
+)
+
+type identityOptions struct {
+	namespace      string
+	outputFormat   string
+	expiringWithin time.Duration
+}
+
+// proxyCertificate describes the certificate presented by the proxy of a
+// pod, as rendered by "linkerd identity".
+type proxyCertificate struct {
+	Pod               string             `json:"pod"`
+	Namespace         string             `json:"namespace"`
+	Subject           string             `json:"subject,omitempty"`
+	SANs              []string           `json:"sans,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	Serial            string             `json:"serial,omitempty"`
+	NotAfter          string             `json:"not_after,omitempty"`
+	RemainingValidity string             `json:"remaining_validity,omitempty"`
+	Expired           bool               `json:"expired"`
+	NearExpiry        bool               `json:"near_expiry"`
+	Chain             []chainCertificate `json:"chain,omitempty"`
+	Error             string             `json:"error,omitempty"`
+}
+
+// chainCertificate describes a certificate of the chain presented by a proxy
+// along with its leaf certificate.
+type chainCertificate struct {
+	Subject  string `json:"subject"`
+	Issuer   string `json:"issuer"`
+	Serial   string `json:"serial"`
+	NotAfter string `json:"not_after"`
+}
+
+type identityIssuancesOptions struct {
+	limit        uint32
+	outputFormat string
+}
+
+type rotateAnchorsReissueOptions struct {
+	anchorPEMFile    string
+	anchorKeyPEMFile string
+	issuerLifetime   time.Duration
+	force            bool
+}
+
+func newCmdIdentity() *cobra.Command {
+	options := &identityOptions{
+		namespace:      "default",
+		outputFormat:   tableOutput,
+		expiringWithin: defaultExpiringWithin,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "identity [flags] (RESOURCE)",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Inspect and manage the identity of the Linkerd proxies",
+		Long: `Inspect and manage the identity of the Linkerd proxies.
+
+Given a RESOURCE, this command port-forwards to the admin port of the proxies of
+its running meshed pods, and displays the leaf certificate each of them
+presents: its subject, SANs, issuer, serial number and remaining validity. The
+JSON output also includes the rest of the chain presented by the proxies. The
+certificates are displayed as presented, without being verified; "linkerd check
+--proxy" verifies them.
+
+  The RESOURCE argument specifies the target resource: (TYPE[/NAME])
+
+  Examples:
+  * pods
+  * deploy/my-deploy
+  * po/mypod1
+  * sts/my-statefulset
+
+  Valid resource types include:
+  * cronjobs
+  * daemonsets
+  * deployments
+  * jobs
+  * pods
+  * replicasets
+  * replicationcontrollers
+  * statefulsets
+
+This command also provides subcommands to operate the trust anchors and the
+issuer that the identity controller certifies the proxies with.`,
+		Example: `  # Display the certificates of all the meshed pods in the emojivoto namespace.
+  linkerd identity -n emojivoto pods
+
+  # Display the certificates of the pods of the web deployment, as JSON.
+  linkerd identity -n emojivoto deploy/web -o json
+
+  # Flag the certificates expiring within the next hour.
+  linkerd identity -n emojivoto pods --expiring-within 1h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return cmd.Help()
+			}
+			if options.outputFormat != tableOutput && options.outputFormat != jsonOutput {
+				return fmt.Errorf("--output currently only supports %s and %s", tableOutput, jsonOutput)
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+			_, configs, err := healthcheck.FetchLinkerdConfigMap(k8sAPI, controlPlaneNamespace)
+			if err != nil {
+				return err
+			}
+			trustDomain := configs.GetGlobal().GetIdentityContext().GetTrustDomain()
+			if trustDomain == "" {
+				return errors.New("identity is disabled in the control plane")
+			}
+
+			pods, err := getMeshedPodsFor(k8sAPI, options.namespace, args[0])
+			if err != nil {
+				return err
+			}
+			if len(pods) == 0 {
+				return fmt.Errorf("no running meshed pods found for %s", args[0])
+			}
+
+			crts := fetchProxyCertificates(k8sAPI, pods, trustDomain, time.Now(), options.expiringWithin)
+			output, err := renderProxyCertificates(crts, options.outputFormat)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Print(output)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the resource")
+	cmd.Flags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\" or \"%s\"", tableOutput, jsonOutput))
+	cmd.Flags().DurationVar(&options.expiringWithin, "expiring-within", options.expiringWithin, "Flag the certificates expiring within this duration as near expiry (0 disables the flag)")
+
+	cmd.AddCommand(newCmdIdentityIssuances())
+	cmd.AddCommand(newCmdIdentityRotateAnchors())
+
+	return cmd
+}
+
+// getMeshedPodsFor returns the running meshed pods of the given resource,
+// sorted by name. Unlike getPodsFor, it accepts a resource type without
+// name, to return all the meshed pods of the namespace.
+func getMeshedPodsFor(k8sAPI *k8s.KubernetesAPI, namespace string, resource string) ([]corev1.Pod, error) {
+	res, err := util.BuildResource(namespace, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []corev1.Pod
+	if res.GetType() == k8s.Pod && res.GetName() == "" {
+		podList, err := k8sAPI.CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+		if err != nil {
+			return nil, err
+		}
+		pods = podList.Items
+	} else {
+		pods, err = getPodsFor(k8sAPI, namespace, resource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	meshed := []corev1.Pod{}
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodRunning && k8s.IsMeshed(&pod, controlPlaneNamespace) {
+			meshed = append(meshed, pod)
+		}
+	}
+	sort.Slice(meshed, func(i, j int) bool { return meshed[i].GetName() < meshed[j].GetName() })
+	return meshed, nil
+}
+
+// fetchProxyCertificates fetches the certificates presented by the proxies of
+// the given pods, concurrently. The failures to fetch them are reported in the
+// returned certificates.
+func fetchProxyCertificates(k8sAPI *k8s.KubernetesAPI, pods []corev1.Pod, trustDomain string, now time.Time, expiringWithin time.Duration) []proxyCertificate {
+	results := k8s.FetchProxiesCertificates(pods, func(pod corev1.Pod) ([]*x509.Certificate, error) {
+		return k8s.FetchProxyCertificates(k8sAPI, pod, k8s.ProxyIdentity(pod, controlPlaneNamespace, trustDomain), verbose)
+	})
+
+	crts := make([]proxyCertificate, len(results))
+	for i, pc := range results {
+		crts[i] = newProxyCertificate(pc.Pod, pc.Certs, pc.Err, now, expiringWithin)
+	}
+	return crts
+}
+
+// newProxyCertificate describes the leaf certificate of the chain presented
+// by the proxy of the given pod, or the error returned when fetching it. The
+// leaf is near expiry when it expires within expiringWithin of now.
+func newProxyCertificate(pod corev1.Pod, certs []*x509.Certificate, err error, now time.Time, expiringWithin time.Duration) proxyCertificate {
+	crt := proxyCertificate{
+		Pod:       pod.GetName(),
+		Namespace: pod.GetNamespace(),
+	}
+	if err != nil {
+		crt.Error = err.Error()
+		return crt
+	}
+
+	leaf := certs[0]
+	crt.Subject = leaf.Subject.CommonName
+	crt.SANs = append(crt.SANs, leaf.DNSNames...)
+	for _, uri := range leaf.URIs {
+		crt.SANs = append(crt.SANs, uri.String())
+	}
+	crt.Issuer = leaf.Issuer.CommonName
+	crt.Serial = leaf.SerialNumber.Text(16)
+	crt.NotAfter = leaf.NotAfter.UTC().Format(time.RFC3339)
+
+	remaining := leaf.NotAfter.Sub(now).Round(time.Second)
+	if remaining <= 0 {
+		remaining = 0
+		crt.Expired = true
+	}
+	crt.RemainingValidity = remaining.String()
+	crt.NearExpiry = expiringWithin > 0 && remaining < expiringWithin
+
+	for _, c := range certs[1:] {
+		crt.Chain = append(crt.Chain, chainCertificate{
+			Subject:  c.Subject.CommonName,
+			Issuer:   c.Issuer.CommonName,
+			Serial:   c.SerialNumber.Text(16),
+			NotAfter: c.NotAfter.UTC().Format(time.RFC3339),
+		})
+	}
+	return crt
+}
+
+func renderProxyCertificates(crts []proxyCertificate, outputFormat string) (string, error) {
+	if outputFormat == jsonOutput {
+		// avoid nil initialization so that no certificate is marshalled as an empty array vs null
+		if crts == nil {
+			crts = []proxyCertificate{}
+		}
+		b, err := json.MarshalIndent(crts, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b) + "\n", nil
+	}
+
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', 0)
+	fmt.Fprintln(w, "POD\tSUBJECT\tSANS\tISSUER\tSERIAL\tREMAINING\tSTATUS\t")
+	for _, crt := range crts {
+		status := "ok"
+		switch {
+		case crt.Error != "":
+			status = fmt.Sprintf("error (%s)", crt.Error)
+		case crt.Expired:
+			status = "expired"
+		case crt.NearExpiry:
+			status = "near expiry"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			crt.Pod,
+			orDash(crt.Subject),
+			orDash(strings.Join(crt.SANs, ",")),
+			orDash(crt.Issuer),
+			orDash(crt.Serial),
+			orDash(crt.RemainingValidity),
+			status,
+		)
+	}
+	w.Flush()
+	return buffer.String(), nil
+}
+
+func newCmdIdentityIssuances() *cobra.Command {
+	options := &identityIssuancesOptions{
+		limit:        50,
+		outputFormat: tableOutput,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "issuances [flags]",
+		Args:  cobra.NoArgs,
+		Short: "List the recent certificate issuances and rejections of the identity controller",
+		Long: `List the recent certificate issuances and rejections of the identity controller.
+
+The audit records are read from the identity controller through the public API,
+from the oldest to the most recent. The identity controller only keeps the most
+recent records in memory, which are lost when it restarts; its --audit-log flag
+appends all of them to a file as JSON lines.`,
+		Example: `  # List the last 10 certification requests.
+  linkerd identity issuances --limit 10
+
+  # List the last certification requests that were rejected.
+  linkerd identity issuances -o json | jq '.[] | select(.rejection_reason)'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.outputFormat != tableOutput && options.outputFormat != jsonOutput {
+				return fmt.Errorf("--output currently only supports %s and %s", tableOutput, jsonOutput)
+			}
+
+			rsp, err := checkPublicAPIClientOrExit().Issuances(context.Background(), &pb.IssuancesRequest{Limit: options.limit})
+			if err != nil {
+				return err
+			}
+
+			output, err := renderIssuances(rsp.GetIssuances(), options.outputFormat)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Print(output)
+			return err
+		},
+	}
+
+	cmd.Flags().Uint32Var(&options.limit, "limit", options.limit, "Maximum number of records to list, keeping the most recent ones (0 lists all the records kept by the identity controller)")
+	cmd.Flags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\" or \"%s\"", tableOutput, jsonOutput))
+
+	return cmd
+}
+
+func renderIssuances(issuances []*pb.Issuance, outputFormat string) (string, error) {
+	if outputFormat == jsonOutput {
+		// avoid nil initialization so that no record is marshalled as an empty array vs null
+		if issuances == nil {
+			issuances = []*pb.Issuance{}
+		}
+		b, err := json.MarshalIndent(issuances, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b) + "\n", nil
+	}
+
+	if len(issuances) == 0 {
+		return "No issuances found.\n", nil
+	}
+
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', 0)
+	fmt.Fprintln(w, "TIMESTAMP\tIDENTITY\tSERVICE ACCOUNT\tSERIAL\tNOT AFTER\tSTATUS\t")
+	for _, issuance := range issuances {
+		status := "issued"
+		if issuance.GetRejectionReason() != "" {
+			status = fmt.Sprintf("rejected (%s)", issuance.GetRejectionReason())
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			issuance.GetTimestamp(),
+			issuance.GetRequestedIdentity(),
+			orDash(issuance.GetServiceAccount()),
+			orDash(issuance.GetSerial()),
+			orDash(issuance.GetNotAfter()),
+			status,
+		)
+	}
+	w.Flush()
+	return buffer.String(), nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func newCmdIdentityRotateAnchors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-anchors [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Rotates the trust anchors of the proxies in staged phases",
+		Long: `Rotates the trust anchors of the proxies in staged phases.
+
+The trust anchors can't be swapped at once, since the proxies only trust the
+anchors they were injected with. Instead, the rotation goes through the
+following phases, each of them guarded by the linkerd-identity checks of the
+previous one:
+
+  1. "add" adds the new trust anchor to the bundle. The control plane must then
+     be upgraded, and the meshed workloads restarted, so that all the proxies
+     trust both the old and the new anchors.
+  2. "reissue" issues a new issuer certificate from the new trust anchor, once
+     all the proxies trust it.
+  3. "wait" waits until all the proxies present a certificate issued by the new
+     issuer, which happens when they renew their certificate or restart.
+  4. "remove" removes the trust anchors that didn't issue the current issuer
+     from the bundle. The control plane must then be upgraded, and the meshed
+     workloads restarted.
+
+This workflow only applies to issuers of the linkerd.io/tls scheme: issuers of
+the kubernetes.io/tls scheme are rotated by the external certificate manager
+that issues them.`,
+	}
+
+	cmd.AddCommand(newCmdIdentityRotateAnchorsAdd())
+	cmd.AddCommand(newCmdIdentityRotateAnchorsReissue())
+	cmd.AddCommand(newCmdIdentityRotateAnchorsWait())
+	cmd.AddCommand(newCmdIdentityRotateAnchorsRemove())
+
+	return cmd
+}
+
+func newCmdIdentityRotateAnchorsAdd() *cobra.Command {
+	var anchorPEMFile string
+
+	cmd := &cobra.Command{
+		Use:   "add [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Output the linkerd-config ConfigMap with a new trust anchor added to the bundle",
+		Long: `Output the linkerd-config ConfigMap with a new trust anchor added to the bundle.
+
+Once the ConfigMap is applied, upgrade the control plane and restart the meshed
+workloads, so that their proxies trust the new anchor. "linkerd check --proxy"
+then reports the proxies that still have to be restarted.`,
+		Example: `  # Add the ca-new.crt trust anchor to the bundle.
+  linkerd identity rotate-anchors add --anchor ca-new.crt | kubectl apply -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if anchorPEMFile == "" {
+				return errors.New("--anchor is required")
+			}
+			crtb, err := ioutil.ReadFile(anchorPEMFile)
+			if err != nil {
+				return err
+			}
+			anchor, err := tls.DecodePEMCrt(strings.TrimSpace(string(crtb)))
+			if err != nil {
+				return err
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+			cm, configs, err := fetchRotatableConfig(k8sAPI)
+			if err != nil {
+				return err
+			}
+
+			idctx := configs.GetGlobal().GetIdentityContext()
+			idctx.TrustAnchorsPem, err = addTrustAnchor(idctx.GetTrustAnchorsPem(), anchor.Certificate)
+			if err != nil {
+				return err
+			}
+
+			cm, err = rotatedConfigMap(cm, configs)
+			if err != nil {
+				return err
+			}
+			return writeObject(os.Stdout, cm)
+		},
+	}
+
+	cmd.Flags().StringVar(&anchorPEMFile, "anchor", anchorPEMFile, "A path to a PEM-encoded file containing the new trust anchor")
+
+	return cmd
+}
+
+func newCmdIdentityRotateAnchorsReissue() *cobra.Command {
+	options := &rotateAnchorsReissueOptions{
+		issuerLifetime: defaultRotatedIssuerLifetime,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reissue [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Output the linkerd-identity-issuer Secret with an issuer issued by the new trust anchor",
+		Long: `Output the linkerd-identity-issuer Secret with an issuer issued by the new trust anchor.
+
+The new trust anchor must have been added to the bundle, and all the proxies
+must trust it: otherwise the proxies couldn't validate the certificates issued
+by the new issuer. The identity controller reloads the issuer as soon as the
+Secret is applied.`,
+		Example: `  # Issue a new issuer from the ca-new.crt trust anchor.
+  linkerd identity rotate-anchors reissue --anchor ca-new.crt --anchor-key ca-new.key | kubectl apply -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.anchorPEMFile == "" || options.anchorKeyPEMFile == "" {
+				return errors.New("--anchor and --anchor-key are required")
+			}
+			if options.issuerLifetime <= 0 {
+				return errors.New("--issuer-lifetime must be positive")
+			}
+			anchor, err := tls.ReadPEMCreds(options.anchorKeyPEMFile, options.anchorPEMFile)
+			if err != nil {
+				return err
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+			_, configs, err := fetchRotatableConfig(k8sAPI)
+			if err != nil {
+				return err
+			}
+
+			idctx := configs.GetGlobal().GetIdentityContext()
+			found, err := hasTrustAnchor(idctx.GetTrustAnchorsPem(), anchor.Certificate)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return errors.New("the trust anchor isn't in the bundle yet, add it with \"linkerd identity rotate-anchors add\"")
+			}
+
+			if !options.force && !runRotationChecks(os.Stderr, healthcheck.LinkerdIdentityDataPlane, healthcheck.LinkerdIdentityDataPlaneCertificates) {
+				return errors.New("all the proxies must trust the new anchor before it issues the issuer; upgrade the control plane and restart the meshed workloads, or use --force")
+			}
+
+			issuerName := fmt.Sprintf("identity.%s.%s", controlPlaneNamespace, idctx.GetTrustDomain())
+			issuer, err := tls.NewCA(*anchor, tls.Validity{Lifetime: options.issuerLifetime}).GenerateCA(issuerName, 0)
+			if err != nil {
+				return err
+			}
+
+			secret, err := k8sAPI.CoreV1().Secrets(controlPlaneNamespace).Get(k8s.IdentityIssuerSecretName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			return writeObject(os.Stdout, reissuedSecret(secret, &issuer.Cred))
+		},
+	}
+
+	cmd.Flags().StringVar(&options.anchorPEMFile, "anchor", options.anchorPEMFile, "A path to a PEM-encoded file containing the new trust anchor")
+	cmd.Flags().StringVar(&options.anchorKeyPEMFile, "anchor-key", options.anchorKeyPEMFile, "A path to a PEM-encoded file containing the private key of the new trust anchor")
+	cmd.Flags().DurationVar(&options.issuerLifetime, "issuer-lifetime", options.issuerLifetime, "The lifetime of the new issuer certificate")
+	cmd.Flags().BoolVar(&options.force, "force", options.force, "Issue the new issuer even if some proxies don't trust the new anchor yet")
+
+	return cmd
+}
+
+func newCmdIdentityRotateAnchorsWait() *cobra.Command {
+	timeout := 5 * time.Minute
+
+	cmd := &cobra.Command{
+		Use:   "wait [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Wait until all the proxies present a certificate issued by the current issuer",
+		Long: `Wait until all the proxies present a certificate issued by the current issuer.
+
+The proxies renew their certificate well before it expires, or when they
+restart. Restarting the meshed workloads is the quickest way through this
+phase.`,
+		Example: `  # Wait for up to 10 minutes.
+  linkerd identity rotate-anchors wait --timeout 10m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deadline := time.Now().Add(timeout)
+			for {
+				var out bytes.Buffer
+				if runRotationChecks(&out, healthcheck.LinkerdIdentity, healthcheck.LinkerdIdentityDataPlane, healthcheck.LinkerdIdentityDataPlaneCertificates) {
+					fmt.Fprintln(os.Stderr, "All the proxies present a certificate issued by the current issuer")
+					return nil
+				}
+				if time.Now().Add(rotationCheckInterval).After(deadline) {
+					fmt.Fprint(os.Stderr, out.String())
+					return fmt.Errorf("timed out after %s", timeout)
+				}
+				fmt.Fprintln(os.Stderr, "Waiting for the proxies to present a certificate issued by the current issuer...")
+				time.Sleep(rotationCheckInterval)
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", timeout, "How long to wait for the proxies")
+
+	return cmd
+}
+
+func newCmdIdentityRotateAnchorsRemove() *cobra.Command {
+	force := false
+
+	cmd := &cobra.Command{
+		Use:   "remove [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Output the linkerd-config ConfigMap without the trust anchors of the previous issuers",
+		Long: `Output the linkerd-config ConfigMap without the trust anchors of the previous issuers.
+
+The trust anchors that didn't issue the current issuer are removed from the
+bundle, once all the proxies present a certificate issued by the current
+issuer. Once the ConfigMap is applied, upgrade the control plane and restart the
+meshed workloads, so that their proxies stop trusting the removed anchors.`,
+		Example: `  # Remove the previous trust anchors from the bundle.
+  linkerd identity rotate-anchors remove | kubectl apply -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+			cm, configs, err := fetchRotatableConfig(k8sAPI)
+			if err != nil {
+				return err
+			}
+
+			if !force && !runRotationChecks(os.Stderr, healthcheck.LinkerdIdentity, healthcheck.LinkerdIdentityDataPlane, healthcheck.LinkerdIdentityDataPlaneCertificates) {
+				return errors.New("all the proxies must present a certificate issued by the current issuer before the previous anchors are removed; see \"linkerd identity rotate-anchors wait\", or use --force")
+			}
+
+			idctx := configs.GetGlobal().GetIdentityContext()
+			issuerData, err := issuercerts.FetchIssuerCrtData(k8sAPI, idctx.GetTrustAnchorsPem(), controlPlaneNamespace)
+			if err != nil {
+				return err
+			}
+			issuer, err := tls.DecodePEMCrt(issuerData.IssuerCrt)
+			if err != nil {
+				return err
+			}
+
+			idctx.TrustAnchorsPem, err = removeStaleTrustAnchors(idctx.GetTrustAnchorsPem(), issuer)
+			if err != nil {
+				return err
+			}
+
+			cm, err = rotatedConfigMap(cm, configs)
+			if err != nil {
+				return err
+			}
+			return writeObject(os.Stdout, cm)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", force, "Remove the previous anchors even if some proxies still present a certificate they issued")
+
+	return cmd
+}
+
+// fetchRotatableConfig fetches the linkerd-config ConfigMap, and ensures that
+// its trust anchors can be rotated by the CLI.
+func fetchRotatableConfig(k8sAPI *k8s.KubernetesAPI) (*corev1.ConfigMap, *configPb.All, error) {
+	cm, configs, err := healthcheck.FetchLinkerdConfigMap(k8sAPI, controlPlaneNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idctx := configs.GetGlobal().GetIdentityContext()
+	if idctx == nil {
+		return nil, nil, errors.New("identity is disabled in the control plane")
+	}
+	if scheme := idctx.GetScheme(); scheme != "" && scheme != k8s.IdentityIssuerSchemeLinkerd {
+		return nil, nil, fmt.Errorf("the issuer of the %s scheme is managed externally, rotate its trust anchors with the external certificate manager", scheme)
+	}
+	return cm, configs, nil
+}
+
+// hasTrustAnchor returns whether the bundle contains the given trust anchor.
+func hasTrustAnchor(bundle string, anchor *x509.Certificate) (bool, error) {
+	anchors, err := tls.DecodePEMCertificates(strings.TrimSpace(bundle))
+	if err != nil {
+		return false, err
+	}
+	for _, a := range anchors {
+		if a.Equal(anchor) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// addTrustAnchor returns the bundle with the given trust anchor appended,
+// after validating it the way the linkerd-identity checks do.
+func addTrustAnchor(bundle string, anchor *x509.Certificate) (string, error) {
+	if !anchor.IsCA {
+		return "", errors.New("the trust anchor must be a CA certificate")
+	}
+	if err := issuercerts.CheckCertAlgoRequirements(anchor); err != nil {
+		return "", fmt.Errorf("the trust anchor %s", err)
+	}
+	if err := issuercerts.CheckCertValidityPeriod(anchor); err != nil {
+		return "", fmt.Errorf("the trust anchor is %s", err)
+	}
+
+	found, err := hasTrustAnchor(bundle, anchor)
+	if err != nil {
+		return "", err
+	}
+	if found {
+		return "", errors.New("the trust anchor is already in the bundle")
+	}
+
+	return strings.TrimSpace(bundle) + "\n" + tls.EncodeCertificatesPEM(anchor), nil
+}
+
+// removeStaleTrustAnchors returns the bundle without the trust anchors that
+// didn't issue the given issuer.
+func removeStaleTrustAnchors(bundle string, issuer *tls.Crt) (string, error) {
+	anchors, err := tls.DecodePEMCertificates(strings.TrimSpace(bundle))
+	if err != nil {
+		return "", err
+	}
+
+	kept := []*x509.Certificate{}
+	for _, anchor := range anchors {
+		if issuer.Verify(tls.CertificatesToPool([]*x509.Certificate{anchor}), "", time.Time{}) == nil {
+			kept = append(kept, anchor)
+		}
+	}
+	if len(kept) == 0 {
+		return "", errors.New("none of the trust anchors issued the current issuer")
+	}
+	if len(kept) == len(anchors) {
+		return "", errors.New("all the trust anchors issued the current issuer, there's no trust anchor to remove")
+	}
+	return tls.EncodeCertificatesPEM(kept...), nil
+}
+
+// rotatedConfigMap returns a copy of the linkerd-config ConfigMap, holding the
+// given global config, that's suitable for kubectl apply.
+func rotatedConfigMap(cm *corev1.ConfigMap, configs *configPb.All) (*corev1.ConfigMap, error) {
+	global, _, _, err := config.ToJSON(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{}
+	for k, v := range cm.Data {
+		data[k] = v
+	}
+	data["global"] = global
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cm.Name,
+			Namespace:   cm.Namespace,
+			Labels:      cm.Labels,
+			Annotations: cm.Annotations,
+		},
+		Data: data,
+	}, nil
+}
+
+// reissuedSecret returns a copy of the linkerd-identity-issuer Secret,
+// holding the given issuer, that's suitable for kubectl apply.
+func reissuedSecret(secret *corev1.Secret, issuer *tls.Cred) *corev1.Secret {
+	annotations := map[string]string{}
+	for k, v := range secret.Annotations {
+		annotations[k] = v
+	}
+	annotations[k8s.IdentityIssuerExpiryAnnotation] = issuer.Crt.Certificate.NotAfter.Format(time.RFC3339)
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        secret.Name,
+			Namespace:   secret.Namespace,
+			Labels:      secret.Labels,
+			Annotations: annotations,
+		},
+		Data: map[string][]byte{
+			k8s.IdentityIssuerCrtName: []byte(issuer.Crt.EncodeCertificatePEM()),
+			k8s.IdentityIssuerKeyName: []byte(issuer.EncodePrivateKeyPEM()),
+		},
+	}
+}
+
+// runRotationChecks runs the checks guarding a phase of the rotation, and
+// reports their failures to w. Unlike "linkerd check", warnings are failures,
+// since the data plane checks are warnings.
+func runRotationChecks(w io.Writer, categories ...healthcheck.CategoryID) bool {
+	checks := append([]healthcheck.CategoryID{
+		healthcheck.KubernetesAPIChecks,
+		healthcheck.LinkerdControlPlaneExistenceChecks,
+	}, categories...)
+	hc := healthcheck.NewHealthChecker(checks, &healthcheck.Options{
+		ControlPlaneNamespace: controlPlaneNamespace,
+		KubeConfig:            kubeconfigPath,
+		KubeContext:           kubeContext,
+		Impersonate:           impersonate,
+		ImpersonateGroup:      impersonateGroup,
+		APIAddr:               apiAddr,
+		RetryDeadline:         time.Now(),
+	})
+
+	success := true
+	hc.RunChecks(func(result *healthcheck.CheckResult) {
+		if result.Retry || result.Err == nil {
+			return
+		}
+		success = false
+		status := failStatus
+		if result.Warning {
+			status = warnStatus
+		}
+		fmt.Fprintf(w, "%s %s\n    %s\n", status, result.Description, result.Err)
+	})
+	return success
+}
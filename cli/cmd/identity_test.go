@@ -0,0 +1,348 @@
+package cmd
+
+import (
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestAnchor(t *testing.T, name string) *tls.CA {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	ca, err := tls.CreateRootCA(name, key, tls.Validity{Lifetime: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return ca
+}
+
+func decodeBundle(t *testing.T, bundle string) []*x509.Certificate {
+	anchors, err := tls.DecodePEMCertificates(bundle)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return anchors
+}
+
+func TestAddTrustAnchor(t *testing.T) {
+	oldAnchor := newTestAnchor(t, "identity.linkerd.cluster.local")
+	newAnchor := newTestAnchor(t, "root.linkerd.cluster.local")
+	issuer, err := newAnchor.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	leaf, err := issuer.GenerateEndEntityCred("web.emojivoto.serviceaccount.identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	bundle := oldAnchor.Cred.Crt.EncodeCertificatePEM()
+
+	t.Run("appends the anchor to the bundle", func(t *testing.T) {
+		// a trailing newline must not break the bundle
+		rotated, err := addTrustAnchor(bundle+"\n", newAnchor.Cred.Crt.Certificate)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		anchors := decodeBundle(t, rotated)
+		if len(anchors) != 2 || !anchors[0].Equal(oldAnchor.Cred.Crt.Certificate) || !anchors[1].Equal(newAnchor.Cred.Crt.Certificate) {
+			t.Fatalf("Expected the old and new anchors in the bundle, got %v", anchors)
+		}
+	})
+
+	for _, tc := range []struct {
+		name          string
+		anchor        *x509.Certificate
+		expectedError string
+	}{
+		{
+			name:          "rejects anchors already in the bundle",
+			anchor:        oldAnchor.Cred.Crt.Certificate,
+			expectedError: "the trust anchor is already in the bundle",
+		},
+		{
+			name:          "rejects certificates that aren't CAs",
+			anchor:        leaf.Crt.Certificate,
+			expectedError: "the trust anchor must be a CA certificate",
+		},
+	} {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := addTrustAnchor(bundle, tc.anchor)
+			if err == nil || err.Error() != tc.expectedError {
+				t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestRemoveStaleTrustAnchors(t *testing.T) {
+	oldAnchor := newTestAnchor(t, "identity.linkerd.cluster.local")
+	newAnchor := newTestAnchor(t, "root.linkerd.cluster.local")
+	issuer, err := newAnchor.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	bundle := oldAnchor.Cred.Crt.EncodeCertificatePEM() + newAnchor.Cred.Crt.EncodeCertificatePEM()
+
+	t.Run("removes the anchors that didn't issue the issuer", func(t *testing.T) {
+		rotated, err := removeStaleTrustAnchors(bundle, &issuer.Cred.Crt)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		anchors := decodeBundle(t, rotated)
+		if len(anchors) != 1 || !anchors[0].Equal(newAnchor.Cred.Crt.Certificate) {
+			t.Fatalf("Expected only the new anchor in the bundle, got %v", anchors)
+		}
+	})
+
+	t.Run("fails when there's no anchor to remove", func(t *testing.T) {
+		_, err := removeStaleTrustAnchors(newAnchor.Cred.Crt.EncodeCertificatePEM(), &issuer.Cred.Crt)
+		expected := "all the trust anchors issued the current issuer, there's no trust anchor to remove"
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %q, got %v", expected, err)
+		}
+	})
+
+	t.Run("fails when no anchor issued the issuer", func(t *testing.T) {
+		_, err := removeStaleTrustAnchors(oldAnchor.Cred.Crt.EncodeCertificatePEM(), &issuer.Cred.Crt)
+		expected := "none of the trust anchors issued the current issuer"
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %q, got %v", expected, err)
+		}
+	})
+}
+
+func TestReissuedSecret(t *testing.T) {
+	anchor := newTestAnchor(t, "root.linkerd.cluster.local")
+	issuer, err := anchor.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	secret := reissuedSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            k8s.IdentityIssuerSecretName,
+			Namespace:       "linkerd",
+			ResourceVersion: "42",
+			Labels:          map[string]string{k8s.ControllerComponentLabel: "identity"},
+			Annotations:     map[string]string{k8s.IdentityIssuerExpiryAnnotation: "2000-01-01T00:00:00Z"},
+		},
+		Data: map[string][]byte{
+			k8s.IdentityIssuerCrtName: []byte("old crt"),
+			k8s.IdentityIssuerKeyName: []byte("old key"),
+		},
+	}, &issuer.Cred)
+
+	if secret.ResourceVersion != "" {
+		t.Fatalf("Expected no resource version, got %s", secret.ResourceVersion)
+	}
+	if secret.Labels[k8s.ControllerComponentLabel] != "identity" {
+		t.Fatalf("Expected the labels to be kept, got %v", secret.Labels)
+	}
+	expectedExpiry := issuer.Cred.Crt.Certificate.NotAfter.Format(time.RFC3339)
+	if expiry := secret.Annotations[k8s.IdentityIssuerExpiryAnnotation]; expiry != expectedExpiry {
+		t.Fatalf("Expected expiry %s, got %s", expectedExpiry, expiry)
+	}
+
+	cred, err := tls.ValidateAndCreateCreds(string(secret.Data[k8s.IdentityIssuerCrtName]), string(secret.Data[k8s.IdentityIssuerKeyName]))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := cred.Crt.Verify(anchor.Cred.Crt.CertPool(), "", time.Time{}); err != nil {
+		t.Fatalf("Expected the issuer to be issued by the anchor, got %s", err)
+	}
+}
+
+func TestRenderIssuances(t *testing.T) {
+	issuances := []*pb.Issuance{
+		{
+			Timestamp:         "2020-03-02T10:00:00Z",
+			RequestedIdentity: "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			ServiceAccount:    "emojivoto/web",
+			Serial:            "2a",
+			NotAfter:          "2020-03-03T10:00:20Z",
+			Code:              "OK",
+		},
+		{
+			Timestamp:         "2020-03-02T10:01:00Z",
+			RequestedIdentity: "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			ServiceAccount:    "emojivoto/default",
+			Code:              "FailedPrecondition",
+			RejectionReason:   "identity_mismatch",
+			Error:             "requested identity did not match provided token: requested=web.emojivoto.serviceaccount.identity.linkerd.cluster.local; found=default.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+		},
+		{
+			Timestamp:         "2020-03-02T10:02:00Z",
+			RequestedIdentity: "vote-bot.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			Code:              "FailedPrecondition",
+			RejectionReason:   "not_authenticated",
+			Error:             "authentication token could not be authenticated",
+		},
+	}
+
+	testCases := []struct {
+		issuances    []*pb.Issuance
+		outputFormat string
+		file         string
+	}{
+		{issuances, tableOutput, "identity_issuances_output.golden"},
+		{issuances, jsonOutput, "identity_issuances_output_json.golden"},
+		{nil, tableOutput, "identity_issuances_empty_output.golden"},
+		{nil, jsonOutput, "identity_issuances_empty_output_json.golden"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.file, func(t *testing.T) {
+			output, err := renderIssuances(tc.issuances, tc.outputFormat)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			diffTestdata(t, tc.file, output)
+		})
+	}
+}
+
+func TestNewProxyCertificate(t *testing.T) {
+	anchor := newTestAnchor(t, "root.linkerd.cluster.local")
+	issuer, err := anchor.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	leaf, err := issuer.GenerateEndEntityCred("web.emojivoto.serviceaccount.identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	// the proxies present their leaf along with the issuer
+	certs := []*x509.Certificate{leaf.Crt.Certificate, issuer.Cred.Crt.Certificate}
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "emojivoto"}}
+	notAfter := leaf.Crt.Certificate.NotAfter
+
+	testCases := []struct {
+		name               string
+		now                time.Time
+		expectedRemaining  string
+		expectedNearExpiry bool
+		expectedExpired    bool
+	}{
+		{"valid", notAfter.Add(-12 * time.Hour), "12h0m0s", false, false},
+		{"near expiry", notAfter.Add(-time.Hour), "1h0m0s", true, false},
+		{"expired", notAfter.Add(time.Hour), "0s", true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			crt := newProxyCertificate(pod, certs, nil, tc.now, defaultExpiringWithin)
+
+			if crt.Pod != "web-1" || crt.Namespace != "emojivoto" {
+				t.Fatalf("Expected pod emojivoto/web-1, got %s/%s", crt.Namespace, crt.Pod)
+			}
+			if len(crt.SANs) != 1 || crt.SANs[0] != "web.emojivoto.serviceaccount.identity.linkerd.cluster.local" {
+				t.Fatalf("Expected the identity in the SANs, got %v", crt.SANs)
+			}
+			if crt.Issuer != "identity.linkerd.cluster.local" {
+				t.Fatalf("Expected issuer identity.linkerd.cluster.local, got %s", crt.Issuer)
+			}
+			if crt.Serial != leaf.Crt.Certificate.SerialNumber.Text(16) {
+				t.Fatalf("Expected serial %s, got %s", leaf.Crt.Certificate.SerialNumber.Text(16), crt.Serial)
+			}
+			if len(crt.Chain) != 1 || crt.Chain[0].Subject != "identity.linkerd.cluster.local" || crt.Chain[0].Issuer != "root.linkerd.cluster.local" {
+				t.Fatalf("Expected the issuer in the chain, got %+v", crt.Chain)
+			}
+			if crt.RemainingValidity != tc.expectedRemaining {
+				t.Fatalf("Expected remaining validity %s, got %s", tc.expectedRemaining, crt.RemainingValidity)
+			}
+			if crt.NearExpiry != tc.expectedNearExpiry || crt.Expired != tc.expectedExpired {
+				t.Fatalf("Expected near expiry %t and expired %t, got %t and %t", tc.expectedNearExpiry, tc.expectedExpired, crt.NearExpiry, crt.Expired)
+			}
+		})
+	}
+
+	t.Run("fetch error", func(t *testing.T) {
+		crt := newProxyCertificate(pod, nil, errors.New("port-forward failed"), time.Now(), defaultExpiringWithin)
+		if crt.Error != "port-forward failed" || crt.Serial != "" {
+			t.Fatalf("Expected only the error, got %+v", crt)
+		}
+	})
+}
+
+func TestRenderProxyCertificates(t *testing.T) {
+	crts := []proxyCertificate{
+		{
+			Pod:               "web-1",
+			Namespace:         "emojivoto",
+			Subject:           "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			SANs:              []string{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local"},
+			Issuer:            "identity.linkerd.cluster.local",
+			Serial:            "2a",
+			NotAfter:          "2020-03-03T10:00:20Z",
+			RemainingValidity: "20h0m0s",
+			Chain: []chainCertificate{
+				{
+					Subject:  "identity.linkerd.cluster.local",
+					Issuer:   "root.linkerd.cluster.local",
+					Serial:   "1",
+					NotAfter: "2021-03-02T10:00:00Z",
+				},
+			},
+		},
+		{
+			Pod:               "web-2",
+			Namespace:         "emojivoto",
+			Subject:           "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			SANs:              []string{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local"},
+			Issuer:            "identity.linkerd.cluster.local",
+			Serial:            "2b",
+			NotAfter:          "2020-03-02T12:00:00Z",
+			RemainingValidity: "2h0m0s",
+			NearExpiry:        true,
+		},
+		{
+			Pod:               "web-3",
+			Namespace:         "emojivoto",
+			Subject:           "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			SANs:              []string{"web.emojivoto.serviceaccount.identity.linkerd.cluster.local"},
+			Issuer:            "identity.linkerd.cluster.local",
+			Serial:            "2c",
+			NotAfter:          "2020-03-02T09:00:00Z",
+			RemainingValidity: "0s",
+			Expired:           true,
+			NearExpiry:        true,
+		},
+		{
+			Pod:       "web-4",
+			Namespace: "emojivoto",
+			Error:     "no linkerd-proxy container found for pod web-4",
+		},
+	}
+
+	testCases := []struct {
+		crts         []proxyCertificate
+		outputFormat string
+		file         string
+	}{
+		{crts, tableOutput, "identity_output.golden"},
+		{crts, jsonOutput, "identity_output_json.golden"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.file, func(t *testing.T) {
+			output, err := renderProxyCertificates(tc.crts, tc.outputFormat)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			diffTestdata(t, tc.file, output)
+		})
+	}
+}
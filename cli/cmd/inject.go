@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	pcv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/proxyconfig/v1alpha1"
 	cfg "github.com/linkerd/linkerd2/controller/gen/config"
 	"github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/inject"
@@ -18,6 +20,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -37,6 +41,18 @@ type resourceTransformerInject struct {
 	configs             *cfg.All
 	overrideAnnotations map[string]string
 	enableDebugSidecar  bool
+	reportSources       bool
+
+	// serverSide returns the injection the proxy-injector would perform on
+	// the given manifest. When set, the workloads are annotated locally and
+	// injected by the proxy-injector.
+	serverSide func([]byte) (*inject.DryRunResponse, error)
+
+	// the ProxyConfigs and the annotations and labels of the namespaces of
+	// the cluster, keyed by namespace
+	proxyConfigs  map[string][]*pcv1alpha1.ProxyConfig
+	nsAnnotations map[string]map[string]string
+	nsLabels      map[string]map[string]string
 }
 
 func runInjectCmd(inputs []io.Reader, errWriter, outWriter io.Writer, transformer *resourceTransformerInject) int {
@@ -45,7 +61,7 @@ func runInjectCmd(inputs []io.Reader, errWriter, outWriter io.Writer, transforme
 
 func newCmdInject() *cobra.Command {
 	options := &proxyConfigOptions{}
-	var manualOption, enableDebugSidecar bool
+	var manualOption, enableDebugSidecar, reportSources, serverSide bool
 
 	cmd := &cobra.Command{
 		Use:   "inject [flags] CONFIG-FILE",
@@ -70,6 +86,9 @@ sub-folders, or coming from stdin.`,
 			if err := options.validate(); err != nil {
 				return err
 			}
+			if serverSide && (manualOption || options.ignoreCluster) {
+				return errors.New("--server-side can't be used with --manual or --ignore-cluster")
+			}
 
 			in, err := read(args[0])
 			if err != nil {
@@ -89,8 +108,25 @@ sub-folders, or coming from stdin.`,
 				configs:             configs,
 				overrideAnnotations: overrideAnnotations,
 				enableDebugSidecar:  enableDebugSidecar,
+				reportSources:       reportSources,
+			}
+			if !options.ignoreCluster {
+				if err := transformer.fetchNamespaceSettings(); err != nil {
+					return err
+				}
+			}
+			var injector *serverSideInjector
+			if serverSide {
+				injector, err = newServerSideInjector()
+				if err != nil {
+					return err
+				}
+				transformer.serverSide = injector.dryRun
 			}
 			exitCode := uninjectAndInject(in, stderr, stdout, transformer)
+			if injector != nil {
+				injector.stop()
+			}
 			os.Exit(exitCode)
 			return nil
 		},
@@ -124,6 +160,12 @@ sub-folders, or coming from stdin.`,
 	flags.BoolVar(&enableDebugSidecar, "enable-debug-sidecar", enableDebugSidecar,
 		"Inject a debug sidecar for data plane debugging")
 
+	flags.BoolVar(&serverSide, "server-side", serverSide,
+		"Inject the resources through the proxy-injector, as the auto-injector would with the cluster's configuration (the proxy sidecar container spec is included in the YAML output)")
+
+	flags.BoolVar(&reportSources, "report", reportSources,
+		"Report where the proxy settings of the injected resources come from: pod annotation, namespace annotation, ProxyConfig or global config")
+
 	flags.StringVar(&options.traceCollector, "trace-collector", options.traceCollector,
 		"Collector Service address for the proxies to send Trace Data")
 
@@ -150,6 +192,10 @@ func (rt resourceTransformerInject) transform(bytes []byte) ([]byte, []inject.Re
 		conf.AppendPodAnnotation(k8s.ProxyEnableDebugAnnotation, "true")
 	}
 
+	// the namespace settings are needed to build the report
+	ns := namespaceOf(bytes)
+	conf.WithNsAnnotations(rt.nsAnnotations[ns]).WithNsLabels(rt.nsLabels[ns]).WithProxyConfigs(rt.proxyConfigs[ns])
+
 	report, err := conf.ParseMetaAndYAML(bytes)
 	if err != nil {
 		return nil, nil, err
@@ -185,36 +231,77 @@ func (rt resourceTransformerInject) transform(bytes []byte) ([]byte, []inject.Re
 	if err != nil {
 		return nil, nil, err
 	}
+	reports[0].ConfigSources = conf.GetConfigSources()
 	if len(patchJSON) == 0 {
 		return bytes, reports, nil
 	}
 	log.Infof("patch generated for: %s", report.ResName())
 	log.Debugf("patch: %s", patchJSON)
-	patch, err := jsonpatch.DecodePatch(patchJSON)
+	injectedJSON, err := applyPatch(bytes, patchJSON)
 	if err != nil {
 		return nil, nil, err
 	}
-	origJSON, err := yaml.YAMLToJSON(bytes)
+
+	if rt.serverSide != nil {
+		// the annotated resource is injected by the proxy-injector, the same
+		// way the auto-injector would once it's applied
+		rsp, err := rt.serverSide(injectedJSON)
+		if err != nil {
+			return nil, nil, err
+		}
+		reports = []inject.Report{rsp.Report}
+		if len(rsp.Patch) == 0 {
+			return bytes, reports, nil
+		}
+		log.Debugf("proxy-injector patch: %s", rsp.Patch)
+		injectedJSON, err = applyPatch(injectedJSON, rsp.Patch)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	injectedYAML, err := conf.JSONToYAML(injectedJSON)
 	if err != nil {
 		return nil, nil, err
 	}
-	injectedJSON, err := patch.Apply(origJSON)
+	return injectedYAML, reports, nil
+}
+
+// applyPatch applies the JSON patch to the given resource, and returns it as
+// JSON
+func applyPatch(bytes, patchJSON []byte) ([]byte, error) {
+	patch, err := jsonpatch.DecodePatch(patchJSON)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	injectedYAML, err := conf.JSONToYAML(injectedJSON)
+	origJSON, err := yaml.YAMLToJSON(bytes)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	return injectedYAML, reports, nil
+	return patch.Apply(origJSON)
+}
+
+// namespaceOf returns the namespace set in the metadata of the given resource,
+// if any. Malformed resources are reported by ParseMetaAndYAML.
+func namespaceOf(bytes []byte) string {
+	var obj struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := yaml.Unmarshal(bytes, &obj); err != nil {
+		return ""
+	}
+	return obj.Metadata.Namespace
 }
 
-func (resourceTransformerInject) generateReport(reports []inject.Report, output io.Writer) {
+func (rt resourceTransformerInject) generateReport(reports []inject.Report, output io.Writer) {
 	injected := []inject.Report{}
 	hostNetwork := []string{}
+	outboundOnlyHostNetwork := []string{}
 	sidecar := []string{}
 	udp := []string{}
 	injectDisabled := []string{}
+	policyExcluded := []string{}
+	shutdownCommandMissing := []string{}
 	warningsPrinted := verbose
 
 	for _, r := range reports {
@@ -222,17 +309,23 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 			injected = append(injected, r)
 		}
 
-		if r.HostNetwork {
+		if r.HostNetwork && !r.OutboundOnly {
 			hostNetwork = append(hostNetwork, r.ResName())
 			warningsPrinted = true
 		}
 
+		if r.HostNetwork && r.OutboundOnly {
+			outboundOnlyHostNetwork = append(outboundOnlyHostNetwork, r.ResName())
+			warningsPrinted = true
+		}
+
 		if r.Sidecar {
 			sidecar = append(sidecar, r.ResName())
 			warningsPrinted = true
 		}
 
-		if r.UDP {
+		// the UDP ports are skipped in outbound-only mode
+		if r.UDP && !r.OutboundOnly {
 			udp = append(udp, r.ResName())
 			warningsPrinted = true
 		}
@@ -241,6 +334,16 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 			injectDisabled = append(injectDisabled, r.ResName())
 			warningsPrinted = true
 		}
+
+		if r.InjectionPolicyExcluded {
+			policyExcluded = append(policyExcluded, r.ResName())
+			warningsPrinted = true
+		}
+
+		if len(r.ShutdownCommandMissing) > 0 {
+			shutdownCommandMissing = append(shutdownCommandMissing, fmt.Sprintf("%s (%s)", r.ResName(), strings.Join(r.ShutdownCommandMissing, ", ")))
+			warningsPrinted = true
+		}
 	}
 
 	//
@@ -256,6 +359,10 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 		output.Write([]byte(fmt.Sprintf("%s %s\n", okStatus, hostNetworkDesc)))
 	}
 
+	if len(outboundOnlyHostNetwork) > 0 {
+		output.Write([]byte(fmt.Sprintf("%s outbound-only mode isn't supported with \"hostNetwork: true\" in %s\n", warnStatus, strings.Join(outboundOnlyHostNetwork, ", "))))
+	}
+
 	if len(sidecar) > 0 {
 		output.Write([]byte(fmt.Sprintf("%s known 3rd party sidecar detected in %s\n", warnStatus, strings.Join(sidecar, ", "))))
 	} else if verbose {
@@ -269,6 +376,10 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 		output.Write([]byte(fmt.Sprintf("%s %s\n", okStatus, injectDisabledDesc)))
 	}
 
+	if len(policyExcluded) > 0 {
+		output.Write([]byte(fmt.Sprintf("%s the injection policy of the cluster excludes %s\n", warnStatus, strings.Join(policyExcluded, ", "))))
+	}
+
 	if len(injected) == 0 {
 		output.Write([]byte(fmt.Sprintf("%s no supported objects found\n", warnStatus)))
 		warningsPrinted = true
@@ -276,6 +387,10 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 		output.Write([]byte(fmt.Sprintf("%s %s\n", okStatus, unsupportedDesc)))
 	}
 
+	if len(shutdownCommandMissing) > 0 {
+		output.Write([]byte(fmt.Sprintf("%s shutdown coordination skipped, as some containers don't set a \"command\" in %s\n", warnStatus, strings.Join(shutdownCommandMissing, ", "))))
+	}
+
 	if len(udp) > 0 {
 		verb := "uses"
 		if len(udp) > 1 {
@@ -295,7 +410,11 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 
 	for _, r := range reports {
 		if b, _ := r.Injectable(); b {
-			output.Write([]byte(fmt.Sprintf("%s \"%s\" injected\n", r.Kind, r.Name)))
+			mode := ""
+			if r.OutboundOnly {
+				mode = " in outbound-only mode"
+			}
+			output.Write([]byte(fmt.Sprintf("%s \"%s\" injected%s\n", r.Kind, r.Name, mode)))
 		} else {
 			if r.Kind != "" {
 				output.Write([]byte(fmt.Sprintf("%s \"%s\" skipped\n", r.Kind, r.Name)))
@@ -305,6 +424,25 @@ func (resourceTransformerInject) generateReport(reports []inject.Report, output
 		}
 	}
 
+	if rt.reportSources {
+		for _, r := range reports {
+			if b, _ := r.Injectable(); !b {
+				continue
+			}
+			output.Write([]byte(fmt.Sprintf("\n%s \"%s\" proxy configuration:\n", r.Kind, r.Name)))
+			annotations := []string{}
+			for annotation := range r.ConfigSources {
+				annotations = append(annotations, annotation)
+			}
+			sort.Strings(annotations)
+			for _, annotation := range annotations {
+				source := r.ConfigSources[annotation]
+				output.Write([]byte(fmt.Sprintf("  %s: %s (%s)\n", annotation, source.Value, source.Source)))
+			}
+			output.Write([]byte(fmt.Sprintf("  other settings: %s\n", inject.SourceGlobal)))
+		}
+	}
+
 	// Trailing newline to separate from kubectl output if piping
 	output.Write([]byte("\n"))
 }
@@ -332,6 +470,52 @@ func (options *proxyConfigOptions) fetchConfigsOrDefault() (*cfg.All, error) {
 	return config, nil
 }
 
+// fetchNamespaceSettings reads the ProxyConfigs and the annotations and
+// labels of the namespaces of the cluster. The ProxyConfigs are omitted if the
+// ProxyConfig CRD isn't installed, and the settings the user isn't allowed to
+// read are omitted with a warning.
+func (rt *resourceTransformerInject) fetchNamespaceSettings() error {
+	rt.nsAnnotations = map[string]map[string]string{}
+	rt.nsLabels = map[string]map[string]string{}
+	rt.proxyConfigs = map[string][]*pcv1alpha1.ProxyConfig{}
+
+	k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := k8sAPI.CoreV1().Namespaces().List(metav1.ListOptions{})
+	if err != nil {
+		if kerrors.IsForbidden(err) {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring the namespace annotations and the ProxyConfigs: %s\n", err)
+			return nil
+		}
+		return err
+	}
+	for _, ns := range namespaces.Items {
+		rt.nsAnnotations[ns.Name] = ns.Annotations
+		rt.nsLabels[ns.Name] = ns.Labels
+	}
+
+	if err := k8s.ProxyConfigsAccess(k8sAPI); err != nil {
+		log.Debugf("Skipping ProxyConfigs: %s", err)
+		return nil
+	}
+	list, err := k8sAPI.SpClient.ConfigV1alpha1().ProxyConfigs("").List(metav1.ListOptions{})
+	if err != nil {
+		if kerrors.IsForbidden(err) {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring the ProxyConfigs: %s\n", err)
+			return nil
+		}
+		return err
+	}
+	for i := range list.Items {
+		pc := &list.Items[i]
+		rt.proxyConfigs[pc.Namespace] = append(rt.proxyConfigs[pc.Namespace], pc)
+	}
+	return nil
+}
+
 // overrideConfigs uses command-line overrides to update the provided configs.
 // the overrideAnnotations map keeps track of which configs are overridden, by
 // storing the corresponding annotations and values.
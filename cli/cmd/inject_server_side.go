@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/linkerd/linkerd2/pkg/inject"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// proxyInjectorPort is the port the proxy-injector serves the admission
+// reviews and the dry-run requests on
+const proxyInjectorPort = 8443
+
+// serverSideInjector injects the workloads through the dry-run endpoint of
+// the proxy-injector, so that they're injected exactly as by the webhook
+type serverSideInjector struct {
+	client      *http.Client
+	url         string
+	portForward *k8s.PortForward
+}
+
+// newServerSideInjector connects to a proxy-injector pod through a
+// port-forward. The proxy-injector is authenticated by the certificate it's
+// registered with in the webhook configuration, and authenticates the user
+// with the credentials of the kubeconfig.
+func newServerSideInjector() (*serverSideInjector, error) {
+	// the proxy-injector authenticates the user of the kubeconfig, and can't
+	// honor the impersonation headers
+	if impersonate != "" || len(impersonateGroup) > 0 {
+		return nil, errors.New("--server-side doesn't support impersonation")
+	}
+	k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, "", nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookConfig, err := k8sAPI.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(k8s.ProxyInjectorWebhookConfigName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the proxy-injector's webhook configuration: %s", err)
+	}
+	if len(webhookConfig.Webhooks) == 0 {
+		return nil, fmt.Errorf("the %s webhook configuration has no webhook", k8s.ProxyInjectorWebhookConfigName)
+	}
+
+	portForward, err := k8s.NewPortForward(k8sAPI, controlPlaneNamespace, k8s.ProxyInjectorWebhookServiceName, "localhost", 0, proxyInjectorPort, verbose)
+	if err != nil {
+		return nil, err
+	}
+	if err := portForward.Init(); err != nil {
+		return nil, err
+	}
+
+	config := rest.CopyConfig(k8sAPI.Config)
+	config.Host = "https://" + portForward.AddressAndPort()
+	config.TLSClientConfig.Insecure = false
+	config.TLSClientConfig.CAFile = ""
+	config.TLSClientConfig.CAData = webhookConfig.Webhooks[0].ClientConfig.CABundle
+	config.TLSClientConfig.ServerName = fmt.Sprintf("%s.%s.svc", k8s.ProxyInjectorWebhookServiceName, controlPlaneNamespace)
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		portForward.Stop()
+		return nil, err
+	}
+
+	return &serverSideInjector{
+		client:      &http.Client{Transport: transport},
+		url:         config.Host + inject.DryRunPath,
+		portForward: portForward,
+	}, nil
+}
+
+// dryRun returns the injection the proxy-injector would perform on the given
+// manifest
+func (s *serverSideInjector) dryRun(manifest []byte) (*inject.DryRunResponse, error) {
+	rsp, err := s.client.Post(s.url, "application/yaml", bytes.NewReader(manifest))
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the proxy-injector failed to inject the resource: %s", strings.TrimSpace(string(body)))
+	}
+
+	var dryRunRsp inject.DryRunResponse
+	if err := json.Unmarshal(body, &dryRunRsp); err != nil {
+		return nil, err
+	}
+	return &dryRunRsp, nil
+}
+
+// stop closes the port-forward to the proxy-injector
+func (s *serverSideInjector) stop() {
+	s.portForward.Stop()
+}
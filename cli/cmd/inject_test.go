@@ -9,11 +9,15 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	pcv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/proxyconfig/v1alpha1"
 	"github.com/linkerd/linkerd2/controller/gen/config"
 	pb "github.com/linkerd/linkerd2/controller/gen/config"
+	"github.com/linkerd/linkerd2/pkg/inject"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type testCase struct {
@@ -226,6 +230,20 @@ func TestUninjectAndInject(t *testing.T) {
 			injectProxy:      true,
 			testInjectConfig: defaultConfig,
 		},
+		{
+			inputFileName:    "inject_job_shutdown_coordination.input.yml",
+			goldenFileName:   "inject_job_shutdown_coordination.golden.yml",
+			reportFileName:   "inject_job_shutdown_coordination.report",
+			injectProxy:      true,
+			testInjectConfig: defaultConfig,
+		},
+		{
+			inputFileName:    "inject_job_shutdown_coordination_no_command.input.yml",
+			goldenFileName:   "inject_job_shutdown_coordination_no_command.golden.yml",
+			reportFileName:   "inject_job_shutdown_coordination_no_command.report",
+			injectProxy:      true,
+			testInjectConfig: defaultConfig,
+		},
 		{
 			inputFileName:    "inject_emojivoto_already_injected.input.yml",
 			goldenFileName:   "inject_emojivoto_already_injected.golden.yml",
@@ -412,6 +430,176 @@ func TestRunInjectCmd(t *testing.T) {
 	}
 }
 
+func TestInjectReportSources(t *testing.T) {
+	in, err := os.Open("testdata/inject_emojivoto_deployment.input.yml")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	transformer := &resourceTransformerInject{
+		configs:       testInstallConfig(),
+		reportSources: true,
+		proxyConfigs: map[string][]*pcv1alpha1.ProxyConfig{
+			"emojivoto": {
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: "emojivoto"},
+					Spec: pcv1alpha1.ProxyConfigSpec{
+						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web-svc"}},
+						LogLevel:    "debug",
+					},
+				},
+			},
+		},
+		nsAnnotations: map[string]map[string]string{
+			"emojivoto": {k8s.ProxyAdminPortAnnotation: "9191"},
+		},
+	}
+
+	verbose = false
+	errBuffer := &bytes.Buffer{}
+	if exitCode := runInjectCmd([]io.Reader{in}, errBuffer, ioutil.Discard, transformer); exitCode != 0 {
+		t.Fatalf("Expected exit code to be 0 but got: %d", exitCode)
+	}
+
+	expected := `
+deployment "web" injected
+
+deployment "web" proxy configuration:
+  config.linkerd.io/admin-port: 9191 (namespace annotation)
+  config.linkerd.io/proxy-log-level: debug (ProxyConfig debug)
+  other settings: global config
+
+`
+	if actual := errBuffer.String(); actual != expected {
+		t.Fatalf("Expected report:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestInjectOutboundOnly(t *testing.T) {
+	testCases := []struct {
+		nsAnnotations map[string]string
+		input         string
+		expected      string
+	}{
+		{
+			input:    "inject_emojivoto_daemonset_outbound_only.input.yml",
+			expected: "\ndaemonset \"agent\" injected in outbound-only mode\n\n",
+		},
+		{
+			input:    "inject_emojivoto_deployment_hostNetwork_true.input.yml",
+			expected: "\n‼ \"hostNetwork: true\" detected in deployment/web\n‼ no supported objects found\n\ndeployment \"web\" skipped\n\n",
+		},
+		{
+			nsAnnotations: map[string]string{k8s.ProxyOutboundOnlyAnnotation: "true"},
+			input:         "inject_emojivoto_deployment_hostNetwork_true.input.yml",
+			expected:      "\n‼ outbound-only mode isn't supported with \"hostNetwork: true\" in deployment/web\n‼ no supported objects found\n\ndeployment \"web\" skipped\n\n",
+		},
+	}
+
+	verbose = false
+	for i, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("%d: %s", i, tc.input), func(t *testing.T) {
+			in, err := os.Open(fmt.Sprintf("testdata/%s", tc.input))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			transformer := &resourceTransformerInject{
+				configs:       testInstallConfig(),
+				nsAnnotations: map[string]map[string]string{"emojivoto": tc.nsAnnotations},
+			}
+			errBuffer := &bytes.Buffer{}
+			runInjectCmd([]io.Reader{in}, errBuffer, ioutil.Discard, transformer)
+			if actual := errBuffer.String(); actual != tc.expected {
+				t.Fatalf("Expected report:\n%s\ngot:\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInjectInjectionPolicy(t *testing.T) {
+	configs := testInstallConfig()
+	configs.Proxy.InjectionPolicy = &pb.InjectionPolicy{
+		Exclude: []*pb.InjectionRule{
+			{NamespaceSelector: "env=prod", OwnerNames: []string{"web*"}},
+		},
+	}
+
+	testCases := []struct {
+		nsLabels map[string]string
+		expected string
+	}{
+		{
+			nsLabels: map[string]string{"env": "dev"},
+			expected: "\ndeployment \"web\" injected\n\n",
+		},
+		{
+			nsLabels: map[string]string{"env": "prod"},
+			expected: "\n‼ the injection policy of the cluster excludes deployment/web\n‼ no supported objects found\n\ndeployment \"web\" skipped\n\n",
+		},
+	}
+
+	verbose = false
+	for i, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("test case #%d", i), func(t *testing.T) {
+			in, err := os.Open("testdata/inject_emojivoto_deployment.input.yml")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			transformer := &resourceTransformerInject{
+				configs:  configs,
+				nsLabels: map[string]map[string]string{"emojivoto": tc.nsLabels},
+			}
+			errBuffer := &bytes.Buffer{}
+			runInjectCmd([]io.Reader{in}, errBuffer, ioutil.Discard, transformer)
+			if actual := errBuffer.String(); actual != tc.expected {
+				t.Fatalf("Expected report:\n%s\ngot:\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInjectServerSide(t *testing.T) {
+	dryRun := func(manifest []byte) (*inject.DryRunResponse, error) {
+		// the resource is annotated before being sent to the proxy-injector
+		if !strings.Contains(string(manifest), `"linkerd.io/inject":"enabled"`) {
+			return nil, fmt.Errorf("the resource isn't annotated: %s", manifest)
+		}
+		return &inject.DryRunResponse{
+			Patch:  []byte(`[{"op":"add","path":"/spec/template/spec/containers/-","value":{"name":"linkerd-proxy","image":"proxy"}}]`),
+			Report: inject.Report{Kind: "deployment", Name: "web"},
+		}, nil
+	}
+
+	in, err := os.Open("testdata/inject_emojivoto_deployment.input.yml")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	transformer := &resourceTransformerInject{
+		configs:    testInstallConfig(),
+		serverSide: dryRun,
+	}
+	errBuffer := &bytes.Buffer{}
+	outBuffer := &bytes.Buffer{}
+	verbose = false
+	if exitCode := runInjectCmd([]io.Reader{in}, errBuffer, outBuffer, transformer); exitCode != 0 {
+		t.Fatalf("Unexpected exit code %d: %s", exitCode, errBuffer)
+	}
+
+	if expected := "\ndeployment \"web\" injected\n\n"; errBuffer.String() != expected {
+		t.Fatalf("Expected report:\n%s\ngot:\n%s", expected, errBuffer)
+	}
+	for _, expected := range []string{"linkerd.io/inject: enabled", "name: linkerd-proxy"} {
+		if !strings.Contains(outBuffer.String(), expected) {
+			t.Fatalf("Expected the output to contain %q, got:\n%s", expected, outBuffer)
+		}
+	}
+}
+
 type injectFilePath struct {
 	resource     string
 	resourceFile string
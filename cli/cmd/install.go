@@ -4,16 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/config"
 	"github.com/linkerd/linkerd2/pkg/charts"
 	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/inject"
 	"github.com/linkerd/linkerd2/pkg/issuercerts"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	consts "github.com/linkerd/linkerd2/pkg/k8s"
@@ -51,6 +54,8 @@ type (
 		omitWebhookSideEffects      bool
 		restrictDashboardPrivileges bool
 		controlPlaneTracing         bool
+		controlPlaneMTLS            bool
+		injectionPolicyFile         string
 		identityOptions             *installIdentityOptions
 		*proxyConfigOptions
 
@@ -64,11 +69,15 @@ type (
 		replicas    uint
 		trustDomain string
 
-		issuanceLifetime   time.Duration
-		clockSkewAllowance time.Duration
+		issuanceLifetime    time.Duration
+		maxIssuanceLifetime time.Duration
+		clockSkewAllowance  time.Duration
 
 		trustPEMFile, crtPEMFile, keyPEMFile string
 		identityExternalIssuer               bool
+
+		tokenAudience    string
+		spiffeIdentities bool
 	}
 
 	// helper struct to move those values together
@@ -76,6 +85,7 @@ type (
 		Identity        *l5dcharts.Identity
 		TrustAnchorsPEM string
 		TrustDomain     string
+		TokenAudience   string
 	}
 )
 
@@ -114,12 +124,15 @@ var (
 	templatesConfigStage = []string{
 		"templates/namespace.yaml",
 		"templates/identity-rbac.yaml",
+		"templates/canary-rbac.yaml",
 		"templates/controller-rbac.yaml",
 		"templates/destination-rbac.yaml",
 		"templates/heartbeat-rbac.yaml",
 		"templates/web-rbac.yaml",
 		"templates/serviceprofile-crd.yaml",
 		"templates/trafficsplit-crd.yaml",
+		"templates/serverauthorization-crd.yaml",
+		"templates/proxyconfig-crd.yaml",
 		"templates/prometheus-rbac.yaml",
 		"templates/grafana-rbac.yaml",
 		"templates/proxy-injector-rbac.yaml",
@@ -136,6 +149,7 @@ var (
 		"templates/_nodeselector.tpl",
 		"templates/config.yaml",
 		"templates/identity.yaml",
+		"templates/canary.yaml",
 		"templates/controller.yaml",
 		"templates/destination.yaml",
 		"templates/heartbeat.yaml",
@@ -185,6 +199,7 @@ func newInstallOptionsWithDefaults() (*installOptions, error) {
 		omitWebhookSideEffects:      defaults.OmitWebhookSideEffects,
 		restrictDashboardPrivileges: defaults.RestrictDashboardPrivileges,
 		controlPlaneTracing:         defaults.Global.ControlPlaneTracing,
+		controlPlaneMTLS:            defaults.ControlPlaneMTLS,
 		proxyConfigOptions: &proxyConfigOptions{
 			proxyVersion:           version.Version,
 			ignoreCluster:          false,
@@ -412,6 +427,10 @@ func (options *installOptions) validateAndBuild(stage string, flags *pflag.FlagS
 		return nil, nil, err
 	}
 	configs := options.configs(toIdentityContext(identityValues))
+	configs.Proxy.InjectionPolicy, err = options.injectionPolicy()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	values, err := options.buildValuesWithoutIdentity(configs)
 	if err != nil {
@@ -420,6 +439,7 @@ func (options *installOptions) validateAndBuild(stage string, flags *pflag.FlagS
 	values.Identity = identityValues.Identity
 	values.Global.IdentityTrustAnchorsPEM = identityValues.TrustAnchorsPEM
 	values.Global.IdentityTrustDomain = identityValues.TrustDomain
+	values.Global.IdentityTokenAudience = identityValues.TokenAudience
 	values.Stage = stage
 
 	return values, configs, nil
@@ -465,6 +485,10 @@ func (options *installOptions) recordableFlagSet() *pflag.FlagSet {
 		&options.disableHeartbeat, "disable-heartbeat", options.disableHeartbeat,
 		"Disables the heartbeat cronjob (default false)",
 	)
+	flags.BoolVar(
+		&options.controlPlaneMTLS, "control-plane-mtls", options.controlPlaneMTLS,
+		"Secure the calls between the web, public API, Prometheus and tap components with mTLS, using certificates issued by the identity service (default false)",
+	)
 	flags.DurationVar(
 		&options.identityOptions.issuanceLifetime, "identity-issuance-lifetime", options.identityOptions.issuanceLifetime,
 		"The amount of time for which the Identity issuer should certify identity",
@@ -493,6 +517,10 @@ func (options *installOptions) recordableFlagSet() *pflag.FlagSet {
 		&options.identityOptions.trustPEMFile, "identity-trust-anchors-file", options.identityOptions.trustPEMFile,
 		"A path to a PEM-encoded file containing Linkerd Identity trust anchors (generated by default)",
 	)
+	flags.StringVar(
+		&options.injectionPolicyFile, "injection-policy-file", options.injectionPolicyFile,
+		"A path to a YAML file holding the include and exclude rules of the proxy injector's injection policy (kept as is on upgrade by default)",
+	)
 	flags.StringVarP(&options.controlPlaneVersion, "control-plane-version", "", options.controlPlaneVersion, "(Development) Tag to be used for the control plane component images")
 	flags.MarkHidden("control-plane-version")
 	flags.MarkHidden("control-plane-tracing")
@@ -534,6 +562,18 @@ func (options *installOptions) installOnlyFlagSet() *pflag.FlagSet {
 		&options.identityOptions.identityExternalIssuer, "identity-external-issuer", options.identityOptions.identityExternalIssuer,
 		"Whether to use an external identity issuer (default false)",
 	)
+	flags.StringVar(
+		&options.identityOptions.tokenAudience, "identity-token-audience", options.identityOptions.tokenAudience,
+		"Audience of the projected service account tokens the proxies authenticate with (the legacy service account tokens are used by default)",
+	)
+	flags.BoolVar(
+		&options.identityOptions.spiffeIdentities, "identity-spiffe-ids", options.identityOptions.spiffeIdentities,
+		"Also include the SPIFFE ID of the proxies in their certificates, as a URI SAN (default false)",
+	)
+	flags.DurationVar(
+		&options.identityOptions.maxIssuanceLifetime, "identity-max-issuance-lifetime", options.identityOptions.maxIssuanceLifetime,
+		fmt.Sprintf("Upper bound of the issuance lifetimes set with the %s annotation on service accounts and namespaces (defaults to --identity-issuance-lifetime)", k8s.IdentityIssuanceLifetimeAnnotation),
+	)
 	return flags
 }
 
@@ -558,7 +598,7 @@ func (options *installOptions) recordFlags(flags *pflag.FlagSet) {
 	flags.VisitAll(func(f *pflag.Flag) {
 		if f.Changed {
 			switch f.Name {
-			case "ignore-cluster", "control-plane-version", "proxy-version", "identity-issuer-certificate-file", "identity-issuer-key-file", "identity-trust-anchors-file":
+			case "ignore-cluster", "control-plane-version", "proxy-version", "identity-issuer-certificate-file", "identity-issuer-key-file", "identity-trust-anchors-file", "injection-policy-file":
 				// These flags don't make sense to record.
 			default:
 				options.recordedFlags = append(options.recordedFlags, &pb.Install_Flag{
@@ -670,6 +710,7 @@ func (options *installOptions) buildValuesWithoutIdentity(configs *pb.All) (*l5d
 	installValues.ControllerUID = options.controllerUID
 	installValues.Global.ControlPlaneTracing = options.controlPlaneTracing
 	installValues.EnableH2Upgrade = !options.disableH2Upgrade
+	installValues.ControlPlaneMTLS = options.controlPlaneMTLS
 	installValues.EnablePodAntiAffinity = options.highAvailability
 	installValues.Global.HighAvailability = options.highAvailability
 	installValues.Global.ImagePullPolicy = options.imagePullPolicy
@@ -859,6 +900,31 @@ func (options *installOptions) proxyConfig() *pb.Proxy {
 	}
 }
 
+// injectionPolicy reads the injection policy from the file of the
+// --injection-policy-file flag, if set
+func (options *installOptions) injectionPolicy() (*pb.InjectionPolicy, error) {
+	if options.injectionPolicyFile == "" {
+		return nil, nil
+	}
+
+	policyYAML, err := ioutil.ReadFile(options.injectionPolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	policyJSON, err := yaml.YAMLToJSON(policyYAML)
+	if err != nil {
+		return nil, fmt.Errorf("invalid injection policy in %s: %s", options.injectionPolicyFile, err)
+	}
+	policy := &pb.InjectionPolicy{}
+	if err := jsonpb.UnmarshalString(string(policyJSON), policy); err != nil {
+		return nil, fmt.Errorf("invalid injection policy in %s: %s", options.injectionPolicyFile, err)
+	}
+	if err := inject.ValidateInjectionPolicy(policy); err != nil {
+		return nil, fmt.Errorf("invalid injection policy in %s: %s", options.injectionPolicyFile, err)
+	}
+	return policy, nil
+}
+
 func errAfterRunningChecks(options *installOptions) error {
 	checks := []healthcheck.CategoryID{
 		healthcheck.KubernetesAPIChecks,
@@ -955,6 +1021,10 @@ func (idopts *installIdentityOptions) validate() error {
 		}
 	}
 
+	if idopts.maxIssuanceLifetime != 0 && idopts.maxIssuanceLifetime < idopts.issuanceLifetime {
+		return fmt.Errorf("--identity-max-issuance-lifetime (%s) must not be shorter than --identity-issuance-lifetime (%s)", idopts.maxIssuanceLifetime, idopts.issuanceLifetime)
+	}
+
 	if idopts.identityExternalIssuer {
 
 		if idopts.crtPEMFile != "" {
@@ -998,13 +1068,25 @@ func (idopts *installIdentityOptions) validateAndBuild() (*identityWithAnchorsAn
 		return nil, err
 	}
 
+	var idvals *identityWithAnchorsAndTrustDomain
+	var err error
 	if idopts.identityExternalIssuer {
-		return idopts.readExternallyManaged()
+		idvals, err = idopts.readExternallyManaged()
 	} else if idopts.trustPEMFile != "" && idopts.crtPEMFile != "" && idopts.keyPEMFile != "" {
-		return idopts.readValues()
+		idvals, err = idopts.readValues()
 	} else {
-		return idopts.genValues()
+		idvals, err = idopts.genValues()
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	idvals.TokenAudience = idopts.tokenAudience
+	idvals.Identity.SPIFFEIdentities = idopts.spiffeIdentities
+	if idopts.maxIssuanceLifetime != 0 {
+		idvals.Identity.Issuer.MaxIssuanceLifetime = idopts.maxIssuanceLifetime.String()
+	}
+	return idvals, nil
 }
 
 func (idopts *installIdentityOptions) issuerName() string {
@@ -1110,16 +1192,24 @@ func toIdentityContext(idvals *identityWithAnchorsAndTrustDomain) *pb.IdentityCo
 		il = defaultIdentityIssuanceLifetime
 	}
 
+	mil, err := time.ParseDuration(idvals.Identity.Issuer.MaxIssuanceLifetime)
+	if err != nil {
+		mil = il
+	}
+
 	csa, err := time.ParseDuration(idvals.Identity.Issuer.ClockSkewAllowance)
 	if err != nil {
 		csa = defaultIdentityClockSkewAllowance
 	}
 
 	return &pb.IdentityContext{
-		TrustDomain:        idvals.TrustDomain,
-		TrustAnchorsPem:    idvals.TrustAnchorsPEM,
-		IssuanceLifetime:   ptypes.DurationProto(il),
-		ClockSkewAllowance: ptypes.DurationProto(csa),
-		Scheme:             idvals.Identity.Issuer.Scheme,
+		TrustDomain:         idvals.TrustDomain,
+		TrustAnchorsPem:     idvals.TrustAnchorsPEM,
+		IssuanceLifetime:    ptypes.DurationProto(il),
+		ClockSkewAllowance:  ptypes.DurationProto(csa),
+		Scheme:              idvals.Identity.Issuer.Scheme,
+		TokenAudience:       idvals.TokenAudience,
+		SpiffeIdentities:    idvals.Identity.SPIFFEIdentities,
+		MaxIssuanceLifetime: ptypes.DurationProto(mil),
 	}
 }
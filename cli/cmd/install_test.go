@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/linkerd/linkerd2/controller/gen/config"
 	pb "github.com/linkerd/linkerd2/controller/gen/config"
 	charts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
@@ -267,6 +269,55 @@ func TestValidateAndBuild_Errors(t *testing.T) {
 			t.Fatal("expected error but got nothing")
 		}
 	})
+
+	t.Run("Fails validation for a max issuance lifetime shorter than the issuance lifetime", func(t *testing.T) {
+		installOptions, err := testInstallOptions()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v\n", err)
+		}
+		installOptions.identityOptions.maxIssuanceLifetime = time.Hour
+		_, _, err = installOptions.validateAndBuild("", nil)
+		if err == nil {
+			t.Fatal("expected error but got nothing")
+		}
+	})
+
+	t.Run("Fails validation for an injection policy with an unsupported kind", func(t *testing.T) {
+		installOptions, err := testInstallOptions()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v\n", err)
+		}
+		installOptions.injectionPolicyFile = filepath.Join("testdata", "injection_policy_invalid.yaml")
+		_, _, err = installOptions.validateAndBuild("", nil)
+		if err == nil {
+			t.Fatal("expected error but got nothing")
+		}
+	})
+}
+
+func TestInjectionPolicyFile(t *testing.T) {
+	installOptions, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	installOptions.injectionPolicyFile = filepath.Join("testdata", "injection_policy.yaml")
+	_, configs, err := installOptions.validateAndBuild("", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+
+	expected := &pb.InjectionPolicy{
+		Include: []*pb.InjectionRule{
+			{NamespaceSelector: "env in (dev, staging)"},
+		},
+		Exclude: []*pb.InjectionRule{
+			{Kinds: []string{"job", "cronjob"}, OwnerNames: []string{"db-migration-*"}},
+			{Images: []string{"*/postgres:*"}},
+		},
+	}
+	if !proto.Equal(configs.GetProxy().GetInjectionPolicy(), expected) {
+		t.Fatalf("Expected injection policy %v, got %v", expected, configs.GetProxy().GetInjectionPolicy())
+	}
 }
 
 func testInstallOptions() (*installOptions, error) {
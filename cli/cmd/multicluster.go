@@ -0,0 +1,312 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/template"
+
+	"github.com/linkerd/linkerd2/cli/multicluster"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	mc "github.com/linkerd/linkerd2/pkg/multicluster"
+	"github.com/linkerd/linkerd2/pkg/version"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+)
+
+const defaultRemoteAccessServiceAccount = "linkerd-service-mirror-remote-access"
+
+type (
+	multiclusterInstallOptions struct {
+		controllerImage            string
+		version                    string
+		logLevel                   string
+		uid                        int64
+		eventRequeueLimit          int
+		remoteAccessServiceAccount string
+	}
+
+	multiclusterLinkOptions struct {
+		clusterName        string
+		serviceAccountName string
+	}
+)
+
+func newCmdMulticluster() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multicluster [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Manages the multicluster setup for Linkerd",
+		Long: `Manages the multicluster setup for Linkerd.
+
+This command provides subcommands to install the service mirror controller and
+to link clusters together, so that the services exported by a remote cluster
+are mirrored into the local one as <svc>-<cluster> services.`,
+	}
+
+	cmd.AddCommand(newCmdMulticlusterInstall())
+	cmd.AddCommand(newCmdMulticlusterLink())
+	cmd.AddCommand(newCmdMulticlusterUnlink())
+
+	return cmd
+}
+
+func newMulticlusterInstallOptionsWithDefault() *multiclusterInstallOptions {
+	return &multiclusterInstallOptions{
+		controllerImage:            defaultDockerRegistry + "/controller",
+		version:                    version.Version,
+		logLevel:                   "info",
+		uid:                        2103,
+		eventRequeueLimit:          3,
+		remoteAccessServiceAccount: defaultRemoteAccessServiceAccount,
+	}
+}
+
+func newCmdMulticlusterInstall() *cobra.Command {
+	options := newMulticlusterInstallOptionsWithDefault()
+
+	cmd := &cobra.Command{
+		Use:   "install [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Output Kubernetes configs to install the Linkerd multicluster components",
+		Long: `Output Kubernetes configs to install the Linkerd multicluster components.
+
+This command outputs the service mirror controller, which mirrors the services
+of the linked remote clusters, along with the service account that other
+clusters use to access this one. It should be installed on every cluster taking
+part in the multicluster setup.`,
+		Example: `  # Default install.
+  linkerd multicluster install | kubectl apply -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return renderMulticlusterInstall(os.Stdout, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.controllerImage, "controller-image", options.controllerImage, "Linkerd controller image name")
+	cmd.Flags().StringVarP(&options.version, "version", "v", options.version, "Tag to be used for the controller image")
+	cmd.Flags().StringVar(&options.logLevel, "log-level", options.logLevel, "Log level for the service mirror controller")
+	cmd.Flags().Int64Var(&options.uid, "uid", options.uid, "Run the service mirror controller under this user ID")
+	cmd.Flags().IntVar(&options.eventRequeueLimit, "event-requeue-limit", options.eventRequeueLimit, "Number of times a failed event is retried by the service mirror controller")
+	cmd.Flags().StringVar(&options.remoteAccessServiceAccount, "service-account-name", options.remoteAccessServiceAccount, "Name of the service account that remote clusters use to access this cluster")
+
+	return cmd
+}
+
+func renderMulticlusterInstall(w io.Writer, options *multiclusterInstallOptions) error {
+	if options.eventRequeueLimit < 0 {
+		return errors.New("--event-requeue-limit must not be negative")
+	}
+
+	tmpl, err := template.New("linkerd").Parse(multicluster.Template)
+	if err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"Namespace":                  controlPlaneNamespace,
+		"ControllerImage":            options.controllerImage,
+		"Version":                    options.version,
+		"LogLevel":                   options.logLevel,
+		"UID":                        options.uid,
+		"EventRequeueLimit":          options.eventRequeueLimit,
+		"RemoteAccessServiceAccount": options.remoteAccessServiceAccount,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+func newCmdMulticlusterLink() *cobra.Command {
+	options := &multiclusterLinkOptions{
+		serviceAccountName: defaultRemoteAccessServiceAccount,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "link [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Output the credentials used to link the current cluster to another one",
+		Long: `Output the credentials used to link the current cluster to another one.
+
+This command reads the token of the remote access service account installed by
+"linkerd multicluster install" in the current cluster, and outputs a Secret
+holding a kubeconfig for it. Once this Secret is applied to another cluster, the
+service mirror controller of that cluster starts mirroring the services that
+the current cluster exports through the mirror.linkerd.io/gateway-name and
+mirror.linkerd.io/gateway-ns annotations.`,
+		Example: `  # Link the "east" cluster to the "west" cluster.
+  linkerd --context=east multicluster link --cluster-name east | kubectl --context=west apply -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateClusterName(options.clusterName); err != nil {
+				return err
+			}
+
+			secret, err := buildRemoteClusterSecret(options)
+			if err != nil {
+				return err
+			}
+
+			return writeObject(os.Stdout, secret)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.clusterName, "cluster-name", options.clusterName, "Name of the current cluster, used as suffix of the mirrored services")
+	cmd.Flags().StringVar(&options.serviceAccountName, "service-account-name", options.serviceAccountName, "Name of the service account used to access the current cluster")
+
+	return cmd
+}
+
+func newCmdMulticlusterUnlink() *cobra.Command {
+	var clusterName string
+
+	cmd := &cobra.Command{
+		Use:   "unlink [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Output the credentials to delete in order to unlink a remote cluster",
+		Long: `Output the credentials to delete in order to unlink a remote cluster.
+
+Once the Secret holding the credentials of the remote cluster is deleted, the
+service mirror controller stops watching that cluster and deletes all the
+services mirrored from it.`,
+		Example: `  # Unlink the "east" cluster from the "west" cluster.
+  linkerd --context=west multicluster unlink --cluster-name east | kubectl --context=west delete -f -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateClusterName(clusterName); err != nil {
+				return err
+			}
+
+			return writeObject(os.Stdout, newUnlinkSecret(clusterName, controlPlaneNamespace))
+		},
+	}
+
+	cmd.Flags().StringVar(&clusterName, "cluster-name", clusterName, "Name of the remote cluster to unlink")
+
+	return cmd
+}
+
+func validateClusterName(clusterName string) error {
+	if clusterName == "" {
+		return errors.New("--cluster-name is required")
+	}
+	if !alphaNumDash.MatchString(clusterName) {
+		return fmt.Errorf("%s is not a valid cluster name; it must only contain alphanumeric characters and dashes", clusterName)
+	}
+	return nil
+}
+
+func buildRemoteClusterSecret(options *multiclusterLinkOptions) (*corev1.Secret, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfigPath != "" {
+		rules.ExplicitPath = kubeconfigPath
+	}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	rawConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	if kubeContext != "" {
+		rawConfig.CurrentContext = kubeContext
+	}
+
+	k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := serviceAccountToken(k8sAPI, options.serviceAccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig, err := buildServiceAccountKubeconfig(rawConfig, token)
+	if err != nil {
+		return nil, err
+	}
+
+	_, configs, err := healthcheck.FetchLinkerdConfigMap(k8sAPI, controlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+	clusterDomain := configs.GetGlobal().GetClusterDomain()
+	if clusterDomain == "" {
+		clusterDomain = defaultClusterDomain
+	}
+
+	return mc.NewRemoteClusterSecret(controlPlaneNamespace, &mc.RemoteClusterConfig{
+		ClusterName:   options.clusterName,
+		ClusterDomain: clusterDomain,
+		KubeConfig:    kubeConfig,
+	}), nil
+}
+
+func serviceAccountToken(k8sAPI *k8s.KubernetesAPI, serviceAccountName string) ([]byte, error) {
+	sa, err := k8sAPI.CoreV1().ServiceAccounts(controlPlaneNamespace).Get(serviceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range sa.Secrets {
+		secret, err := k8sAPI.CoreV1().Secrets(controlPlaneNamespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if secret.Type == corev1.SecretTypeServiceAccountToken {
+			if token, ok := secret.Data[corev1.ServiceAccountTokenKey]; ok {
+				return token, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a token for service account %s/%s", controlPlaneNamespace, serviceAccountName)
+}
+
+// buildServiceAccountKubeconfig returns a self-contained kubeconfig for the
+// current context of the given config, authenticating with the given token
+// instead of the user's credentials.
+func buildServiceAccountKubeconfig(config clientcmdapi.Config, token []byte) ([]byte, error) {
+	if err := clientcmdapi.MinifyConfig(&config); err != nil {
+		return nil, err
+	}
+	if err := clientcmdapi.FlattenConfig(&config); err != nil {
+		return nil, err
+	}
+
+	currentContext := config.Contexts[config.CurrentContext]
+	config.AuthInfos = map[string]*clientcmdapi.AuthInfo{
+		currentContext.AuthInfo: {Token: string(token)},
+	}
+
+	return clientcmd.Write(config)
+}
+
+func newUnlinkSecret(clusterName, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mc.SecretName(clusterName),
+			Namespace: namespace,
+		},
+	}
+}
+
+func writeObject(w io.Writer, obj interface{}) error {
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "---\n%s", out)
+	return err
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestRenderMulticlusterInstall(t *testing.T) {
+	options := &multiclusterInstallOptions{
+		controllerImage:            "gcr.io/linkerd-io/controller",
+		version:                    "dev-undefined",
+		logLevel:                   "info",
+		uid:                        2103,
+		eventRequeueLimit:          3,
+		remoteAccessServiceAccount: defaultRemoteAccessServiceAccount,
+	}
+
+	var buf bytes.Buffer
+	if err := renderMulticlusterInstall(&buf, options); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	diffTestdata(t, "install_multicluster.golden", buf.String())
+}
+
+func TestValidateClusterName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"east":        true,
+		"us-west-2":   true,
+		"":            false,
+		"east.remote": false,
+		"east_remote": false,
+	} {
+		err := validateClusterName(name)
+		if valid && err != nil {
+			t.Fatalf("Expected %q to be valid, got: %s", name, err)
+		}
+		if !valid && err == nil {
+			t.Fatalf("Expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestBuildServiceAccountKubeconfig(t *testing.T) {
+	config := clientcmdapi.Config{
+		CurrentContext: "east",
+		Contexts: map[string]*clientcmdapi.Context{
+			"east": {Cluster: "east-cluster", AuthInfo: "east-admin"},
+			"west": {Cluster: "west-cluster", AuthInfo: "west-admin"},
+		},
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"east-cluster": {Server: "https://east.example.com", CertificateAuthorityData: []byte("east-ca")},
+			"west-cluster": {Server: "https://west.example.com", CertificateAuthorityData: []byte("west-ca")},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"east-admin": {ClientCertificateData: []byte("cert"), ClientKeyData: []byte("key")},
+			"west-admin": {Token: "west-token"},
+		},
+	}
+
+	out, err := buildServiceAccountKubeconfig(config, []byte("sa-token"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	kubeconfig, err := clientcmd.Load(out)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(kubeconfig.Contexts) != 1 || len(kubeconfig.Clusters) != 1 || len(kubeconfig.AuthInfos) != 1 {
+		t.Fatalf("Expected a kubeconfig with a single context, got %+v", kubeconfig)
+	}
+	if server := kubeconfig.Clusters["east-cluster"].Server; server != "https://east.example.com" {
+		t.Fatalf("Expected server https://east.example.com, got %s", server)
+	}
+	authInfo := kubeconfig.AuthInfos["east-admin"]
+	if authInfo.Token != "sa-token" || len(authInfo.ClientCertificateData) != 0 {
+		t.Fatalf("Expected the service account token to be the only credentials, got %+v", authInfo)
+	}
+}
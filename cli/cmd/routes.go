@@ -28,6 +28,9 @@ type routeRowStats struct {
 	rowStats
 	actualRequestRate float64
 	actualSuccessRate float64
+	retries           uint64
+	budgetExhausted   uint64
+	gatewayTimeouts   uint64
 	hasRequestData    bool
 }
 
@@ -125,6 +128,9 @@ func writeRouteStatsToBuffer(resp *pb.TopRoutesResponse, w *tabwriter.Writer, op
 					},
 					actualRequestRate: getRequestRate(r.Stats.GetActualSuccessCount(), r.Stats.GetActualFailureCount(), r.TimeWindow),
 					actualSuccessRate: getSuccessRate(r.Stats.GetActualSuccessCount(), r.Stats.GetActualFailureCount()),
+					retries:           r.Stats.GetRetryCount(),
+					budgetExhausted:   r.Stats.GetRetryBudgetExhaustedCount(),
+					gatewayTimeouts:   r.Stats.GetGatewayTimeoutCount(),
 					hasRequestData:    statHasRequestData(r.Stats),
 				})
 			}
@@ -177,6 +183,9 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 			"EFFECTIVE_RPS",
 			"ACTUAL_SUCCESS",
 			"ACTUAL_RPS",
+			"RETRIES",
+			"BUDGET_EXHAUSTED",
+			"504_RESPONSES",
 		}...)
 	} else {
 		headers = append(headers, []string{
@@ -196,13 +205,14 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 	// route, success rate, rps
 	templateString := routeTemplate + "\t%s\t%.2f%%\t%.1frps\t"
 	if outputActual {
-		// actual success rate, actual rps
-		templateString = templateString + "%.2f%%\t%.1frps\t"
+		// actual success rate, actual rps, retries, retries skipped, 504 responses
+		templateString = templateString + "%.2f%%\t%.1frps\t%d\t%d\t%d\t"
 	}
 	// p50, p95, p99
 	templateString = templateString + "%dms\t%dms\t%dms\t\n"
 
-	emptyTemplateString := routeTemplate + "\t%s\t-\t-\t-\t-\t-\t\n"
+	// one dash per stat column
+	emptyTemplateString := routeTemplate + "\t%s\t" + strings.Repeat("-\t", len(headers)-2) + "\n"
 	for _, row := range stats {
 
 		values := []interface{}{
@@ -220,6 +230,9 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 				values = append(values, []interface{}{
 					row.actualSuccessRate * 100,
 					row.actualRequestRate,
+					row.retries,
+					row.budgetExhausted,
+					row.gatewayTimeouts,
 				}...)
 			}
 			values = append(values, []interface{}{
@@ -246,6 +259,9 @@ type JSONRouteStats struct {
 	EffectiveRps     *float64 `json:"effective_rps,omitempty"`
 	ActualSuccess    *float64 `json:"actual_success,omitempty"`
 	ActualRps        *float64 `json:"actual_rps,omitempty"`
+	Retries          *uint64  `json:"retries,omitempty"`
+	BudgetExhausted  *uint64  `json:"retry_budget_exhausted,omitempty"`
+	GatewayTimeouts  *uint64  `json:"504_responses,omitempty"`
 	LatencyMSp50     *uint64  `json:"latency_ms_p50"`
 	LatencyMSp95     *uint64  `json:"latency_ms_p95"`
 	LatencyMSp99     *uint64  `json:"latency_ms_p99"`
@@ -267,6 +283,9 @@ func printRouteJSON(tables map[string][]*routeRowStats, w *tabwriter.Writer, opt
 				entry.EffectiveRps = &row.requestRate
 				entry.ActualSuccess = &row.actualSuccessRate
 				entry.ActualRps = &row.actualRequestRate
+				entry.Retries = &row.retries
+				entry.BudgetExhausted = &row.budgetExhausted
+				entry.GatewayTimeouts = &row.gatewayTimeouts
 			} else {
 				entry.Success = &row.successRate
 				entry.Rps = &row.requestRate
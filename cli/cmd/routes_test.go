@@ -4,12 +4,14 @@ import (
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
 )
 
 type routesParamsExp struct {
 	options *routesOptions
 	routes  []string
 	counts  []uint64
+	retries bool
 	file    string
 }
 
@@ -33,12 +35,60 @@ func TestRoutes(t *testing.T) {
 			file:    "routes_one_output_json.golden",
 		}, t)
 	})
+
+	outboundOptions := newRoutesOptions()
+	outboundOptions.toResource = "deploy/books"
+	outboundOptions.outputFormat = wideOutput
+	t.Run("Returns outbound route stats with retries (wide)", func(t *testing.T) {
+		testRoutesCall(routesParamsExp{
+			routes:  []string{"/a", "/b", "/c"},
+			counts:  []uint64{90, 60, 0, 30},
+			retries: true,
+			options: outboundOptions,
+			file:    "routes_outbound_output_wide.golden",
+		}, t)
+	})
+
+	outboundJSONOptions := newRoutesOptions()
+	outboundJSONOptions.toResource = "deploy/books"
+	outboundJSONOptions.outputFormat = jsonOutput
+	t.Run("Returns outbound route stats with retries (json)", func(t *testing.T) {
+		testRoutesCall(routesParamsExp{
+			routes:  []string{"/a", "/b", "/c"},
+			counts:  []uint64{90, 60, 0, 30},
+			retries: true,
+			options: outboundJSONOptions,
+			file:    "routes_outbound_output_json.golden",
+		}, t)
+	})
+}
+
+// addRetryStats simulates retries on the routes that received requests: a
+// tenth of the requests were retried, some retries were skipped because of
+// the retry budget, and a few responses were 504s.
+func addRetryStats(resp *pb.TopRoutesResponse) {
+	for _, table := range resp.GetOk().GetRoutes() {
+		for _, row := range table.GetRows() {
+			stats := row.GetStats()
+			if stats.GetSuccessCount() == 0 {
+				continue
+			}
+			stats.RetryCount = stats.GetSuccessCount() / 10
+			stats.ActualSuccessCount = stats.GetSuccessCount() - stats.GetRetryCount()
+			stats.ActualFailureCount = 2 * stats.GetRetryCount()
+			stats.RetryBudgetExhaustedCount = stats.GetRetryCount() / 3
+			stats.GatewayTimeoutCount = 1
+		}
+	}
 }
 
 func testRoutesCall(exp routesParamsExp, t *testing.T) {
 	mockClient := &public.MockAPIClient{}
 
 	response := public.GenTopRoutesResponse(exp.routes, exp.counts, exp.options.toResource != "", "foobar")
+	if exp.retries {
+		addRetryStats(&response)
+	}
 
 	mockClient.TopRoutesResponseToReturn = &response
 
@@ -303,6 +303,9 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 		}
 
 		meshedCount := fmt.Sprintf("%d/%d", r.MeshedPodCount, r.RunningPodCount)
+		if r.OutboundOnlyPodCount > 0 {
+			meshedCount = fmt.Sprintf("%s (%d outbound-only)", meshedCount, r.OutboundOnlyPodCount)
+		}
 		if resourceKey == k8s.Authority {
 			meshedCount = "-"
 		}
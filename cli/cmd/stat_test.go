@@ -29,6 +29,20 @@ func TestStat(t *testing.T) {
 		}, k8s.Namespace, t)
 	})
 
+	t.Run("Returns namespace stats with outbound-only pods", func(t *testing.T) {
+		testStatCall(paramsExp{
+			counts: &public.PodCounts{
+				MeshedPods:       2,
+				OutboundOnlyPods: 1,
+				RunningPods:      2,
+				FailedPods:       0,
+			},
+			options: options,
+			resNs:   []string{"emojivoto1"},
+			file:    "stat_one_outbound_only_output.golden",
+		}, k8s.Namespace, t)
+	})
+
 	t.Run("Returns pod stats", func(t *testing.T) {
 		testStatCall(paramsExp{
 			counts: &public.PodCounts{
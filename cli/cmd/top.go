@@ -537,11 +537,11 @@ func newRow(req topRequest) (tableRow, error) {
 		route = public.DefaultRouteName
 	}
 	method := req.reqInit.GetMethod().GetRegistered().String()
-	source := stripPort(addr.PublicAddressToString(req.event.GetSource()))
+	source := addr.PublicIPToString(req.event.GetSource().GetIp())
 	if pod := req.event.SourceMeta.Labels["pod"]; pod != "" {
 		source = pod
 	}
-	destination := stripPort(addr.PublicAddressToString(req.event.GetDestination()))
+	destination := addr.PublicIPToString(req.event.GetDestination().GetIp())
 	if pod := req.event.DestinationMeta.Labels["pod"]; pod != "" {
 		destination = pod
 	}
@@ -626,10 +626,6 @@ func (t *topTable) insert(req topRequest) {
 	}
 }
 
-func stripPort(address string) string {
-	return strings.Split(address, ":")[0]
-}
-
 func (t *topTable) renderHeaders(scrollpos int) {
 	tbprint(0, 0, "(press q to quit)")
 	tbprint(0, 1, "(press a/LeftArrowKey to scroll left, d/RightArrowKey to scroll right)")
@@ -238,6 +238,14 @@ func (options *upgradeOptions) validateAndBuild(stage string, k kubernetes.Inter
 	// The overrideConfigs() is used to override proxy configs only.
 	options.overrideConfigs(configs, map[string]string{})
 
+	// The injection policy is only replaced when a new one is provided.
+	if options.injectionPolicyFile != "" {
+		configs.Proxy.InjectionPolicy, err = options.injectionPolicy()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Override configs with upgrade CLI options.
 	if options.controlPlaneVersion != "" {
 		configs.GetGlobal().Version = options.controlPlaneVersion
@@ -286,6 +294,11 @@ func (options *upgradeOptions) validateAndBuild(stage string, k kubernetes.Inter
 		if err != nil {
 			return nil, nil, err
 		}
+		// Configs from before the lifetime annotations were supported have no
+		// upper bound, which defaults to the issuance lifetime.
+		if idctx.MaxIssuanceLifetime == nil {
+			idctx.MaxIssuanceLifetime = idctx.GetIssuanceLifetime()
+		}
 	}
 
 	// Values have to be generated after any missing identity is generated,
@@ -297,6 +310,7 @@ func (options *upgradeOptions) validateAndBuild(stage string, k kubernetes.Inter
 	values.Identity = identity.Identity
 	values.Global.IdentityTrustAnchorsPEM = identity.TrustAnchorsPEM
 	values.Global.IdentityTrustDomain = identity.TrustDomain
+	values.Global.IdentityTokenAudience = identity.TokenAudience
 	// we need to do that if we have updated the anchors as the config map json has already been generated
 	if values.Global.IdentityTrustAnchorsPEM != configs.Global.IdentityContext.TrustAnchorsPem {
 		// override the anchors in config
@@ -469,6 +483,7 @@ func (options *upgradeOptions) fetchIdentityValues(k kubernetes.Interface, idctx
 	return &identityWithAnchorsAndTrustDomain{
 		TrustDomain:     idctx.GetTrustDomain(),
 		TrustAnchorsPEM: trustAnchorsPEM,
+		TokenAudience:   idctx.GetTokenAudience(),
 		Identity: &charts.Identity{
 
 			Issuer: &charts.Issuer{
@@ -482,6 +497,7 @@ func (options *upgradeOptions) fetchIdentityValues(k kubernetes.Interface, idctx
 					CrtPEM: issuerData.IssuerCrt,
 				},
 			},
+			SPIFFEIdentities: idctx.GetSpiffeIdentities(),
 		},
 	}, nil
 
@@ -0,0 +1,161 @@
+package multicluster
+
+// Template provides the base template for the `linkerd multicluster install`
+// command. It contains both the service mirror controller, which watches the
+// linked remote clusters, and the service account that linked clusters use to
+// access this one.
+const Template = `---
+###
+### Service Mirror
+###
+---
+kind: ClusterRole
+apiVersion: rbac.authorization.k8s.io/v1
+metadata:
+  name: linkerd-{{.Namespace}}-service-mirror
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+rules:
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["list"]
+- apiGroups: [""]
+  resources: ["services", "endpoints"]
+  verbs: ["list", "get", "watch", "create", "update", "delete"]
+- apiGroups: [""]
+  resources: ["namespaces"]
+  verbs: ["get", "create"]
+---
+kind: ClusterRoleBinding
+apiVersion: rbac.authorization.k8s.io/v1
+metadata:
+  name: linkerd-{{.Namespace}}-service-mirror
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: linkerd-{{.Namespace}}-service-mirror
+subjects:
+- kind: ServiceAccount
+  name: linkerd-service-mirror
+  namespace: {{.Namespace}}
+---
+kind: Role
+apiVersion: rbac.authorization.k8s.io/v1
+metadata:
+  name: linkerd-service-mirror
+  namespace: {{.Namespace}}
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+rules:
+- apiGroups: [""]
+  resources: ["secrets"]
+  verbs: ["list", "get", "watch"]
+---
+kind: RoleBinding
+apiVersion: rbac.authorization.k8s.io/v1
+metadata:
+  name: linkerd-service-mirror
+  namespace: {{.Namespace}}
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: linkerd-service-mirror
+subjects:
+- kind: ServiceAccount
+  name: linkerd-service-mirror
+  namespace: {{.Namespace}}
+---
+kind: ServiceAccount
+apiVersion: v1
+metadata:
+  name: linkerd-service-mirror
+  namespace: {{.Namespace}}
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+---
+kind: Deployment
+apiVersion: apps/v1
+metadata:
+  name: linkerd-service-mirror
+  namespace: {{.Namespace}}
+  labels:
+    app.kubernetes.io/name: service-mirror
+    app.kubernetes.io/part-of: Linkerd
+    app.kubernetes.io/version: {{.Version}}
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      linkerd.io/control-plane-component: service-mirror
+  template:
+    metadata:
+      labels:
+        linkerd.io/control-plane-component: service-mirror
+    spec:
+      serviceAccountName: linkerd-service-mirror
+      containers:
+      - name: service-mirror
+        image: {{.ControllerImage}}:{{.Version}}
+        args:
+        - service-mirror
+        - -namespace={{.Namespace}}
+        - -event-requeue-limit={{.EventRequeueLimit}}
+        - -log-level={{.LogLevel}}
+        ports:
+        - name: admin-http
+          containerPort: 9999
+        securityContext:
+          runAsUser: {{.UID}}
+---
+###
+### Remote Access
+###
+---
+kind: ServiceAccount
+apiVersion: v1
+metadata:
+  name: {{.RemoteAccessServiceAccount}}
+  namespace: {{.Namespace}}
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+---
+kind: ClusterRole
+apiVersion: rbac.authorization.k8s.io/v1
+metadata:
+  name: linkerd-{{.Namespace}}-service-mirror-remote-access
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+rules:
+- apiGroups: [""]
+  resources: ["services", "endpoints"]
+  verbs: ["list", "get", "watch"]
+---
+kind: ClusterRoleBinding
+apiVersion: rbac.authorization.k8s.io/v1
+metadata:
+  name: linkerd-{{.Namespace}}-service-mirror-remote-access
+  labels:
+    linkerd.io/control-plane-component: service-mirror
+    linkerd.io/control-plane-ns: {{.Namespace}}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: linkerd-{{.Namespace}}-service-mirror-remote-access
+subjects:
+- kind: ServiceAccount
+  name: {{.RemoteAccessServiceAccount}}
+  namespace: {{.Namespace}}
+`
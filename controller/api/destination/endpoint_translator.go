@@ -9,16 +9,30 @@ import (
 	"github.com/linkerd/linkerd2/pkg/addr"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 )
 
-const defaultWeight uint32 = 10000
+const (
+	defaultWeight uint32 = 10000
+
+	// preferredZoneWeight is given to the endpoints that are in the same zone
+	// as the client, when the service has topology-aware routing enabled.
+	preferredZoneWeight uint32 = 10 * defaultWeight
+)
 
 // endpointTranslator satisfies EndpointUpdateListener and translates updates
 // into Destination.Get messages.
+//
+// If clientZone is not empty, the endpoints whose node is in that zone are
+// given a higher weight than the rest. The zone of each endpoint is looked up
+// through the nodes lister.
 type endpointTranslator struct {
 	controllerNS        string
 	identityTrustDomain string
 	enableH2Upgrade     bool
+	clientZone          string
+	nodes               corelisters.NodeLister
 	stream              pb.Destination_GetServer
 	log                 *logging.Entry
 }
@@ -28,6 +42,8 @@ func newEndpointTranslator(
 	identityTrustDomain string,
 	enableH2Upgrade bool,
 	service string,
+	clientZone string,
+	nodes corelisters.NodeLister,
 	stream pb.Destination_GetServer,
 	log *logging.Entry,
 ) *endpointTranslator {
@@ -36,7 +52,7 @@ func newEndpointTranslator(
 		"service":   service,
 	})
 
-	return &endpointTranslator{controllerNS, identityTrustDomain, enableH2Upgrade, stream, log}
+	return &endpointTranslator{controllerNS, identityTrustDomain, enableH2Upgrade, clientZone, nodes, stream, log}
 }
 
 func (et *endpointTranslator) Add(set watcher.PodSet) {
@@ -119,7 +135,7 @@ func (et *endpointTranslator) NoEndpoints(exists bool) {
 }
 
 func (et *endpointTranslator) toAddr(address watcher.Address) (*net.TcpAddress, error) {
-	ip, err := addr.ParseProxyIPV4(address.IP)
+	ip, err := addr.ParseProxyIP(address.IP)
 	if err != nil {
 		return nil, err
 	}
@@ -172,9 +188,39 @@ func (et *endpointTranslator) toWeightedAddr(address watcher.Address) (*pb.Weigh
 
 	return &pb.WeightedAddr{
 		Addr:         tcpAddr,
-		Weight:       defaultWeight,
+		Weight:       et.weight(address.Pod),
 		MetricLabels: labels,
 		TlsIdentity:  identity,
 		ProtocolHint: hint,
 	}, nil
 }
+
+// weight returns preferredZoneWeight if the pod runs in the same zone as the
+// client, and defaultWeight otherwise.
+func (et *endpointTranslator) weight(pod *corev1.Pod) uint32 {
+	if et.clientZone == "" || et.nodes == nil || pod.Spec.NodeName == "" {
+		return defaultWeight
+	}
+	zone, err := nodeZone(et.nodes, pod.Spec.NodeName)
+	if err != nil {
+		et.log.Debugf("Failed to get zone of node %s: %s", pod.Spec.NodeName, err)
+		return defaultWeight
+	}
+	if zone == et.clientZone {
+		return preferredZoneWeight
+	}
+	return defaultWeight
+}
+
+// nodeZone returns the zone of the given node, as indicated by its
+// well-known topology labels.
+func nodeZone(nodes corelisters.NodeLister, nodeName string) (string, error) {
+	node, err := nodes.Get(nodeName)
+	if err != nil {
+		return "", err
+	}
+	if zone, ok := node.Labels[corev1.LabelZoneFailureDomainStable]; ok {
+		return zone, nil
+	}
+	return node.Labels[corev1.LabelZoneFailureDomain], nil
+}
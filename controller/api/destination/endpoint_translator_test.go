@@ -8,8 +8,9 @@ import (
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2-proxy-api/go/net"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/addr"
-	"github.com/linkerd/linkerd2/pkg/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	logging "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,11 +25,11 @@ var (
 				Name:      "pod1",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: k8s.IdentityModeDefault,
+					pkgK8s.IdentityModeAnnotation: pkgK8s.IdentityModeDefault,
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "linkerd",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 			Spec: corev1.PodSpec{
@@ -47,11 +48,11 @@ var (
 				Name:      "pod2",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: "optional",
+					pkgK8s.IdentityModeAnnotation: "optional",
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "linkerd",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 		},
@@ -65,11 +66,26 @@ var (
 				Name:      "pod3",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: k8s.IdentityModeDefault,
+					pkgK8s.IdentityModeAnnotation: pkgK8s.IdentityModeDefault,
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "other-linkerd-namespace",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "other-linkerd-namespace",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
+				},
+			},
+		},
+	}
+
+	ipv6Pod = watcher.Address{
+		IP:   "fd00:10:244::5",
+		Port: 5,
+		Pod: &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod5",
+				Namespace: "ns",
+				Labels: map[string]string{
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 		},
@@ -83,11 +99,11 @@ var (
 				Name:      "pod4",
 				Namespace: "ns",
 				Annotations: map[string]string{
-					k8s.IdentityModeAnnotation: k8s.IdentityModeDisabled,
+					pkgK8s.IdentityModeAnnotation: pkgK8s.IdentityModeDisabled,
 				},
 				Labels: map[string]string{
-					k8s.ControllerNSLabel:    "linkerd",
-					k8s.ProxyDeploymentLabel: "deployment-name",
+					pkgK8s.ControllerNSLabel:    "linkerd",
+					pkgK8s.ProxyDeploymentLabel: "deployment-name",
 				},
 			},
 		},
@@ -101,6 +117,8 @@ func makeEndpointTranslator(t *testing.T) (*mockDestinationGetServer, *endpointT
 		"trust.domain",
 		false,
 		"service-name.service-ns",
+		"",
+		nil,
 		mockGetServer,
 		logging.WithField("test", t.Name()),
 	)
@@ -108,6 +126,60 @@ func makeEndpointTranslator(t *testing.T) (*mockDestinationGetServer, *endpointT
 }
 
 func TestEndpointTranslator(t *testing.T) {
+	t.Run("Weights endpoints in the client's zone higher", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Node
+metadata:
+  name: node-a
+  labels:
+    topology.kubernetes.io/zone: zone-a`,
+			`
+apiVersion: v1
+kind: Node
+metadata:
+  name: node-b
+  labels:
+    failure-domain.beta.kubernetes.io/zone: zone-b`,
+		)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		k8sAPI.Sync()
+
+		mockGetServer := &mockDestinationGetServer{updatesReceived: []*pb.Update{}}
+		translator := newEndpointTranslator(
+			"linkerd",
+			"trust.domain",
+			false,
+			"service-name.service-ns",
+			"zone-a",
+			k8sAPI.Node().Lister(),
+			mockGetServer,
+			logging.WithField("test", t.Name()),
+		)
+
+		sameZonePod := normalPod
+		sameZonePod.Pod = normalPod.Pod.DeepCopy()
+		sameZonePod.Pod.Spec.NodeName = "node-a"
+		otherZonePod := tlsOptionalPod
+		otherZonePod.Pod = tlsOptionalPod.Pod.DeepCopy()
+		otherZonePod.Pod.Spec.NodeName = "node-b"
+
+		translator.Add(mkPodSet(sameZonePod, otherZonePod))
+
+		addrs := mockGetServer.updatesReceived[0].GetAdd().GetAddrs()
+		sort.Slice(addrs, func(i, j int) bool {
+			return addrs[i].GetAddr().Port < addrs[j].GetAddr().Port
+		})
+		if addrs[0].GetWeight() != preferredZoneWeight {
+			t.Fatalf("Expected weight [%d] but got [%d]", preferredZoneWeight, addrs[0].GetWeight())
+		}
+		if addrs[1].GetWeight() != defaultWeight {
+			t.Fatalf("Expected weight [%d] but got [%d]", defaultWeight, addrs[1].GetWeight())
+		}
+	})
+
 	t.Run("Sends one update for add and another for remove", func(t *testing.T) {
 		mockGetServer, translator := makeEndpointTranslator(t)
 
@@ -149,6 +221,28 @@ func TestEndpointTranslator(t *testing.T) {
 		checkAddress(t, addressesRemoved[0], tlsDisabledPod)
 	})
 
+	t.Run("Sends IPv6 addresses as removed or added", func(t *testing.T) {
+		mockGetServer, translator := makeEndpointTranslator(t)
+
+		translator.Add(mkPodSet(ipv6Pod))
+		translator.Remove(mkPodSet(ipv6Pod))
+
+		addressesAdded := mockGetServer.updatesReceived[0].GetAdd().Addrs
+		if len(addressesAdded) != 1 {
+			t.Fatalf("Expecting [1] address to be added, got [%d]: %v", len(addressesAdded), addressesAdded)
+		}
+		addressesRemoved := mockGetServer.updatesReceived[1].GetRemove().Addrs
+		if len(addressesRemoved) != 1 {
+			t.Fatalf("Expecting [1] address to be removed, got [%d]: %v", len(addressesRemoved), addressesRemoved)
+		}
+
+		checkAddressAndWeight(t, addressesAdded[0], ipv6Pod)
+		checkAddress(t, addressesRemoved[0], ipv6Pod)
+		if addressesAdded[0].GetAddr().GetIp().GetIpv6() == nil {
+			t.Fatalf("Expected an IPv6 address, got [%+v]", addressesAdded[0].GetAddr())
+		}
+	})
+
 	t.Run("Sends metric labels with added addresses", func(t *testing.T) {
 		mockGetServer, translator := makeEndpointTranslator(t)
 
@@ -258,7 +352,7 @@ func checkAddressAndWeight(t *testing.T, actual *pb.WeightedAddr, expected watch
 }
 
 func checkAddress(t *testing.T, actual *net.TcpAddress, expected watcher.Address) {
-	expectedAddr, err := addr.ParseProxyIPV4(expected.IP)
+	expectedAddr, err := addr.ParseProxyIP(expected.IP)
 	expectedTCP := net.TcpAddress{
 		Ip:   expectedAddr,
 		Port: expected.Port,
@@ -0,0 +1,126 @@
+package destination
+
+import (
+	"fmt"
+
+	saz "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
+	"github.com/linkerd/linkerd2/controller/identity"
+	logging "github.com/sirupsen/logrus"
+)
+
+// implements the PolicyUpdateListener interface
+type policyTranslator struct {
+	// trustDomain is nil when identity is disabled, in which case all the
+	// clients are unauthenticated
+	trustDomain *identity.TrustDomain
+	stream      policyPb.Policy_WatchServer
+	log         *logging.Entry
+}
+
+func newPolicyTranslator(
+	controllerNS string,
+	identityTrustDomain string,
+	stream policyPb.Policy_WatchServer,
+	log *logging.Entry,
+) *policyTranslator {
+	log = log.WithField("component", "policy-translator")
+
+	var trustDomain *identity.TrustDomain
+	if identityTrustDomain != "" {
+		var err error
+		trustDomain, err = identity.NewTrustDomain(controllerNS, identityTrustDomain)
+		if err != nil {
+			log.Errorf("Invalid trust domain, ignoring the identity-based rules: %s", err)
+		}
+	}
+
+	return &policyTranslator{trustDomain, stream, log}
+}
+
+func (pt *policyTranslator) UpdatePolicy(authorizations []*saz.ServerAuthorization) {
+	policy := pt.toServerPolicy(authorizations)
+	pt.log.Debugf("Sending policy update: %+v", policy)
+	if err := pt.stream.Send(policy); err != nil {
+		pt.log.Errorf("Failed to send policy update: %s", err)
+	}
+}
+
+// toServerPolicy returns the policy made of the given ServerAuthorizations.
+// The deny rules of all the authorizations are placed before their allow
+// rules, so that they take precedence.
+func (pt *policyTranslator) toServerPolicy(authorizations []*saz.ServerAuthorization) *policyPb.ServerPolicy {
+	policy := &policyPb.ServerPolicy{
+		DefaultAction:  policyPb.Action_ALLOW,
+		Rules:          []*policyPb.Rule{},
+		Authorizations: []string{},
+	}
+	if len(authorizations) == 0 {
+		return policy
+	}
+
+	policy.DefaultAction = policyPb.Action_DENY
+	allowRules := []*policyPb.Rule{}
+	for _, authorization := range authorizations {
+		policy.Authorizations = append(policy.Authorizations, fmt.Sprintf("%s/%s", authorization.Namespace, authorization.Name))
+		for _, client := range authorization.Spec.Deny {
+			policy.Rules = append(policy.Rules, pt.toRules(policyPb.Action_DENY, authorization.Namespace, client)...)
+		}
+		for _, client := range authorization.Spec.Allow {
+			allowRules = append(allowRules, pt.toRules(policyPb.Action_ALLOW, authorization.Namespace, client)...)
+		}
+	}
+	policy.Rules = append(policy.Rules, allowRules...)
+
+	return policy
+}
+
+// toRules returns a rule for each of the client matches described by the
+// given Client.
+func (pt *policyTranslator) toRules(action policyPb.Action, namespace string, client *saz.Client) []*policyPb.Rule {
+	rules := []*policyPb.Rule{}
+	newRule := func(match *policyPb.ClientMatch) {
+		rules = append(rules, &policyPb.Rule{Action: action, Client: match})
+	}
+
+	if client.Unauthenticated {
+		newRule(&policyPb.ClientMatch{
+			Match: &policyPb.ClientMatch_Unauthenticated{Unauthenticated: true},
+		})
+	}
+
+	if pt.trustDomain == nil {
+		if len(client.ServiceAccounts) > 0 || len(client.Namespaces) > 0 {
+			pt.log.Warnf("Ignoring the identity-based %s rules of namespace %s, as identity is disabled", action, namespace)
+		}
+		return rules
+	}
+
+	for _, sa := range client.ServiceAccounts {
+		saNamespace := sa.Namespace
+		if saNamespace == "" {
+			saNamespace = namespace
+		}
+		id, err := pt.trustDomain.Identity("serviceaccount", sa.Name, saNamespace)
+		if err != nil {
+			pt.log.Warnf("Ignoring invalid service account %s/%s: %s", saNamespace, sa.Name, err)
+			continue
+		}
+		newRule(&policyPb.ClientMatch{
+			Match: &policyPb.ClientMatch_Identity{Identity: id},
+		})
+	}
+
+	for _, ns := range client.Namespaces {
+		suffix, err := pt.trustDomain.IdentitySuffix("serviceaccount", ns)
+		if err != nil {
+			pt.log.Warnf("Ignoring invalid namespace %s: %s", ns, err)
+			continue
+		}
+		newRule(&policyPb.ClientMatch{
+			Match: &policyPb.ClientMatch_IdentitySuffix{IdentitySuffix: suffix},
+		})
+	}
+
+	return rules
+}
@@ -0,0 +1,155 @@
+package destination
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkerd/linkerd2/controller/api/util"
+	saz "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
+	logging "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var (
+	webAuthorization = &saz.ServerAuthorization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "ns",
+		},
+		Spec: saz.ServerAuthorizationSpec{
+			PodSelector: &metav1.LabelSelector{},
+			Port:        intstr.FromString("http"),
+			Allow: []*saz.Client{
+				{
+					ServiceAccounts: []*saz.ServiceAccountRef{
+						{Name: "frontend"},
+						{Name: "backend", Namespace: "other-ns"},
+					},
+				},
+			},
+			Deny: []*saz.Client{
+				{Unauthenticated: true},
+			},
+		},
+	}
+
+	monitoringAuthorization = &saz.ServerAuthorization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "monitoring",
+			Namespace: "ns",
+		},
+		Spec: saz.ServerAuthorizationSpec{
+			PodSelector: &metav1.LabelSelector{},
+			Port:        intstr.FromInt(8080),
+			Allow: []*saz.Client{
+				{Namespaces: []string{"monitoring"}},
+			},
+		},
+	}
+
+	unauthenticatedMatch = &policyPb.ClientMatch{
+		Match: &policyPb.ClientMatch_Unauthenticated{Unauthenticated: true},
+	}
+)
+
+type bufferingPolicyStream struct {
+	updates []*policyPb.ServerPolicy
+	util.MockServerStream
+}
+
+func (bps *bufferingPolicyStream) Send(policy *policyPb.ServerPolicy) error {
+	bps.updates = append(bps.updates, policy)
+	return nil
+}
+
+func identityMatch(id string) *policyPb.ClientMatch {
+	return &policyPb.ClientMatch{
+		Match: &policyPb.ClientMatch_Identity{Identity: id},
+	}
+}
+
+func TestPolicyTranslator(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		trustDomain    string
+		authorizations []*saz.ServerAuthorization
+		expected       *policyPb.ServerPolicy
+	}{
+		{
+			name:           "allows all clients without authorizations",
+			trustDomain:    "cluster.local",
+			authorizations: nil,
+			expected: &policyPb.ServerPolicy{
+				DefaultAction:  policyPb.Action_ALLOW,
+				Rules:          []*policyPb.Rule{},
+				Authorizations: []string{},
+			},
+		},
+		{
+			name:           "places deny rules before allow rules",
+			trustDomain:    "cluster.local",
+			authorizations: []*saz.ServerAuthorization{monitoringAuthorization, webAuthorization},
+			expected: &policyPb.ServerPolicy{
+				DefaultAction: policyPb.Action_DENY,
+				Rules: []*policyPb.Rule{
+					{
+						Action: policyPb.Action_DENY,
+						Client: unauthenticatedMatch,
+					},
+					{
+						Action: policyPb.Action_ALLOW,
+						Client: &policyPb.ClientMatch{
+							Match: &policyPb.ClientMatch_IdentitySuffix{
+								IdentitySuffix: ".monitoring.serviceaccount.identity.linkerd.cluster.local",
+							},
+						},
+					},
+					{
+						Action: policyPb.Action_ALLOW,
+						Client: identityMatch("frontend.ns.serviceaccount.identity.linkerd.cluster.local"),
+					},
+					{
+						Action: policyPb.Action_ALLOW,
+						Client: identityMatch("backend.other-ns.serviceaccount.identity.linkerd.cluster.local"),
+					},
+				},
+				Authorizations: []string{"ns/monitoring", "ns/web"},
+			},
+		},
+		{
+			name:           "ignores identity-based rules when identity is disabled",
+			trustDomain:    "",
+			authorizations: []*saz.ServerAuthorization{webAuthorization},
+			expected: &policyPb.ServerPolicy{
+				DefaultAction: policyPb.Action_DENY,
+				Rules: []*policyPb.Rule{
+					{
+						Action: policyPb.Action_DENY,
+						Client: unauthenticatedMatch,
+					},
+				},
+				Authorizations: []string{"ns/web"},
+			},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &bufferingPolicyStream{
+				updates:          []*policyPb.ServerPolicy{},
+				MockServerStream: util.NewMockServerStream(),
+			}
+			translator := newPolicyTranslator("linkerd", tt.trustDomain, stream, logging.WithField("test", t.Name()))
+
+			translator.UpdatePolicy(tt.authorizations)
+
+			if len(stream.updates) != 1 {
+				t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+			}
+			if !proto.Equal(stream.updates[0], tt.expected) {
+				t.Fatalf("Expected policy %v, got %v", tt.expected, stream.updates[0])
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package destination
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
@@ -33,14 +35,18 @@ var (
 
 // implements the ProfileUpdateListener interface
 type profileTranslator struct {
-	stream pb.Destination_GetProfileServer
-	log    *logging.Entry
+	// authority is the authority the profile is served for, against which the
+	// authority matches of the routes are evaluated
+	authority string
+	stream    pb.Destination_GetProfileServer
+	log       *logging.Entry
 }
 
-func newProfileTranslator(stream pb.Destination_GetProfileServer, log *logging.Entry) *profileTranslator {
+func newProfileTranslator(authority string, stream pb.Destination_GetProfileServer, log *logging.Entry) *profileTranslator {
 	return &profileTranslator{
-		stream: stream,
-		log:    log.WithField("component", "profile-translator"),
+		authority: authority,
+		stream:    stream,
+		log:       log.WithField("component", "profile-translator"),
 	}
 }
 
@@ -49,7 +55,7 @@ func (pt *profileTranslator) Update(profile *sp.ServiceProfile) {
 		pt.stream.Send(&defaultServiceProfile)
 		return
 	}
-	destinationProfile, err := toServiceProfile(profile)
+	destinationProfile, err := pt.toServiceProfile(profile)
 	if err != nil {
 		pt.log.Error(err)
 		return
@@ -67,14 +73,16 @@ func toDuration(d time.Duration) *duration.Duration {
 
 // toServiceProfile returns a Proxy API DestinationProfile, given a
 // ServiceProfile.
-func toServiceProfile(profile *sp.ServiceProfile) (*pb.DestinationProfile, error) {
+func (pt *profileTranslator) toServiceProfile(profile *sp.ServiceProfile) (*pb.DestinationProfile, error) {
 	routes := make([]*pb.Route, 0)
 	for _, route := range profile.Spec.Routes {
-		pbRoute, err := toRoute(profile, route)
+		pbRoute, err := pt.toRoute(profile, route)
 		if err != nil {
 			return nil, err
 		}
-		routes = append(routes, pbRoute)
+		if pbRoute != nil {
+			routes = append(routes, pbRoute)
+		}
 	}
 	budget := defaultRetryBudget
 	if profile.Spec.RetryBudget != nil {
@@ -106,12 +114,27 @@ func toDstOverrides(dsts []*sp.WeightedDst) []*pb.WeightedDst {
 	return pbDsts
 }
 
-// toRoute returns a Proxy API Route, given a ServiceProfile Route.
-func toRoute(profile *sp.ServiceProfile, route *sp.RouteSpec) (*pb.Route, error) {
-	cond, err := toRequestMatch(route.Condition)
+// toRoute returns a Proxy API Route, given a ServiceProfile Route. It returns
+// nil when the route can't match any request to the authority.
+func (pt *profileTranslator) toRoute(profile *sp.ServiceProfile, route *sp.RouteSpec) (*pb.Route, error) {
+	cond, kind, err := pt.toRequestMatch(route.Condition)
 	if err != nil {
 		return nil, err
 	}
+	switch kind {
+	case matchNever:
+		pt.log.Debugf("Skipping route '%s' which doesn't match authority %s", route.Name, pt.authority)
+		return nil, nil
+	case matchAlways:
+		// an empty conjunction matches every request
+		cond = &pb.RequestMatch{
+			Match: &pb.RequestMatch_All{
+				All: &pb.RequestMatch_Seq{
+					Matches: []*pb.RequestMatch{},
+				},
+			},
+		}
+	}
 	rcs := make([]*pb.ResponseClass, 0)
 	for _, rc := range route.ResponseClasses {
 		pbRc, err := toResponseClass(rc)
@@ -243,53 +266,70 @@ func toResponseMatch(rspMatch *sp.ResponseMatch) (*pb.ResponseMatch, error) {
 	}, nil
 }
 
+// matchKind describes how a ServiceProfile RequestMatch translates to a Proxy
+// API RequestMatch. Authority matches are evaluated by the destination service
+// against the authority the profile is served for, so that they become
+// constant.
+type matchKind int
+
+const (
+	// matchRequest is a match that the proxies evaluate for each request.
+	matchRequest matchKind = iota
+	// matchAlways is a match that is true for every request to the authority.
+	matchAlways
+	// matchNever is a match that is false for every request to the authority.
+	matchNever
+)
+
 // toRequestMatch returns a Proxy API RequestMatch, given a ServiceProfile
-// RequestMatch.
-func toRequestMatch(reqMatch *sp.RequestMatch) (*pb.RequestMatch, error) {
+// RequestMatch. The returned RequestMatch is nil when the kind of the match is
+// anything but matchRequest.
+func (pt *profileTranslator) toRequestMatch(reqMatch *sp.RequestMatch) (*pb.RequestMatch, matchKind, error) {
 	if reqMatch == nil {
-		return nil, errors.New("missing request match")
+		return nil, matchRequest, errors.New("missing request match")
 	}
 	err := profiles.ValidateRequestMatch(reqMatch)
 	if err != nil {
-		return nil, err
+		return nil, matchRequest, err
 	}
 
 	matches := make([]*pb.RequestMatch, 0)
+	kinds := make([]matchKind, 0)
 
 	if reqMatch.All != nil {
-		all := make([]*pb.RequestMatch, 0)
-		for _, m := range reqMatch.All {
-			pbM, err := toRequestMatch(m)
-			if err != nil {
-				return nil, err
-			}
-			all = append(all, pbM)
+		all, allKinds, err := pt.toRequestMatches(reqMatch.All)
+		if err != nil {
+			return nil, matchRequest, err
 		}
-		matches = append(matches, &pb.RequestMatch{
-			Match: &pb.RequestMatch_All{
-				All: &pb.RequestMatch_Seq{
-					Matches: all,
+		kind := allKind(allKinds...)
+		if kind == matchRequest {
+			matches = append(matches, &pb.RequestMatch{
+				Match: &pb.RequestMatch_All{
+					All: &pb.RequestMatch_Seq{
+						Matches: all,
+					},
 				},
-			},
-		})
+			})
+		}
+		kinds = append(kinds, kind)
 	}
 
 	if reqMatch.Any != nil {
-		any := make([]*pb.RequestMatch, 0)
-		for _, m := range reqMatch.Any {
-			pbM, err := toRequestMatch(m)
-			if err != nil {
-				return nil, err
-			}
-			any = append(any, pbM)
+		any, anyKinds, err := pt.toRequestMatches(reqMatch.Any)
+		if err != nil {
+			return nil, matchRequest, err
 		}
-		matches = append(matches, &pb.RequestMatch{
-			Match: &pb.RequestMatch_Any{
-				Any: &pb.RequestMatch_Seq{
-					Matches: any,
+		kind := anyKind(anyKinds...)
+		if kind == matchRequest {
+			matches = append(matches, &pb.RequestMatch{
+				Match: &pb.RequestMatch_Any{
+					Any: &pb.RequestMatch_Seq{
+						Matches: any,
+					},
 				},
-			},
-		})
+			})
+		}
+		kinds = append(kinds, kind)
 	}
 
 	if reqMatch.Method != "" {
@@ -298,18 +338,27 @@ func toRequestMatch(reqMatch *sp.RequestMatch) (*pb.RequestMatch, error) {
 				Method: util.ParseMethod(reqMatch.Method),
 			},
 		})
+		kinds = append(kinds, matchRequest)
 	}
 
 	if reqMatch.Not != nil {
-		not, err := toRequestMatch(reqMatch.Not)
+		not, kind, err := pt.toRequestMatch(reqMatch.Not)
 		if err != nil {
-			return nil, err
+			return nil, matchRequest, err
 		}
-		matches = append(matches, &pb.RequestMatch{
-			Match: &pb.RequestMatch_Not{
-				Not: not,
-			},
-		})
+		switch kind {
+		case matchRequest:
+			matches = append(matches, &pb.RequestMatch{
+				Match: &pb.RequestMatch_Not{
+					Not: not,
+				},
+			})
+		case matchAlways:
+			kind = matchNever
+		case matchNever:
+			kind = matchAlways
+		}
+		kinds = append(kinds, kind)
 	}
 
 	if reqMatch.PathRegex != "" {
@@ -320,13 +369,24 @@ func toRequestMatch(reqMatch *sp.RequestMatch) (*pb.RequestMatch, error) {
 				},
 			},
 		})
+		kinds = append(kinds, matchRequest)
 	}
 
-	if len(matches) == 0 {
-		return nil, errors.New("A request match must have a field set")
+	if reqMatch.Authority != nil {
+		kind, err := pt.matchAuthority(reqMatch.Authority)
+		if err != nil {
+			return nil, matchRequest, err
+		}
+		kinds = append(kinds, kind)
+	}
+
+	// All the fields of a request match must match
+	kind := allKind(kinds...)
+	if kind != matchRequest {
+		return nil, kind, nil
 	}
 	if len(matches) == 1 {
-		return matches[0], nil
+		return matches[0], matchRequest, nil
 	}
 	return &pb.RequestMatch{
 		Match: &pb.RequestMatch_All{
@@ -334,5 +394,75 @@ func toRequestMatch(reqMatch *sp.RequestMatch) (*pb.RequestMatch, error) {
 				Matches: matches,
 			},
 		},
-	}, nil
+	}, matchRequest, nil
+}
+
+// toRequestMatches translates a list of ServiceProfile RequestMatches. It
+// returns the Proxy API RequestMatches of the matches of kind matchRequest,
+// along with the kinds of all the matches.
+func (pt *profileTranslator) toRequestMatches(reqMatches []*sp.RequestMatch) ([]*pb.RequestMatch, []matchKind, error) {
+	matches := make([]*pb.RequestMatch, 0)
+	kinds := make([]matchKind, 0)
+	for _, m := range reqMatches {
+		pbM, kind, err := pt.toRequestMatch(m)
+		if err != nil {
+			return nil, nil, err
+		}
+		if kind == matchRequest {
+			matches = append(matches, pbM)
+		}
+		kinds = append(kinds, kind)
+	}
+	return matches, kinds, nil
+}
+
+// matchAuthority evaluates an AuthorityMatch against the authority the profile
+// is served for. Regexes must match the whole authority.
+func (pt *profileTranslator) matchAuthority(authority *sp.AuthorityMatch) (matchKind, error) {
+	matched := authority.Exact == "" || authority.Exact == pt.authority
+	if authority.Regex != "" {
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", authority.Regex))
+		if err != nil {
+			return matchRequest, err
+		}
+		matched = re.MatchString(pt.authority)
+	}
+	if matched {
+		return matchAlways, nil
+	}
+	return matchNever, nil
+}
+
+// allKind returns the kind of the conjunction of matches of the given kinds.
+// A match that never matches makes the whole conjunction never match.
+func allKind(kinds ...matchKind) matchKind {
+	result := matchAlways
+	for _, kind := range kinds {
+		switch kind {
+		case matchNever:
+			return matchNever
+		case matchRequest:
+			if result == matchAlways {
+				result = matchRequest
+			}
+		}
+	}
+	return result
+}
+
+// anyKind returns the kind of the disjunction of matches of the given kinds.
+// A match that always matches makes the whole disjunction always match.
+func anyKind(kinds ...matchKind) matchKind {
+	result := matchNever
+	for _, kind := range kinds {
+		switch kind {
+		case matchAlways:
+			return matchAlways
+		case matchRequest:
+			if result == matchNever {
+				result = matchRequest
+			}
+		}
+	}
+	return result
 }
@@ -425,6 +425,80 @@ var (
 		},
 		RetryBudget: &defaultRetryBudget,
 	}
+
+	profileWithAuthorityMatches = &sp.ServiceProfile{
+		Spec: sp.ServiceProfileSpec{
+			Routes: []*sp.RouteSpec{
+				{
+					Name: "webLogin",
+					Condition: &sp.RequestMatch{
+						PathRegex: "/login",
+						Authority: &sp.AuthorityMatch{
+							Regex: `web\.ns\.svc\.cluster\.local:\d+`,
+						},
+					},
+				},
+				{
+					Name: "otherGet",
+					Condition: &sp.RequestMatch{
+						Method: "GET",
+						Authority: &sp.AuthorityMatch{
+							Exact: "other.ns.svc.cluster.local:8080",
+						},
+					},
+				},
+				{
+					Name: "postOrWeb",
+					Condition: &sp.RequestMatch{
+						Any: []*sp.RequestMatch{
+							{
+								Method: "POST",
+							},
+							{
+								Not: &sp.RequestMatch{
+									Authority: &sp.AuthorityMatch{
+										Exact: "other.ns.svc.cluster.local:8080",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pbProfileWithAuthorityMatches = &pb.DestinationProfile{
+		Routes: []*pb.Route{
+			{
+				MetricsLabels: map[string]string{
+					"route": "webLogin",
+				},
+				Condition:       pbLogin,
+				ResponseClasses: []*pb.ResponseClass{},
+				Timeout: &duration.Duration{
+					Seconds: 10,
+				},
+			},
+			{
+				MetricsLabels: map[string]string{
+					"route": "postOrWeb",
+				},
+				Condition: &pb.RequestMatch{
+					Match: &pb.RequestMatch_All{
+						All: &pb.RequestMatch_Seq{
+							Matches: []*pb.RequestMatch{},
+						},
+					},
+				},
+				ResponseClasses: []*pb.ResponseClass{},
+				Timeout: &duration.Duration{
+					Seconds: 10,
+				},
+			},
+		},
+		RetryBudget: &defaultRetryBudget,
+	}
 )
 
 func TestProfileTranslator(t *testing.T) {
@@ -591,4 +665,25 @@ func TestProfileTranslator(t *testing.T) {
 			t.Fatalf("Expected profile sent to be [%v] but was [%v]", pbProfileWithTimeout, actualPbProfile)
 		}
 	})
+
+	t.Run("Evaluates authority matches", func(t *testing.T) {
+		mockGetProfileServer := &mockDestinationGetProfileServer{profilesReceived: []*pb.DestinationProfile{}}
+
+		translator := newProfileTranslator(
+			"web.ns.svc.cluster.local:8080",
+			mockGetProfileServer,
+			logging.WithField("test", t.Name()),
+		)
+
+		translator.Update(profileWithAuthorityMatches)
+
+		numProfiles := len(mockGetProfileServer.profilesReceived)
+		if numProfiles != 1 {
+			t.Fatalf("Expecting [1] profile, got [%d]. Updates: %v", numProfiles, mockGetProfileServer.profilesReceived)
+		}
+		actualPbProfile := mockGetProfileServer.profilesReceived[0]
+		if !proto.Equal(actualPbProfile, pbProfileWithAuthorityMatches) {
+			t.Fatalf("Expected profile sent to be [%v] but was [%v]", pbProfileWithAuthorityMatches, actualPbProfile)
+		}
+	})
 }
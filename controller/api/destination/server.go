@@ -8,7 +8,9 @@ import (
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
 	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	logging "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -23,6 +25,8 @@ type (
 		profiles      *watcher.ProfileWatcher
 		trafficSplits *watcher.TrafficSplitWatcher
 		ips           *watcher.IPWatcher
+		policies      *watcher.PolicyWatcher
+		k8sAPI        *k8s.API
 
 		enableH2Upgrade     bool
 		controllerNS        string
@@ -45,12 +49,16 @@ type (
 // omitted, "default" is used as a default.append
 //
 // Addresses for the given destination are fetched from the Kubernetes Endpoints
-// API.
+// API, or from the EndpointSlices API if enableEndpointSlices is true.
+//
+// The destination server also serves the authorization policies of the pod
+// ports, as described by the ServerAuthorization resources.
 func NewServer(
 	addr string,
 	controllerNS string,
 	identityTrustDomain string,
 	enableH2Upgrade bool,
+	enableEndpointSlices bool,
 	k8sAPI *k8s.API,
 	clusterDomain string,
 	shutdown <-chan struct{},
@@ -59,16 +67,19 @@ func NewServer(
 		"addr":      addr,
 		"component": "server",
 	})
-	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log)
+	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log, enableEndpointSlices)
 	profiles := watcher.NewProfileWatcher(k8sAPI, log)
 	trafficSplits := watcher.NewTrafficSplitWatcher(k8sAPI, log)
 	ips := watcher.NewIPWatcher(k8sAPI, endpoints, log)
+	policies := watcher.NewPolicyWatcher(k8sAPI, log)
 
 	srv := server{
 		endpoints,
 		profiles,
 		trafficSplits,
 		ips,
+		policies,
+		k8sAPI,
 		enableH2Upgrade,
 		controllerNS,
 		identityTrustDomain,
@@ -80,6 +91,8 @@ func NewServer(
 	s := prometheus.NewGrpcServer()
 	// linkerd2-proxy-api/destination.Destination (proxy-facing)
 	pb.RegisterDestinationServer(s, &srv)
+	// linkerd2/controller/policy.Policy (proxy-facing)
+	policyPb.RegisterPolicyServer(s, &srv)
 	return s
 }
 
@@ -91,15 +104,6 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 	}
 	log.Debugf("Get %s", dest.GetPath())
 
-	translator := newEndpointTranslator(
-		s.controllerNS,
-		s.identityTrustDomain,
-		s.enableH2Upgrade,
-		dest.GetPath(),
-		stream,
-		log,
-	)
-
 	// The host must be fully-qualified or be an IP address.
 	host, port, err := getHostAndPort(dest.GetPath())
 	if err != nil {
@@ -108,6 +112,12 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 	}
 
 	if ip := net.ParseIP(host); ip != nil {
+		translator := s.newEndpointTranslator(dest.GetPath(), "", stream, log)
+
+		// Use the canonical form of the IP so that it matches the ClusterIPs
+		// and pod IPs indexed by the IPWatcher, regardless of how an IPv6
+		// address was written in the authority.
+		host = ip.String()
 		err := s.ips.Subscribe(host, port, translator)
 		if err != nil {
 			log.Errorf("Failed to subscribe to %s: %s", dest.GetPath(), err)
@@ -123,6 +133,9 @@ func (s *server) Get(dest *pb.GetDestination, stream pb.Destination_GetServer) e
 			return status.Errorf(codes.InvalidArgument, "Invalid authority: %s", dest.GetPath())
 		}
 
+		clientZone := s.getClientZone(service, dest.GetContextToken(), log)
+		translator := s.newEndpointTranslator(dest.GetPath(), clientZone, stream, log)
+
 		err = s.endpoints.Subscribe(service, port, instanceID, translator)
 		if err != nil {
 			if _, ok := err.(watcher.InvalidService); ok {
@@ -155,7 +168,7 @@ func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetPr
 	// We build up the pipeline of profile updaters backwards, starting from
 	// the translator which takes profile updates, translates them to protobuf
 	// and pushes them onto the gRPC stream.
-	translator := newProfileTranslator(stream, log)
+	translator := newProfileTranslator(dest.GetPath(), stream, log)
 
 	// The host must be fully-qualified or be an IP address.
 	host, port, err := getHostAndPort(dest.GetPath())
@@ -233,18 +246,120 @@ func (s *server) GetProfile(dest *pb.GetDestination, stream pb.Destination_GetPr
 	return nil
 }
 
+// Watch streams the authorization policy of a pod port.
+func (s *server) Watch(spec *policyPb.PortSpec, stream policyPb.Policy_WatchServer) error {
+	log := s.log
+	client, _ := peer.FromContext(stream.Context())
+	if client != nil {
+		log = log.WithField("remote", client.Addr)
+	}
+	log.Debugf("Watch(%+v)", spec)
+
+	if spec.GetNamespace() == "" || spec.GetPod() == "" {
+		return status.Error(codes.InvalidArgument, "pod namespace and name are required")
+	}
+	if spec.GetPort() == 0 || spec.GetPort() > 65535 {
+		return status.Errorf(codes.InvalidArgument, "invalid port: %d", spec.GetPort())
+	}
+
+	id := watcher.PodPortID{
+		Pod: watcher.PodID{
+			Namespace: spec.GetNamespace(),
+			Name:      spec.GetPod(),
+		},
+		Port: spec.GetPort(),
+	}
+	translator := newPolicyTranslator(s.controllerNS, s.identityTrustDomain, stream, log)
+
+	err := s.policies.Subscribe(id, translator)
+	if err != nil {
+		log.Errorf("Failed to subscribe to %s: %s", id, err)
+		return err
+	}
+	defer s.policies.Unsubscribe(id, translator)
+
+	select {
+	case <-s.shutdown:
+	case <-stream.Context().Done():
+		log.Debugf("Watch(%+v) cancelled", spec)
+	}
+
+	return nil
+}
+
+func (s *server) newEndpointTranslator(
+	path string,
+	clientZone string,
+	stream pb.Destination_GetServer,
+	log *logging.Entry,
+) *endpointTranslator {
+	return newEndpointTranslator(
+		s.controllerNS,
+		s.identityTrustDomain,
+		s.enableH2Upgrade,
+		path,
+		clientZone,
+		s.k8sAPI.Node().Lister(),
+		stream,
+		log,
+	)
+}
+
+// getClientZone returns the zone of the node where the client proxy runs, if
+// the service has topology-aware routing enabled and the proxy's context
+// token names its node. Otherwise it returns an empty string.
+func (s *server) getClientZone(service watcher.ServiceID, contextToken string, log *logging.Entry) string {
+	svc, err := s.k8sAPI.Svc().Lister().Services(service.Namespace).Get(service.Name)
+	if err != nil || svc.Annotations[pkgK8s.TopologyModeAnnotation] != pkgK8s.TopologyModePreferZone {
+		return ""
+	}
+
+	nodeName := parseContextToken(contextToken).nodeName
+	if nodeName == "" {
+		log.Debugf("Context token %q does not include a node name; ignoring topology", contextToken)
+		return ""
+	}
+
+	zone, err := nodeZone(s.k8sAPI.Node().Lister(), nodeName)
+	if err != nil {
+		log.Warnf("Failed to get zone of node %s: %s", nodeName, err)
+		return ""
+	}
+	return zone
+}
+
 ////////////
 /// util ///
 ////////////
 
-func nsFromToken(token string) string {
-	// ns:<namespace>
-	parts := strings.Split(token, ":")
-	if len(parts) == 2 && parts[0] == "ns" {
-		return parts[1]
+// contextToken holds the information that the proxies send along with their
+// requests to identify themselves.
+type contextToken struct {
+	ns       string
+	nodeName string
+}
+
+// parseContextToken parses a context token of the form
+// ns:<namespace>[,node:<node-name>]. Unknown keys are ignored.
+func parseContextToken(token string) contextToken {
+	ctx := contextToken{}
+	for _, pair := range strings.Split(token, ",") {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "ns":
+			ctx.ns = parts[1]
+		case "node":
+			ctx.nodeName = parts[1]
+		}
 	}
+	return ctx
+}
 
-	return ""
+func nsFromToken(token string) string {
+	return parseContextToken(token).ns
 }
 
 func profileID(authority string, contextToken string, clusterDomain string) (watcher.ProfileID, error) {
@@ -266,19 +381,24 @@ func profileID(authority string, contextToken string, clusterDomain string) (wat
 	return id, nil
 }
 
+// getHostAndPort splits an authority into its host and port. IPv6 hosts must
+// be enclosed in square brackets when a port is given, e.g. [fd00::1]:8080.
+// If the port is omitted, 80 is used as a default.
 func getHostAndPort(authority string) (string, watcher.Port, error) {
-	hostPort := strings.Split(authority, ":")
-	if len(hostPort) > 2 {
+	if host := strings.Trim(authority, "[]"); net.ParseIP(host) != nil {
+		// An IP address without a port.
+		return host, watcher.Port(80), nil
+	}
+	if !strings.Contains(authority, ":") {
+		return authority, watcher.Port(80), nil
+	}
+	host, portStr, err := net.SplitHostPort(authority)
+	if err != nil {
 		return "", 0, fmt.Errorf("Invalid destination %s", authority)
 	}
-	host := hostPort[0]
-	port := 80
-	if len(hostPort) == 2 {
-		var err error
-		port, err = strconv.Atoi(hostPort[1])
-		if err != nil {
-			return "", 0, fmt.Errorf("Invalid port %s", hostPort[1])
-		}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("Invalid port %s", portStr)
 	}
 	return host, watcher.Port(port), nil
 }
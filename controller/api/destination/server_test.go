@@ -6,6 +6,7 @@ import (
 	pb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	"github.com/linkerd/linkerd2/controller/api/destination/watcher"
 	"github.com/linkerd/linkerd2/controller/api/util"
+	policyPb "github.com/linkerd/linkerd2/controller/gen/controller/policy"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/addr"
 	logging "github.com/sirupsen/logrus"
@@ -93,6 +94,19 @@ spec:
     isRetryable: true
     condition:
       pathRegex: "/x/y/z"`,
+		`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  podSelector: {}
+  port: 8989
+  allow:
+  - serviceAccounts:
+    - name: client
+      namespace: client-ns`,
 	)
 	if err != nil {
 		t.Fatalf("NewFakeAPI returned an error: %s", err)
@@ -101,16 +115,19 @@ spec:
 
 	k8sAPI.Sync()
 
-	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log)
+	endpoints := watcher.NewEndpointsWatcher(k8sAPI, log, false)
 	profiles := watcher.NewProfileWatcher(k8sAPI, log)
 	trafficSplits := watcher.NewTrafficSplitWatcher(k8sAPI, log)
 	ips := watcher.NewIPWatcher(k8sAPI, endpoints, log)
+	policies := watcher.NewPolicyWatcher(k8sAPI, log)
 
 	return &server{
 		endpoints,
 		profiles,
 		trafficSplits,
 		ips,
+		policies,
+		k8sAPI,
 		false,
 		"linkerd",
 		"trust.domain",
@@ -281,6 +298,60 @@ func TestGetProfiles(t *testing.T) {
 	})
 }
 
+func TestWatch(t *testing.T) {
+	t.Run("Returns error if not valid pod port", func(t *testing.T) {
+		server := makeServer(t)
+
+		for _, spec := range []*policyPb.PortSpec{
+			{Pod: "name1-1", Port: 8989},
+			{Namespace: "ns", Port: 8989},
+			{Namespace: "ns", Pod: "name1-1"},
+			{Namespace: "ns", Pod: "name1-1", Port: 65536},
+		} {
+			stream := &bufferingPolicyStream{
+				updates:          []*policyPb.ServerPolicy{},
+				MockServerStream: util.NewMockServerStream(),
+			}
+
+			err := server.Watch(spec, stream)
+			if err == nil {
+				t.Fatalf("Expecting error for %+v, got nothing", spec)
+			}
+		}
+	})
+
+	t.Run("Returns policy", func(t *testing.T) {
+		server := makeServer(t)
+
+		stream := &bufferingPolicyStream{
+			updates:          []*policyPb.ServerPolicy{},
+			MockServerStream: util.NewMockServerStream(),
+		}
+
+		stream.Cancel() // See note above on pre-emptive cancellation.
+		err := server.Watch(&policyPb.PortSpec{Namespace: "ns", Pod: "name1-1", Port: 8989}, stream)
+		if err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+
+		if len(stream.updates) != 1 {
+			t.Fatalf("Expected 1 update but got %d: %v", len(stream.updates), stream.updates)
+		}
+		policy := stream.updates[0]
+		if policy.GetDefaultAction() != policyPb.Action_DENY {
+			t.Fatalf("Expected default action to be DENY, got %s", policy.GetDefaultAction())
+		}
+		rules := policy.GetRules()
+		if len(rules) != 1 {
+			t.Fatalf("Expected 1 rule but got %d: %v", len(rules), rules)
+		}
+		expectedID := "client.client-ns.serviceaccount.identity.linkerd.trust.domain"
+		if rules[0].GetAction() != policyPb.Action_ALLOW || rules[0].GetClient().GetIdentity() != expectedID {
+			t.Fatalf("Expected rule to allow %s, got %v", expectedID, rules[0])
+		}
+	})
+}
+
 func updateAddAddress(t *testing.T, update *pb.Update) []string {
 	add, ok := update.GetUpdate().(*pb.Update_Add)
 	if !ok {
@@ -292,3 +363,59 @@ func updateAddAddress(t *testing.T, update *pb.Update) []string {
 	}
 	return ips
 }
+
+func TestGetHostAndPort(t *testing.T) {
+	for _, tt := range []struct {
+		authority    string
+		expectedHost string
+		expectedPort watcher.Port
+		expectedErr  bool
+	}{
+		{authority: "name1.ns.svc.mycluster.local", expectedHost: "name1.ns.svc.mycluster.local", expectedPort: 80},
+		{authority: "name1.ns.svc.mycluster.local:8989", expectedHost: "name1.ns.svc.mycluster.local", expectedPort: 8989},
+		{authority: "172.17.0.12:8989", expectedHost: "172.17.0.12", expectedPort: 8989},
+		{authority: "fd00:10:244::c", expectedHost: "fd00:10:244::c", expectedPort: 80},
+		{authority: "[fd00:10:244::c]", expectedHost: "fd00:10:244::c", expectedPort: 80},
+		{authority: "[fd00:10:244::c]:8989", expectedHost: "fd00:10:244::c", expectedPort: 8989},
+		{authority: "name1.ns.svc.mycluster.local:abc", expectedErr: true},
+		{authority: "name1.ns.svc.mycluster.local:80:80", expectedErr: true},
+	} {
+		tt := tt // pin
+		t.Run(tt.authority, func(t *testing.T) {
+			host, port, err := getHostAndPort(tt.authority)
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatalf("Expected error, got nothing")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if host != tt.expectedHost || port != tt.expectedPort {
+				t.Fatalf("Expected %s and %d, got %s and %d", tt.expectedHost, tt.expectedPort, host, port)
+			}
+		})
+	}
+}
+
+func TestParseContextToken(t *testing.T) {
+	for _, tt := range []struct {
+		token    string
+		expected contextToken
+	}{
+		{token: "", expected: contextToken{}},
+		{token: "ns:emojivoto", expected: contextToken{ns: "emojivoto"}},
+		{token: "ns:emojivoto,node:node-1", expected: contextToken{ns: "emojivoto", nodeName: "node-1"}},
+		{token: "node:node-1,ns:emojivoto,foo:bar", expected: contextToken{ns: "emojivoto", nodeName: "node-1"}},
+		{token: "garbage", expected: contextToken{}},
+	} {
+		tt := tt // pin
+		t.Run(tt.token, func(t *testing.T) {
+			actual := parseContextToken(tt.token)
+			if actual != tt.expected {
+				t.Fatalf("Expected %+v, got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	logging "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
@@ -48,9 +49,13 @@ type (
 	// cluster.  Listeners can subscribe to a particular service and port and
 	// EndpointsWatcher will publish the address set and all future changes for
 	// that service:port.
+	//
+	// When enableEndpointSlices is set, the address sets are built from the
+	// EndpointSlices of each service rather than from its Endpoints resource.
 	EndpointsWatcher struct {
-		publishers map[ServiceID]*servicePublisher
-		k8sAPI     *k8s.API
+		publishers           map[ServiceID]*servicePublisher
+		k8sAPI               *k8s.API
+		enableEndpointSlices bool
 
 		log          *logging.Entry
 		sync.RWMutex // This mutex protects modification of the map itself.
@@ -66,9 +71,10 @@ type (
 	// requested, the address set will be filtered to only include addresses
 	// with the requested hostname.
 	servicePublisher struct {
-		id     ServiceID
-		log    *logging.Entry
-		k8sAPI *k8s.API
+		id                   ServiceID
+		log                  *logging.Entry
+		k8sAPI               *k8s.API
+		enableEndpointSlices bool
 
 		ports map[portAndHostname]*portPublisher
 		// All access to the servicePublisher and its portPublishers is explicitly synchronized by
@@ -80,13 +86,14 @@ type (
 	// hostname.  Multiple listeners may be subscribed to a portPublisher.
 	// portPublisher maintains the current state of the address set and
 	// publishes diffs to all listeners when updates come from either the
-	// endpoints API or the service API.
+	// endpoints API (or the endpointslices API) or the service API.
 	portPublisher struct {
-		id         ServiceID
-		targetPort namedPort
-		hostname   string
-		log        *logging.Entry
-		k8sAPI     *k8s.API
+		id                   ServiceID
+		targetPort           namedPort
+		hostname             string
+		log                  *logging.Entry
+		k8sAPI               *k8s.API
+		enableEndpointSlices bool
 
 		exists    bool
 		pods      PodSet
@@ -105,11 +112,14 @@ type (
 var endpointsVecs = newEndpointsMetricsVecs()
 
 // NewEndpointsWatcher creates an EndpointsWatcher and begins watching the
-// k8sAPI for pod, service, and endpoint changes.
-func NewEndpointsWatcher(k8sAPI *k8s.API, log *logging.Entry) *EndpointsWatcher {
+// k8sAPI for pod, service, and endpoint changes. If enableEndpointSlices is
+// true, EndpointSlices are watched instead of Endpoints, in which case the
+// k8sAPI must have been initialized with the ES resource.
+func NewEndpointsWatcher(k8sAPI *k8s.API, log *logging.Entry, enableEndpointSlices bool) *EndpointsWatcher {
 	ew := &EndpointsWatcher{
-		publishers: make(map[ServiceID]*servicePublisher),
-		k8sAPI:     k8sAPI,
+		publishers:           make(map[ServiceID]*servicePublisher),
+		k8sAPI:               k8sAPI,
+		enableEndpointSlices: enableEndpointSlices,
 		log: log.WithFields(logging.Fields{
 			"component": "endpoints-watcher",
 		}),
@@ -128,11 +138,19 @@ func NewEndpointsWatcher(k8sAPI *k8s.API, log *logging.Entry) *EndpointsWatcher
 		UpdateFunc: func(_, obj interface{}) { ew.addService(obj) },
 	})
 
-	k8sAPI.Endpoint().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    ew.addEndpoints,
-		DeleteFunc: ew.deleteEndpoints,
-		UpdateFunc: func(_, obj interface{}) { ew.addEndpoints(obj) },
-	})
+	if enableEndpointSlices {
+		k8sAPI.ES().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    ew.addEndpointSlice,
+			DeleteFunc: ew.deleteEndpointSlice,
+			UpdateFunc: ew.updateEndpointSlice,
+		})
+	} else {
+		k8sAPI.Endpoint().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    ew.addEndpoints,
+			DeleteFunc: ew.deleteEndpoints,
+			UpdateFunc: func(_, obj interface{}) { ew.addEndpoints(obj) },
+		})
+	}
 
 	return ew
 }
@@ -266,6 +284,68 @@ func (ew *EndpointsWatcher) deleteEndpoints(obj interface{}) {
 	}
 }
 
+func (ew *EndpointsWatcher) addEndpointSlice(obj interface{}) {
+	slice := obj.(*discovery.EndpointSlice)
+	if slice.Namespace == kubeSystem {
+		return
+	}
+	id, ok := endpointSliceServiceID(slice)
+	if !ok {
+		ew.log.Debugf("skipping EndpointSlice %s/%s without a service name label", slice.Namespace, slice.Name)
+		return
+	}
+
+	sp := ew.getOrNewServicePublisher(id)
+
+	sp.updateEndpointSlice(nil, slice)
+}
+
+func (ew *EndpointsWatcher) updateEndpointSlice(oldObj interface{}, newObj interface{}) {
+	oldSlice := oldObj.(*discovery.EndpointSlice)
+	newSlice := newObj.(*discovery.EndpointSlice)
+	if newSlice.Namespace == kubeSystem {
+		return
+	}
+	id, ok := endpointSliceServiceID(newSlice)
+	if !ok {
+		ew.log.Debugf("skipping EndpointSlice %s/%s without a service name label", newSlice.Namespace, newSlice.Name)
+		return
+	}
+
+	sp := ew.getOrNewServicePublisher(id)
+
+	sp.updateEndpointSlice(oldSlice, newSlice)
+}
+
+func (ew *EndpointsWatcher) deleteEndpointSlice(obj interface{}) {
+	slice, ok := obj.(*discovery.EndpointSlice)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			ew.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		slice, ok = tombstone.Obj.(*discovery.EndpointSlice)
+		if !ok {
+			ew.log.Errorf("DeletedFinalStateUnknown contained object that is not an EndpointSlice %#v", obj)
+			return
+		}
+	}
+
+	if slice.Namespace == kubeSystem {
+		return
+	}
+	id, ok := endpointSliceServiceID(slice)
+	if !ok {
+		return
+	}
+
+	sp, ok := ew.getServicePublisher(id)
+	if ok {
+		sp.updateEndpointSlice(slice, nil)
+	}
+}
+
 // Returns the servicePublisher for the given id if it exists.  Otherwise,
 // create a new one and return it.
 func (ew *EndpointsWatcher) getOrNewServicePublisher(id ServiceID) *servicePublisher {
@@ -283,8 +363,9 @@ func (ew *EndpointsWatcher) getOrNewServicePublisher(id ServiceID) *servicePubli
 				"ns":        id.Namespace,
 				"svc":       id.Name,
 			}),
-			k8sAPI: ew.k8sAPI,
-			ports:  make(map[portAndHostname]*portPublisher),
+			k8sAPI:               ew.k8sAPI,
+			enableEndpointSlices: ew.enableEndpointSlices,
+			ports:                make(map[portAndHostname]*portPublisher),
 		}
 		ew.publishers[id] = sp
 	}
@@ -312,6 +393,19 @@ func (sp *servicePublisher) updateEndpoints(newEndpoints *corev1.Endpoints) {
 	}
 }
 
+// updateEndpointSlice applies the change from oldSlice to newSlice to all of
+// the service's ports. oldSlice is nil when the slice has just been created
+// and newSlice is nil when it has been deleted.
+func (sp *servicePublisher) updateEndpointSlice(oldSlice, newSlice *discovery.EndpointSlice) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.log.Debugf("Updating endpoint slice for %s", sp.id)
+
+	for _, port := range sp.ports {
+		port.updateEndpointSlice(oldSlice, newSlice)
+	}
+}
+
 func (sp *servicePublisher) deleteEndpoints() {
 	sp.Lock()
 	defer sp.Unlock()
@@ -384,13 +478,26 @@ func (sp *servicePublisher) newPortPublisher(srcPort Port, hostname string) *por
 	log := sp.log.WithField("port", srcPort)
 
 	port := &portPublisher{
-		listeners:  []EndpointUpdateListener{},
-		targetPort: targetPort,
-		hostname:   hostname,
-		exists:     exists,
-		k8sAPI:     sp.k8sAPI,
-		log:        log,
-		metrics:    endpointsVecs.newEndpointsMetrics(sp.metricsLabels(srcPort, hostname)),
+		id:                   sp.id,
+		listeners:            []EndpointUpdateListener{},
+		targetPort:           targetPort,
+		hostname:             hostname,
+		exists:               exists,
+		k8sAPI:               sp.k8sAPI,
+		enableEndpointSlices: sp.enableEndpointSlices,
+		log:                  log,
+		metrics:              endpointsVecs.newEndpointsMetrics(sp.metricsLabels(srcPort, hostname)),
+	}
+
+	if sp.enableEndpointSlices {
+		slices, err := sp.k8sAPI.GetEndpointSlicesFor(sp.id.Namespace, sp.id.Name)
+		if err != nil {
+			sp.log.Errorf("error getting endpoint slices: %s", err)
+		}
+		if len(slices) > 0 {
+			port.updateEndpointSlices(slices)
+		}
+		return port
 	}
 
 	endpoints, err := sp.k8sAPI.Endpoint().Lister().Endpoints(sp.id.Namespace).Get(sp.id.Name)
@@ -418,12 +525,69 @@ func (sp *servicePublisher) metricsLabels(port Port, hostname string) prometheus
 
 func (pp *portPublisher) updateEndpoints(endpoints *corev1.Endpoints) {
 	newPods := pp.endpointsToAddresses(endpoints)
+	add, remove := diffPods(pp.pods, newPods)
+	pp.publish(newPods, add, remove)
+}
+
+// updateEndpointSlices replaces the whole address set with the addresses of
+// all the given slices, which must belong to the same service.
+func (pp *portPublisher) updateEndpointSlices(slices []*discovery.EndpointSlice) {
+	newPods := PodSet{
+		Pods:   make(map[PodID]Address),
+		Labels: pp.serviceLabels(),
+	}
+	for _, slice := range slices {
+		for id, address := range pp.endpointSliceToAddresses(slice).Pods {
+			newPods.Pods[id] = address
+		}
+	}
+	add, remove := diffPods(pp.pods, newPods)
+	pp.publish(newPods, add, remove)
+}
+
+// updateEndpointSlice diffs a single slice against its previous version and
+// applies only that difference to the address set, so that updates to large
+// services don't require rebuilding the addresses of every other slice.
+func (pp *portPublisher) updateEndpointSlice(oldSlice, newSlice *discovery.EndpointSlice) {
+	oldPods := PodSet{Pods: make(map[PodID]Address)}
+	if oldSlice != nil {
+		// Use the addresses we already know about rather than resolving the
+		// old slice again, since its pods may no longer exist.
+		for _, id := range pp.endpointSliceToIDs(oldSlice) {
+			if address, ok := pp.pods.Pods[id]; ok {
+				oldPods.Pods[id] = address
+			}
+		}
+	}
+	newPods := PodSet{Pods: make(map[PodID]Address), Labels: pp.serviceLabels()}
+	if newSlice != nil {
+		newPods = pp.endpointSliceToAddresses(newSlice)
+	}
+	add, remove := diffPods(oldPods, newPods)
+
+	pods := PodSet{
+		Pods:   make(map[PodID]Address),
+		Labels: pp.serviceLabels(),
+	}
+	for id, address := range pp.pods.Pods {
+		if _, ok := remove.Pods[id]; !ok {
+			pods.Pods[id] = address
+		}
+	}
+	for id, address := range add.Pods {
+		pods.Pods[id] = address
+	}
+	pp.publish(pods, add, remove)
+}
+
+// publish notifies the listeners of the given changes and makes newPods the
+// current address set.
+func (pp *portPublisher) publish(newPods, add, remove PodSet) {
 	if len(newPods.Pods) == 0 {
 		for _, listener := range pp.listeners {
 			listener.NoEndpoints(true)
 		}
 	} else {
-		add, remove := diffPods(pp.pods, newPods)
 		for _, listener := range pp.listeners {
 			if len(remove.Pods) > 0 {
 				listener.Remove(remove)
@@ -491,6 +655,110 @@ func (pp *portPublisher) endpointsToAddresses(endpoints *corev1.Endpoints) PodSe
 	}
 }
 
+func (pp *portPublisher) endpointSliceToAddresses(slice *discovery.EndpointSlice) PodSet {
+	pods := make(map[PodID]Address)
+	if slice.AddressType != discovery.AddressTypeIPv4 && slice.AddressType != discovery.AddressTypeIPv6 {
+		pp.log.Debugf("skipping EndpointSlice %s with address type %s", slice.Name, slice.AddressType)
+		return PodSet{Pods: pods, Labels: pp.serviceLabels()}
+	}
+
+	resolvedPort := pp.resolveSliceTargetPort(slice.Ports)
+	for _, endpoint := range slice.Endpoints {
+		if !isReady(endpoint) {
+			continue
+		}
+		if pp.hostname != "" && (endpoint.Hostname == nil || pp.hostname != *endpoint.Hostname) {
+			continue
+		}
+		for _, ip := range endpoint.Addresses {
+			if endpoint.TargetRef == nil {
+				id := ServiceID{
+					Name: strings.Join([]string{
+						pp.id.Name,
+						ip,
+						fmt.Sprint(resolvedPort),
+					}, "-"),
+					Namespace: slice.Namespace,
+				}
+				pods[id] = Address{
+					IP:   ip,
+					Port: resolvedPort,
+				}
+				continue
+			}
+			if endpoint.TargetRef.Kind == "Pod" {
+				id := PodID{
+					Name:      endpoint.TargetRef.Name,
+					Namespace: endpoint.TargetRef.Namespace,
+				}
+				pod, err := pp.k8sAPI.Pod().Lister().Pods(id.Namespace).Get(id.Name)
+				if err != nil {
+					pp.log.Errorf("Unable to fetch pod %v: %s", id, err)
+					continue
+				}
+				ownerKind, ownerName := pp.k8sAPI.GetOwnerKindAndName(pod, false)
+				pods[id] = Address{
+					IP:        ip,
+					Port:      resolvedPort,
+					Pod:       pod,
+					OwnerName: ownerName,
+					OwnerKind: ownerKind,
+				}
+			}
+		}
+	}
+	return PodSet{
+		Pods:   pods,
+		Labels: pp.serviceLabels(),
+	}
+}
+
+// endpointSliceToIDs returns the IDs of the addresses that
+// endpointSliceToAddresses would build for the slice, without resolving any
+// pods.
+func (pp *portPublisher) endpointSliceToIDs(slice *discovery.EndpointSlice) []PodID {
+	ids := []PodID{}
+	resolvedPort := pp.resolveSliceTargetPort(slice.Ports)
+	for _, endpoint := range slice.Endpoints {
+		for _, ip := range endpoint.Addresses {
+			if endpoint.TargetRef == nil {
+				ids = append(ids, ServiceID{
+					Name: strings.Join([]string{
+						pp.id.Name,
+						ip,
+						fmt.Sprint(resolvedPort),
+					}, "-"),
+					Namespace: slice.Namespace,
+				})
+			} else if endpoint.TargetRef.Kind == "Pod" {
+				ids = append(ids, PodID{
+					Name:      endpoint.TargetRef.Name,
+					Namespace: endpoint.TargetRef.Namespace,
+				})
+			}
+		}
+	}
+	return ids
+}
+
+func (pp *portPublisher) serviceLabels() map[string]string {
+	return map[string]string{"service": pp.id.Name, "namespace": pp.id.Namespace}
+}
+
+func (pp *portPublisher) resolveSliceTargetPort(ports []discovery.EndpointPort) Port {
+	switch pp.targetPort.Type {
+	case intstr.Int:
+		return Port(pp.targetPort.IntVal)
+	case intstr.String:
+		for _, p := range ports {
+			if p.Name != nil && p.Port != nil && *p.Name == pp.targetPort.StrVal {
+				return Port(*p.Port)
+			}
+		}
+	}
+	return Port(0)
+}
+
 func (pp *portPublisher) resolveTargetPort(subset corev1.EndpointSubset) Port {
 	switch pp.targetPort.Type {
 	case intstr.Int:
@@ -507,6 +775,17 @@ func (pp *portPublisher) resolveTargetPort(subset corev1.EndpointSubset) Port {
 
 func (pp *portPublisher) updatePort(targetPort namedPort) {
 	pp.targetPort = targetPort
+
+	if pp.enableEndpointSlices {
+		slices, err := pp.k8sAPI.GetEndpointSlicesFor(pp.id.Namespace, pp.id.Name)
+		if err == nil {
+			pp.updateEndpointSlices(slices)
+		} else {
+			pp.log.Errorf("Unable to get endpoint slices during port update: %s", err)
+		}
+		return
+	}
+
 	endpoints, err := pp.k8sAPI.Endpoint().Lister().Endpoints(pp.id.Namespace).Get(pp.id.Name)
 	if err == nil {
 		pp.updateEndpoints(endpoints)
@@ -584,6 +863,25 @@ func getTargetPort(service *corev1.Service, port Port) namedPort {
 	return targetPort
 }
 
+// endpointSliceServiceID returns the ID of the service that the given slice
+// belongs to, as indicated by its kubernetes.io/service-name label.
+func endpointSliceServiceID(slice *discovery.EndpointSlice) (ServiceID, bool) {
+	name, ok := slice.Labels[discovery.LabelServiceName]
+	if !ok || name == "" {
+		return ServiceID{}, false
+	}
+	return ServiceID{
+		Namespace: slice.Namespace,
+		Name:      name,
+	}, true
+}
+
+// isReady returns false only if the endpoint is explicitly marked as not
+// ready; an unknown readiness is interpreted as ready.
+func isReady(endpoint discovery.Endpoint) bool {
+	return endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+}
+
 func diffPods(oldPods, newPods PodSet) (add, remove PodSet) {
 	// TODO: this detects pods which have been added or removed, but does not
 	// detect pods which have been modified.  A modified pod should trigger
@@ -1,13 +1,15 @@
 package watcher
 
 import (
-	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 
 	logging "github.com/sirupsen/logrus"
 )
@@ -27,7 +29,7 @@ func newBufferingEndpointListener() *bufferingEndpointListener {
 }
 
 func addressString(address Address) string {
-	return fmt.Sprintf("%s:%d", address.IP, address.Port)
+	return net.JoinHostPort(address.IP, strconv.Itoa(int(address.Port)))
 }
 
 func (bel *bufferingEndpointListener) Add(set PodSet) {
@@ -470,7 +472,7 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()))
+			watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), false)
 
 			k8sAPI.Sync()
 
@@ -591,7 +593,7 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()))
+			watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), false)
 
 			k8sAPI.Sync()
 
@@ -617,3 +619,189 @@ status:
 	}
 
 }
+
+func TestEndpointsWatcherWithEndpointSlices(t *testing.T) {
+	k8sConfigs := []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 8989
+    targetPort: http
+    name: http`,
+		`
+apiVersion: discovery.k8s.io/v1beta1
+kind: EndpointSlice
+metadata:
+  name: name1-abcde
+  namespace: ns
+  labels:
+    kubernetes.io/service-name: name1
+addressType: IPv4
+endpoints:
+- addresses:
+  - 172.17.0.12
+  conditions:
+    ready: true
+  targetRef:
+    kind: Pod
+    name: name1-1
+    namespace: ns
+- addresses:
+  - 172.17.0.19
+  conditions:
+    ready: false
+  targetRef:
+    kind: Pod
+    name: name1-2
+    namespace: ns
+- addresses:
+  - 172.17.0.21
+ports:
+- name: http
+  port: 8080`,
+		`
+apiVersion: discovery.k8s.io/v1beta1
+kind: EndpointSlice
+metadata:
+  name: name1-fghij
+  namespace: ns
+  labels:
+    kubernetes.io/service-name: name1
+addressType: IPv6
+endpoints:
+- addresses:
+  - fd00:10:244::c
+  targetRef:
+    kind: Pod
+    name: name1-3
+    namespace: ns
+ports:
+- name: http
+  port: 8080`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+status:
+  phase: Running
+  podIP: 172.17.0.12`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-2
+  namespace: ns
+status:
+  phase: Running
+  podIP: 172.17.0.19`,
+		`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-3
+  namespace: ns
+status:
+  phase: Running
+  podIP: fd00:10:244::c`,
+	}
+
+	t.Run("merges the ready addresses of all the service's slices", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(k8sConfigs...)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+
+		watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), true)
+
+		k8sAPI.Sync()
+
+		listener := newBufferingEndpointListener()
+
+		err = watcher.Subscribe(ServiceID{Name: "name1", Namespace: "ns"}, 8989, "", listener)
+		if err != nil {
+			t.Fatalf("Expected no error, got [%s]", err)
+		}
+
+		actualAddresses := make([]string, 0)
+		actualAddresses = append(actualAddresses, listener.added...)
+		sort.Strings(actualAddresses)
+
+		testCompare(t, []string{"172.17.0.12:8080", "172.17.0.21:8080", "[fd00:10:244::c]:8080"}, actualAddresses)
+
+		if listener.noEndpointsCalled {
+			t.Fatal("Expected NoEndpoints not to be called")
+		}
+	})
+
+	t.Run("only publishes the changes of an updated slice", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(k8sConfigs...)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+
+		watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), true)
+
+		k8sAPI.Sync()
+
+		listener := newBufferingEndpointListener()
+
+		err = watcher.Subscribe(ServiceID{Name: "name1", Namespace: "ns"}, 8989, "", listener)
+		if err != nil {
+			t.Fatalf("Expected no error, got [%s]", err)
+		}
+
+		oldSlice, err := k8sAPI.ES().Lister().EndpointSlices("ns").Get("name1-abcde")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		newSlice := oldSlice.DeepCopy()
+		ready := true
+		newSlice.Endpoints = newSlice.Endpoints[1:2]
+		newSlice.Endpoints[0].Conditions.Ready = &ready
+
+		listener.added = []string{}
+		watcher.updateEndpointSlice(oldSlice, newSlice)
+
+		testCompare(t, []string{"172.17.0.19:8080"}, listener.added)
+
+		actualRemoved := make([]string, 0)
+		actualRemoved = append(actualRemoved, listener.removed...)
+		sort.Strings(actualRemoved)
+		testCompare(t, []string{"172.17.0.12:8080", "172.17.0.21:8080"}, actualRemoved)
+	})
+
+	t.Run("sends NoEndpoints when the last slice is deleted", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(k8sConfigs[0], k8sConfigs[2], k8sConfigs[5])
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+
+		watcher := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), true)
+
+		k8sAPI.Sync()
+
+		listener := newBufferingEndpointListener()
+
+		err = watcher.Subscribe(ServiceID{Name: "name1", Namespace: "ns"}, 8989, "", listener)
+		if err != nil {
+			t.Fatalf("Expected no error, got [%s]", err)
+		}
+
+		slice, err := k8sAPI.ES().Lister().EndpointSlices("ns").Get("name1-fghij")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		watcher.deleteEndpointSlice(cache.DeletedFinalStateUnknown{Obj: slice})
+
+		if !listener.noEndpointsCalled || !listener.noEndpointsExists {
+			t.Fatal("Expected NoEndpoints to be called for an existing service")
+		}
+	})
+}
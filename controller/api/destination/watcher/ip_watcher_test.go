@@ -397,6 +397,80 @@ status:
 			expectedNoEndpointsServiceExists: false,
 			expectedError:                    false,
 		},
+		{
+			serviceType: "IPv6 services",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Service
+metadata:
+  name: name1
+  namespace: ns
+spec:
+  type: ClusterIP
+  clusterIP: fd00:10:96::a
+  ports:
+  - port: 8989`,
+				`
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: name1
+  namespace: ns
+subsets:
+- addresses:
+  - ip: fd00:10:244::c
+    targetRef:
+      kind: Pod
+      name: name1-1
+      namespace: ns
+  ports:
+  - port: 8989`,
+				`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+  ownerReferences:
+  - kind: ReplicaSet
+    name: rs-1
+status:
+  phase: Running
+  podIP: fd00:10:244::c`,
+			},
+			host: "fd00:10:96::a",
+			port: 8989,
+			expectedAddresses: []string{
+				"[fd00:10:244::c]:8989",
+			},
+			expectedNoEndpoints:              false,
+			expectedNoEndpointsServiceExists: false,
+			expectedError:                    false,
+		},
+		{
+			serviceType: "IPv6 pods",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: name1-1
+  namespace: ns
+  ownerReferences:
+  - kind: ReplicaSet
+    name: rs-1
+status:
+  phase: Running
+  podIP: fd00:10:244::c`,
+			},
+			host: "fd00:10:244::c",
+			port: 8989,
+			expectedAddresses: []string{
+				"[fd00:10:244::c]:8989",
+			},
+			expectedNoEndpoints:              false,
+			expectedNoEndpointsServiceExists: false,
+			expectedError:                    false,
+		},
 		{
 			serviceType: "pod with hostNetwork",
 			k8sConfigs: []string{`
@@ -431,7 +505,7 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			endpoints := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()))
+			endpoints := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), false)
 			watcher := NewIPWatcher(k8sAPI, endpoints, logging.WithField("test", t.Name()))
 
 			k8sAPI.Sync()
@@ -569,7 +643,7 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			endpoints := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()))
+			endpoints := NewEndpointsWatcher(k8sAPI, logging.WithField("test", t.Name()), false)
 			watcher := NewIPWatcher(k8sAPI, endpoints, logging.WithField("test", t.Name()))
 
 			k8sAPI.Sync()
@@ -0,0 +1,357 @@
+package watcher
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+
+	saz "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/prometheus/client_golang/prometheus"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+)
+
+type (
+	// PodPortID identifies a port of a pod.
+	PodPortID struct {
+		Pod  PodID
+		Port Port
+	}
+
+	// PolicyWatcher watches all the ServerAuthorizations and pods in the
+	// Kubernetes cluster. Listeners can subscribe to a pod port and
+	// PolicyWatcher will publish the ServerAuthorizations that apply to it.
+	PolicyWatcher struct {
+		k8sAPI     *k8s.API
+		publishers map[PodID]map[Port]*policyPublisher
+
+		log          *logging.Entry
+		sync.RWMutex // This mutex protects modification of the map itself.
+	}
+
+	policyPublisher struct {
+		id             PodPortID
+		authorizations []*saz.ServerAuthorization
+		listeners      []PolicyUpdateListener
+
+		log           *logging.Entry
+		policyMetrics metrics
+		// All access to the policyPublisher is explicitly synchronized by this mutex.
+		sync.Mutex
+	}
+
+	// PolicyUpdateListener is the interface that subscribers must implement.
+	PolicyUpdateListener interface {
+		UpdatePolicy(authorizations []*saz.ServerAuthorization)
+	}
+)
+
+var policyVecs = newMetricsVecs("policy", []string{"namespace", "pod", "port"})
+
+func (id PodPortID) String() string {
+	return fmt.Sprintf("%s:%d", id.Pod, id.Port)
+}
+
+// NewPolicyWatcher creates a PolicyWatcher and begins watching the k8sAPI for
+// ServerAuthorization and pod changes.
+func NewPolicyWatcher(k8sAPI *k8s.API, log *logging.Entry) *PolicyWatcher {
+	watcher := &PolicyWatcher{
+		k8sAPI:     k8sAPI,
+		publishers: make(map[PodID]map[Port]*policyPublisher),
+		log:        log.WithField("component", "policy-watcher"),
+	}
+
+	k8sAPI.Authz().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    watcher.addAuthorization,
+			UpdateFunc: watcher.updateAuthorization,
+			DeleteFunc: watcher.deleteAuthorization,
+		},
+	)
+
+	k8sAPI.Pod().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    watcher.addPod,
+			UpdateFunc: watcher.updatePod,
+			DeleteFunc: watcher.deletePod,
+		},
+	)
+
+	return watcher
+}
+
+///
+/// PolicyWatcher
+///
+
+// Subscribe to a pod port.
+// Each time the set of ServerAuthorizations that apply to the port changes,
+// the listener will be updated.
+func (pw *PolicyWatcher) Subscribe(id PodPortID, listener PolicyUpdateListener) error {
+	pw.log.Infof("Establishing watch on pod port %s", id)
+
+	pw.Lock()
+	defer pw.Unlock()
+
+	ports, ok := pw.publishers[id.Pod]
+	if !ok {
+		ports = make(map[Port]*policyPublisher)
+		pw.publishers[id.Pod] = ports
+	}
+
+	publisher, ok := ports[id.Port]
+	if !ok {
+		publisher = &policyPublisher{
+			id:             id,
+			authorizations: pw.getAuthorizations(id),
+			listeners:      make([]PolicyUpdateListener, 0),
+			log: pw.log.WithFields(logging.Fields{
+				"component": "policy-publisher",
+				"ns":        id.Pod.Namespace,
+				"pod":       id.Pod.Name,
+				"port":      id.Port,
+			}),
+			policyMetrics: policyVecs.newMetrics(policyLabels(id)),
+		}
+		ports[id.Port] = publisher
+	}
+
+	publisher.subscribe(listener)
+	return nil
+}
+
+// Unsubscribe removes a listener from the subscribers list for this pod port.
+// The publisher of the port is dropped once it has no more listeners, as pods
+// come and go over time.
+func (pw *PolicyWatcher) Unsubscribe(id PodPortID, listener PolicyUpdateListener) error {
+	pw.log.Infof("Stopping watch on pod port %s", id)
+
+	pw.Lock()
+	defer pw.Unlock()
+
+	publisher, ok := pw.publishers[id.Pod][id.Port]
+	if !ok {
+		return fmt.Errorf("cannot unsubscribe from unknown pod port [%s] ", id)
+	}
+
+	if publisher.unsubscribe(listener) == 0 {
+		delete(pw.publishers[id.Pod], id.Port)
+		if len(pw.publishers[id.Pod]) == 0 {
+			delete(pw.publishers, id.Pod)
+		}
+		policyVecs.unregister(policyLabels(id))
+	}
+	return nil
+}
+
+func (pw *PolicyWatcher) addAuthorization(obj interface{}) {
+	authorization := obj.(*saz.ServerAuthorization)
+	pw.updateNamespace(authorization.Namespace)
+}
+
+func (pw *PolicyWatcher) updateAuthorization(old interface{}, new interface{}) {
+	pw.addAuthorization(new)
+}
+
+func (pw *PolicyWatcher) deleteAuthorization(obj interface{}) {
+	authorization, ok := obj.(*saz.ServerAuthorization)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			pw.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		authorization, ok = tombstone.Obj.(*saz.ServerAuthorization)
+		if !ok {
+			pw.log.Errorf("DeletedFinalStateUnknown contained object that is not a ServerAuthorization %#v", obj)
+			return
+		}
+	}
+
+	pw.updateNamespace(authorization.Namespace)
+}
+
+func (pw *PolicyWatcher) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	pw.updatePodPorts(PodID{Namespace: pod.Namespace, Name: pod.Name})
+}
+
+func (pw *PolicyWatcher) updatePod(old interface{}, new interface{}) {
+	pw.addPod(new)
+}
+
+func (pw *PolicyWatcher) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			pw.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*corev1.Pod)
+		if !ok {
+			pw.log.Errorf("DeletedFinalStateUnknown contained object that is not a Pod %#v", obj)
+			return
+		}
+	}
+
+	pw.updatePodPorts(PodID{Namespace: pod.Namespace, Name: pod.Name})
+}
+
+// updateNamespace recomputes the ServerAuthorizations of all the subscribed
+// pod ports of a namespace.
+func (pw *PolicyWatcher) updateNamespace(namespace string) {
+	pw.RLock()
+	defer pw.RUnlock()
+
+	for pod, ports := range pw.publishers {
+		if pod.Namespace != namespace {
+			continue
+		}
+		for _, publisher := range ports {
+			publisher.update(pw.getAuthorizations(publisher.id))
+		}
+	}
+}
+
+// updatePodPorts recomputes the ServerAuthorizations of the subscribed ports
+// of a pod, whose labels or container ports may have changed.
+func (pw *PolicyWatcher) updatePodPorts(pod PodID) {
+	pw.RLock()
+	defer pw.RUnlock()
+
+	for _, publisher := range pw.publishers[pod] {
+		publisher.update(pw.getAuthorizations(publisher.id))
+	}
+}
+
+// getAuthorizations returns the ServerAuthorizations that apply to the given
+// pod port, sorted by name. It returns nil when the pod doesn't exist.
+func (pw *PolicyWatcher) getAuthorizations(id PodPortID) []*saz.ServerAuthorization {
+	pod, err := pw.k8sAPI.Pod().Lister().Pods(id.Pod.Namespace).Get(id.Pod.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			pw.log.Errorf("error getting pod %s: %s", id.Pod, err)
+		}
+		return nil
+	}
+
+	authorizations, err := pw.k8sAPI.Authz().Lister().ServerAuthorizations(id.Pod.Namespace).List(labels.Everything())
+	if err != nil {
+		pw.log.Errorf("error listing ServerAuthorizations in namespace %s: %s", id.Pod.Namespace, err)
+		return nil
+	}
+
+	var matching []*saz.ServerAuthorization
+	for _, authorization := range authorizations {
+		selector, err := metav1.LabelSelectorAsSelector(authorization.Spec.PodSelector)
+		if err != nil {
+			pw.log.Warnf("ignoring ServerAuthorization %s/%s with an invalid pod selector: %s", authorization.Namespace, authorization.Name, err)
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) && portMatches(authorization.Spec.Port, pod, id.Port) {
+			matching = append(matching, authorization)
+		}
+	}
+
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].Name < matching[j].Name
+	})
+	return matching
+}
+
+// portMatches returns true if the given port, referenced by number or by
+// container port name, is the given port of the pod.
+func portMatches(ref intstr.IntOrString, pod *corev1.Pod, port Port) bool {
+	if ref.Type == intstr.Int {
+		return Port(ref.IntVal) == port
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == ref.StrVal && Port(containerPort.ContainerPort) == port {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func policyLabels(id PodPortID) prometheus.Labels {
+	return prometheus.Labels{
+		"namespace": id.Pod.Namespace,
+		"pod":       id.Pod.Name,
+		"port":      strconv.FormatUint(uint64(id.Port), 10),
+	}
+}
+
+///
+/// policyPublisher
+///
+
+func (pp *policyPublisher) subscribe(listener PolicyUpdateListener) {
+	pp.Lock()
+	defer pp.Unlock()
+
+	pp.listeners = append(pp.listeners, listener)
+	listener.UpdatePolicy(pp.authorizations)
+
+	pp.policyMetrics.setSubscribers(len(pp.listeners))
+}
+
+// unsubscribe removes the listener and returns the number of listeners left.
+func (pp *policyPublisher) unsubscribe(listener PolicyUpdateListener) int {
+	pp.Lock()
+	defer pp.Unlock()
+
+	for i, item := range pp.listeners {
+		if item == listener {
+			// delete the item from the slice
+			n := len(pp.listeners)
+			pp.listeners[i] = pp.listeners[n-1]
+			pp.listeners[n-1] = nil
+			pp.listeners = pp.listeners[:n-1]
+			break
+		}
+	}
+
+	pp.policyMetrics.setSubscribers(len(pp.listeners))
+	return len(pp.listeners)
+}
+
+func (pp *policyPublisher) update(authorizations []*saz.ServerAuthorization) {
+	pp.Lock()
+	defer pp.Unlock()
+
+	if sameAuthorizations(pp.authorizations, authorizations) {
+		return
+	}
+	pp.log.Debug("Updating ServerAuthorizations")
+
+	pp.authorizations = authorizations
+	for _, listener := range pp.listeners {
+		listener.UpdatePolicy(authorizations)
+	}
+
+	pp.policyMetrics.incUpdates()
+}
+
+func sameAuthorizations(a, b []*saz.ServerAuthorization) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !reflect.DeepEqual(a[i].Spec, b[i].Spec) {
+			return false
+		}
+	}
+	return true
+}
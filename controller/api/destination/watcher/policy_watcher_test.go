@@ -0,0 +1,252 @@
+package watcher
+
+import (
+	"reflect"
+	"testing"
+
+	saz "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	logging "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+)
+
+type bufferingPolicyListener struct {
+	updates [][]string
+}
+
+func newBufferingPolicyListener() *bufferingPolicyListener {
+	return &bufferingPolicyListener{
+		updates: [][]string{},
+	}
+}
+
+func (bpl *bufferingPolicyListener) UpdatePolicy(authorizations []*saz.ServerAuthorization) {
+	names := []string{}
+	for _, authorization := range authorizations {
+		names = append(names, authorization.Name)
+	}
+	bpl.updates = append(bpl.updates, names)
+}
+
+var (
+	testPolicyPod = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-1
+  namespace: ns
+  labels:
+    app: web
+spec:
+  containers:
+  - name: web
+    ports:
+    - name: http
+      containerPort: 8080
+    - name: admin
+      containerPort: 9090`
+
+	testAuthorizationByNumber = `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web-by-number
+  namespace: ns
+spec:
+  podSelector:
+    matchLabels:
+      app: web
+  port: 8080
+  allow:
+  - namespaces: [ns]`
+
+	testAuthorizationByName = `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web-by-name
+  namespace: ns
+spec:
+  podSelector:
+    matchLabels:
+      app: web
+  port: http
+  deny:
+  - unauthenticated: true`
+
+	testAuthorizationOtherApp = `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: other-app
+  namespace: ns
+spec:
+  podSelector:
+    matchLabels:
+      app: other
+  port: 8080
+  allow:
+  - unauthenticated: true`
+
+	testAuthorizationOtherNs = `
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: other-ns
+  namespace: other-ns
+spec:
+  podSelector: {}
+  port: 8080
+  allow:
+  - unauthenticated: true`
+)
+
+func TestPolicyWatcher(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		k8sConfigs []string
+		id         PodPortID
+		expected   [][]string
+	}{
+		{
+			name: "authorizations selecting the port by number and by name",
+			k8sConfigs: []string{
+				testPolicyPod,
+				testAuthorizationByNumber,
+				testAuthorizationByName,
+				testAuthorizationOtherApp,
+				testAuthorizationOtherNs,
+			},
+			id:       PodPortID{Pod: PodID{Namespace: "ns", Name: "web-1"}, Port: 8080},
+			expected: [][]string{{"web-by-name", "web-by-number"}},
+		},
+		{
+			name: "no authorization for the port",
+			k8sConfigs: []string{
+				testPolicyPod,
+				testAuthorizationByNumber,
+				testAuthorizationByName,
+			},
+			id:       PodPortID{Pod: PodID{Namespace: "ns", Name: "web-1"}, Port: 9090},
+			expected: [][]string{{}},
+		},
+		{
+			name: "unknown pod",
+			k8sConfigs: []string{
+				testAuthorizationByNumber,
+			},
+			id:       PodPortID{Pod: PodID{Namespace: "ns", Name: "web-2"}, Port: 8080},
+			expected: [][]string{{}},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(tt.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+
+			watcher := NewPolicyWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+			k8sAPI.Sync()
+
+			listener := newBufferingPolicyListener()
+			watcher.Subscribe(tt.id, listener)
+
+			if !reflect.DeepEqual(tt.expected, listener.updates) {
+				t.Fatalf("Expected updates %v, got %v", tt.expected, listener.updates)
+			}
+		})
+	}
+}
+
+func TestPolicyWatcherUpdates(t *testing.T) {
+	id := PodPortID{Pod: PodID{Namespace: "ns", Name: "web-1"}, Port: 8080}
+
+	t.Run("publishes the deletion of an authorization", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(testPolicyPod, testAuthorizationByNumber)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+
+		watcher := NewPolicyWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+		k8sAPI.Sync()
+
+		listener := newBufferingPolicyListener()
+		watcher.Subscribe(id, listener)
+
+		authorization, err := k8sAPI.Authz().Lister().ServerAuthorizations("ns").Get("web-by-number")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		k8sAPI.Authz().Informer().GetStore().Delete(authorization)
+		watcher.deleteAuthorization(cache.DeletedFinalStateUnknown{Obj: authorization})
+
+		expected := [][]string{{"web-by-number"}, {}}
+		if !reflect.DeepEqual(expected, listener.updates) {
+			t.Fatalf("Expected updates %v, got %v", expected, listener.updates)
+		}
+	})
+
+	t.Run("publishes the authorizations of a relabeled pod", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(testPolicyPod, testAuthorizationByNumber, testAuthorizationOtherApp)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+
+		watcher := NewPolicyWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+		k8sAPI.Sync()
+
+		listener := newBufferingPolicyListener()
+		watcher.Subscribe(id, listener)
+
+		pod, err := k8sAPI.Pod().Lister().Pods("ns").Get("web-1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		relabeled := pod.DeepCopy()
+		relabeled.Labels = map[string]string{"app": "other"}
+		k8sAPI.Pod().Informer().GetStore().Update(relabeled)
+		watcher.updatePod(pod, relabeled)
+
+		// updates that don't change the authorizations aren't published
+		watcher.updatePod(relabeled, relabeled)
+
+		expected := [][]string{{"web-by-number"}, {"other-app"}}
+		if !reflect.DeepEqual(expected, listener.updates) {
+			t.Fatalf("Expected updates %v, got %v", expected, listener.updates)
+		}
+	})
+
+	t.Run("drops the publisher of a port without listeners", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI(testPolicyPod)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+
+		watcher := NewPolicyWatcher(k8sAPI, logging.WithField("test", t.Name()))
+
+		k8sAPI.Sync()
+
+		listener := newBufferingPolicyListener()
+		watcher.Subscribe(id, listener)
+		if err := watcher.Unsubscribe(id, listener); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(watcher.publishers) != 0 {
+			t.Fatalf("Expected no publishers, got %v", watcher.publishers)
+		}
+		if err := watcher.Unsubscribe(id, listener); err == nil {
+			t.Fatal("Expected an error when unsubscribing from an unknown pod port")
+		}
+
+		// the port can be subscribed to again
+		watcher.Subscribe(id, listener)
+		if len(listener.updates) != 2 {
+			t.Fatalf("Expected 2 updates, got %v", listener.updates)
+		}
+	})
+}
@@ -110,6 +110,15 @@ func (mv metricsVecs) newMetrics(labels prometheus.Labels) metrics {
 	}
 }
 
+func (mv metricsVecs) unregister(labels prometheus.Labels) {
+	if !mv.subscribers.Delete(labels) {
+		log.Warnf("unable to delete subscribers metric with labels %s", labels)
+	}
+	if !mv.updates.Delete(labels) {
+		log.Warnf("unable to delete updates metric with labels %s", labels)
+	}
+}
+
 func (emv endpointsMetricsVecs) newEndpointsMetrics(labels prometheus.Labels) endpointsMetrics {
 	metrics := emv.newMetrics(labels)
 	return endpointsMetrics{
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
@@ -79,6 +80,12 @@ func (c *grpcOverHTTPClient) Config(ctx context.Context, req *pb.Empty, _ ...grp
 	return &msg, err
 }
 
+func (c *grpcOverHTTPClient) Issuances(ctx context.Context, req *pb.IssuancesRequest, _ ...grpc.CallOption) (*pb.IssuancesResponse, error) {
+	var msg pb.IssuancesResponse
+	err := c.apiRequest(ctx, "Issuances", req, &msg)
+	return &msg, err
+}
+
 func (c *grpcOverHTTPClient) ListPods(ctx context.Context, req *pb.ListPodsRequest, _ ...grpc.CallOption) (*pb.ListPodsResponse, error) {
 	var msg pb.ListPodsResponse
 	err := c.apiRequest(ctx, "ListPods", req, &msg)
@@ -204,6 +211,19 @@ func NewInternalClient(controlPlaneNamespace string, kubeAPIHost string) (APICli
 	return newClient(apiURL, &http.Client{Transport: &ochttp.Transport{}}, controlPlaneNamespace)
 }
 
+// NewInternalMTLSClient creates a new Public API client intended to run inside
+// a Kubernetes cluster, calling the mTLS server of the Public API with the
+// given TLS configuration.
+func NewInternalMTLSClient(controlPlaneNamespace string, apiAddr string, tlsConfig *tls.Config) (APIClient, error) {
+	apiURL, err := url.Parse(fmt.Sprintf("https://%s/", apiAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &ochttp.Transport{Base: &http.Transport{TLSClientConfig: tlsConfig}}
+	return newClient(apiURL, &http.Client{Transport: transport}, controlPlaneNamespace)
+}
+
 // NewExternalClient creates a new Public API client intended to run from
 // outside a Kubernetes cluster.
 func NewExternalClient(controlPlaneNamespace string, kubeAPI *k8s.KubernetesAPI) (APIClient, error) {
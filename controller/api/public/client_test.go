@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -60,6 +61,18 @@ func TestNewInternalClient(t *testing.T) {
 	})
 }
 
+func TestNewInternalMTLSClient(t *testing.T) {
+	client, err := NewInternalMTLSClient("linkerd", "linkerd-controller-api.linkerd.svc.cluster.local:8087", &tls.Config{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedURL := "https://linkerd-controller-api.linkerd.svc.cluster.local:8087/api/v1/"
+	if actualURL := client.(*grpcOverHTTPClient).serverURL.String(); actualURL != expectedURL {
+		t.Fatalf("Expected server URL [%v], but got [%v]", expectedURL, actualURL)
+	}
+}
+
 func TestFromByteStreamToProtocolBuffers(t *testing.T) {
 	t.Run("Correctly marshalls an valid object", func(t *testing.T) {
 		versionInfo := pb.VersionInfo{
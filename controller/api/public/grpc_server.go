@@ -12,6 +12,7 @@ import (
 	"github.com/linkerd/linkerd2/controller/api/util"
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 	configPb "github.com/linkerd/linkerd2/controller/gen/config"
+	auditPb "github.com/linkerd/linkerd2/controller/gen/controller/identity"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/config"
@@ -35,6 +36,7 @@ type APIServer interface {
 type grpcServer struct {
 	prometheusAPI          promv1.API
 	destinationClient      destinationPb.DestinationClient
+	auditClient            auditPb.AuditClient
 	k8sAPI                 *k8s.API
 	controllerNamespace    string
 	clusterDomain          string
@@ -60,6 +62,7 @@ const (
 func newGrpcServer(
 	promAPI promv1.API,
 	destinationClient destinationPb.DestinationClient,
+	auditClient auditPb.AuditClient,
 	k8sAPI *k8s.API,
 	controllerNamespace string,
 	clusterDomain string,
@@ -69,6 +72,7 @@ func newGrpcServer(
 	grpcServer := &grpcServer{
 		prometheusAPI:          promAPI,
 		destinationClient:      destinationClient,
+		auditClient:            auditClient,
 		k8sAPI:                 k8sAPI,
 		controllerNamespace:    controllerNamespace,
 		clusterDomain:          clusterDomain,
@@ -243,6 +247,11 @@ func (s *grpcServer) Config(ctx context.Context, req *pb.Empty) (*configPb.All,
 	return &configPb.All{Global: global, Proxy: proxy, Install: install}, nil
 }
 
+// Pass through to the Audit API of the identity controller
+func (s *grpcServer) Issuances(ctx context.Context, req *pb.IssuancesRequest) (*pb.IssuancesResponse, error) {
+	return s.auditClient.Issuances(ctx, req)
+}
+
 func (s *grpcServer) Tap(req *pb.TapRequest, stream pb.Api_TapServer) error {
 	return status.Error(codes.Unimplemented, "Tap is deprecated in public API, use tap APIServer")
 }
@@ -399,6 +399,7 @@ status:
 			fakeGrpcServer := newGrpcServer(
 				&mProm,
 				nil,
+				nil,
 				k8sAPI,
 				"linkerd",
 				"mycluster.local",
@@ -501,6 +502,7 @@ metadata:
 			fakeGrpcServer := newGrpcServer(
 				&MockProm{},
 				nil,
+				nil,
 				k8sAPI,
 				"linkerd",
 				"mycluster.local",
@@ -531,6 +533,7 @@ func TestConfig(t *testing.T) {
 		fakeGrpcServer := newGrpcServer(
 			&MockProm{},
 			nil,
+			nil,
 			k8sAPI,
 			"linkerd",
 			"mycluster.local",
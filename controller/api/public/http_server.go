@@ -8,6 +8,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	destinationPb "github.com/linkerd/linkerd2-proxy-api/go/destination"
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
+	auditPb "github.com/linkerd/linkerd2/controller/gen/controller/identity"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
@@ -28,6 +29,7 @@ var (
 	edgesPath        = fullURLPathFor("Edges")
 	destGetPath      = fullURLPathFor("DestinationGet")
 	configPath       = fullURLPathFor("Config")
+	issuancesPath    = fullURLPathFor("Issuances")
 )
 
 type handler struct {
@@ -64,6 +66,8 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		h.handleDestGet(w, req)
 	case configPath:
 		h.handleConfig(w, req)
+	case issuancesPath:
+		h.handleIssuances(w, req)
 	default:
 		http.NotFound(w, req)
 	}
@@ -261,6 +265,27 @@ func (h *handler) handleConfig(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (h *handler) handleIssuances(w http.ResponseWriter, req *http.Request) {
+	var protoRequest pb.IssuancesRequest
+	err := protohttp.HTTPRequestToProto(req, &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	rsp, err := h.grpcServer.Issuances(req.Context(), &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	err = protohttp.WriteProtoToHTTPResponse(w, rsp)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
 type streamServer struct {
 	w   protohttp.FlushableResponseWriter
 	req *http.Request
@@ -302,6 +327,7 @@ func NewServer(
 	addr string,
 	prometheusClient promApi.Client,
 	destinationClient destinationPb.DestinationClient,
+	auditClient auditPb.AuditClient,
 	k8sAPI *k8s.API,
 	controllerNamespace string,
 	clusterDomain string,
@@ -311,6 +337,7 @@ func NewServer(
 		grpcServer: newGrpcServer(
 			promv1.NewAPI(prometheusClient),
 			destinationClient,
+			auditClient,
 			k8sAPI,
 			controllerNamespace,
 			clusterDomain,
@@ -66,6 +66,11 @@ func (m *mockGrpcServer) Config(ctx context.Context, req *pb.Empty) (*configPb.A
 	return m.ResponseToReturn.(*configPb.All), m.ErrorToReturn
 }
 
+func (m *mockGrpcServer) Issuances(ctx context.Context, req *pb.IssuancesRequest) (*pb.IssuancesResponse, error) {
+	m.LastRequestReceived = req
+	return m.ResponseToReturn.(*pb.IssuancesResponse), m.ErrorToReturn
+}
+
 func (m *mockGrpcServer) Tap(req *pb.TapRequest, tapServer pb.Api_TapServer) error {
 	m.LastRequestReceived = req
 	if m.ErrorToReturn != nil {
@@ -139,7 +144,18 @@ func TestServer(t *testing.T) {
 			functionCall: func() (proto.Message, error) { return client.Version(context.TODO(), versionReq) },
 		}
 
-		for _, testCase := range []grpcCallTestCase{testListPods, testStatSummary, testVersion} {
+		issuancesReq := &pb.IssuancesRequest{Limit: 10}
+		testIssuances := grpcCallTestCase{
+			expectedRequest: issuancesReq,
+			expectedResponse: &pb.IssuancesResponse{
+				Issuances: []*pb.Issuance{
+					{RequestedIdentity: "web.emojivoto.serviceaccount.identity.linkerd.cluster.local", Code: "OK"},
+				},
+			},
+			functionCall: func() (proto.Message, error) { return client.Issuances(context.TODO(), issuancesReq) },
+		}
+
+		for _, testCase := range []grpcCallTestCase{testListPods, testStatSummary, testVersion, testIssuances} {
 			assertCallWasForwarded(t, &mockGrpcServer.mockServer, testCase.expectedRequest, testCase.expectedResponse, testCase.functionCall)
 		}
 	})
@@ -23,14 +23,16 @@ type promResult struct {
 }
 
 const (
-	promRequests       = promType("QUERY_REQUESTS")
-	promActualRequests = promType("QUERY_ACTUAL_REQUESTS")
-	promTCPConnections = promType("QUERY_TCP_CONNECTIONS")
-	promTCPReadBytes   = promType("QUERY_TCP_READ_BYTES")
-	promTCPWriteBytes  = promType("QUERY_TCP_WRITE_BYTES")
-	promLatencyP50     = promType("0.5")
-	promLatencyP95     = promType("0.95")
-	promLatencyP99     = promType("0.99")
+	promRequests         = promType("QUERY_REQUESTS")
+	promActualRequests   = promType("QUERY_ACTUAL_REQUESTS")
+	promRetriesSkipped   = promType("QUERY_RETRIES_SKIPPED")
+	promResponseStatuses = promType("QUERY_RESPONSE_STATUSES")
+	promTCPConnections   = promType("QUERY_TCP_CONNECTIONS")
+	promTCPReadBytes     = promType("QUERY_TCP_READ_BYTES")
+	promTCPWriteBytes    = promType("QUERY_TCP_WRITE_BYTES")
+	promLatencyP50       = promType("0.5")
+	promLatencyP95       = promType("0.95")
+	promLatencyP99       = promType("0.99")
 
 	namespaceLabel    = model.LabelName("namespace")
 	dstNamespaceLabel = model.LabelName("dst_namespace")
@@ -11,6 +11,7 @@ import (
 	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -56,11 +57,12 @@ const (
 )
 
 type podStats struct {
-	status string
-	inMesh uint64
-	total  uint64
-	failed uint64
-	errors map[string]*pb.PodErrors
+	status       string
+	inMesh       uint64
+	outboundOnly uint64
+	total        uint64
+	failed       uint64
+	errors       map[string]*pb.PodErrors
 }
 
 type trafficSplitStats struct {
@@ -246,6 +248,7 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 		podStat := objInfo.podStats
 		row.Status = podStat.status
 		row.MeshedPodCount = podStat.inMesh
+		row.OutboundOnlyPodCount = podStat.outboundOnly
 		row.RunningPodCount = podStat.total
 		row.FailedPodCount = podStat.failed
 		row.ErrorsByPod = podStat.errors
@@ -546,6 +549,39 @@ func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSum
 	return tsBasicStats, nil
 }
 
+// TrafficSplitLeafStats returns the stats of the leaves of a TrafficSplit over
+// the given time window, indexed by leaf Service. These are the stats that
+// StatSummary reports for TrafficSplits.
+func TrafficSplitLeafStats(ctx context.Context, promAPI promv1.API, ts *v1alpha1.TrafficSplit, timeWindow string) (map[string]*pb.BasicStats, error) {
+	s := &grpcServer{prometheusAPI: promAPI}
+	req := &pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: ts.Namespace,
+				Type:      k8s.TrafficSplit,
+				Name:      ts.Name,
+			},
+		},
+		TimeWindow: timeWindow,
+	}
+	tsStats := &trafficSplitStats{
+		namespace: ts.Namespace,
+		name:      ts.Name,
+		apex:      ts.Spec.Service,
+	}
+
+	tsBasicStats, err := s.getTrafficSplitMetrics(ctx, req, tsStats, timeWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	leafStats := make(map[string]*pb.BasicStats)
+	for key, basicStats := range tsBasicStats {
+		leafStats[key.Leaf] = basicStats
+	}
+	return leafStats, nil
+}
+
 func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats) {
 	basicStats := make(map[rKey]*pb.BasicStats)
 	tcpStats := make(map[rKey]*pb.TcpStats)
@@ -637,6 +673,9 @@ func (s *grpcServer) getPodStats(obj runtime.Object) (*podStats, error) {
 			meshCount.total++
 			if k8s.IsMeshed(pod, s.controllerNamespace) {
 				meshCount.inMesh++
+				if k8s.IsOutboundOnly(pod) {
+					meshCount.outboundOnly++
+				}
 			}
 		}
 
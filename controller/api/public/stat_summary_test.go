@@ -188,6 +188,50 @@ status:
 		testStatSummary(t, expectations)
 	})
 
+	t.Run("Successfully counts the pods injected in outbound-only mode", func(t *testing.T) {
+		expectations := []statSumExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-mode: outbound-only
+status:
+  phase: Running
+`,
+					},
+					mockPromResponse: prometheusMetric("emoji", "pod"),
+				},
+				req: pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+						},
+					},
+					TimeWindow: "1m",
+				},
+				expectedResponse: GenStatSummaryResponse("emoji", pkgK8s.Pod, []string{"emojivoto"}, &PodCounts{
+					Status:           "Running",
+					MeshedPods:       1,
+					OutboundOnlyPods: 1,
+					RunningPods:      1,
+					FailedPods:       0,
+				}, true, false),
+			},
+		}
+
+		testStatSummary(t, expectations)
+	})
+
 	t.Run("Successfully performs a query based on resource type Pod when pod Reason is filled", func(t *testing.T) {
 		expectations := []statSumExpected{
 			{
@@ -1415,6 +1459,7 @@ status:
 			fakeGrpcServer := newGrpcServer(
 				&MockProm{Res: exp.mockPromResponse},
 				nil,
+				nil,
 				k8sAPI,
 				"linkerd",
 				"mycluster.local",
@@ -1440,6 +1485,7 @@ status:
 		fakeGrpcServer := newGrpcServer(
 			&MockProm{Res: model.Vector{}},
 			nil,
+			nil,
 			k8sAPI,
 			"linkerd",
 			"mycluster.local",
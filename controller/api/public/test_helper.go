@@ -33,6 +33,7 @@ type MockAPIClient struct {
 	EdgesResponseToReturn          *pb.EdgesResponse
 	SelfCheckResponseToReturn      *healthcheckPb.SelfCheckResponse
 	ConfigResponseToReturn         *configPb.All
+	IssuancesResponseToReturn      *pb.IssuancesResponse
 	APITapClientToReturn           pb.Api_TapClient
 	APITapByResourceClientToReturn pb.Api_TapByResourceClient
 	DestinationGetClientToReturn   destinationPb.Destination_GetClient
@@ -99,6 +100,11 @@ func (c *MockAPIClient) Config(ctx context.Context, in *pb.Empty, _ ...grpc.Call
 	return c.ConfigResponseToReturn, c.ErrorToReturn
 }
 
+// Issuances provides a mock of a Public API method.
+func (c *MockAPIClient) Issuances(ctx context.Context, in *pb.IssuancesRequest, _ ...grpc.CallOption) (*pb.IssuancesResponse, error) {
+	return c.IssuancesResponseToReturn, c.ErrorToReturn
+}
+
 // MockDestinationGetClient satisfies the Destination_GetClient gRPC interface.
 type MockDestinationGetClient struct {
 	UpdatesToReturn []destinationPb.Update
@@ -171,11 +177,12 @@ type MockProm struct {
 // PodCounts is a test helper struct that is used for representing data in a
 // StatTable.PodGroup.Row.
 type PodCounts struct {
-	Status      string
-	MeshedPods  uint64
-	RunningPods uint64
-	FailedPods  uint64
-	Errors      map[string]*pb.PodErrors
+	Status           string
+	MeshedPods       uint64
+	OutboundOnlyPods uint64
+	RunningPods      uint64
+	FailedPods       uint64
+	Errors           map[string]*pb.PodErrors
 }
 
 // Query performs a query for the given time.
@@ -298,6 +305,7 @@ func GenStatSummaryResponse(resName, resType string, resNs []string, counts *Pod
 
 		if counts != nil {
 			statTableRow.MeshedPodCount = counts.MeshedPods
+			statTableRow.OutboundOnlyPodCount = counts.OutboundOnlyPods
 			statTableRow.RunningPodCount = counts.RunningPods
 			statTableRow.FailedPodCount = counts.FailedPods
 			statTableRow.Status = counts.Status
@@ -565,6 +573,7 @@ func newMockGrpcServer(exp expectedStatRPC) (*MockProm, *grpcServer, error) {
 	fakeGrpcServer := newGrpcServer(
 		mockProm,
 		nil,
+		nil,
 		k8sAPI,
 		"linkerd",
 		"cluster.local",
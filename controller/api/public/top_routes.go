@@ -21,9 +21,18 @@ const (
 	routeReqQuery             = "sum(increase(route_response_total%s[%s])) by (%s, dst, classification)"
 	actualRouteReqQuery       = "sum(increase(route_actual_response_total%s[%s])) by (%s, dst, classification)"
 	routeLatencyQuantileQuery = "histogram_quantile(%s, sum(irate(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s))"
+	routeRetrySkippedQuery    = "sum(increase(route_retry_skipped_total%s[%s])) by (%s, dst, skipped)"
+	routeResponseStatusQuery  = "sum(increase(route_response_total%s[%s])) by (%s, dst, status_code)"
 	dstLabel                  = `dst=~"(%s)(:\\d+)?"`
 	// DefaultRouteName is the name to display for requests that don't match any routes.
 	DefaultRouteName = "[DEFAULT]"
+
+	// retries are skipped for this reason when the retry budget of the route
+	// is exhausted
+	retrySkippedNoBudget = "no_budget"
+	// the status code of the responses synthesized by the proxy when a
+	// request exceeds the route timeout, which servers may return too
+	gatewayTimeoutStatusCode = "504"
 )
 
 type dstAndRoute struct {
@@ -238,7 +247,10 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 
 	if req.GetOutbound() != nil && req.GetNone() == nil {
 		// If this req has an Outbound, then query the actual request counts as well.
+		// Retries and timeouts are also only handled by the client proxies.
 		queries[promActualRequests] = actualRouteReqQuery
+		queries[promRetriesSkipped] = routeRetrySkippedQuery
+		queries[promResponseStatuses] = routeResponseStatusQuery
 	}
 
 	results, err := s.getPrometheusMetrics(ctx, queries, routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy)
@@ -271,6 +283,9 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 	}
 
 	processRouteMetrics(results, timeWindow, table)
+	if req.GetOutbound() != nil && req.GetNone() == nil {
+		computeRetryCounts(table)
+	}
 
 	return table, nil
 }
@@ -337,6 +352,14 @@ func processRouteMetrics(results []promResult, timeWindow string, table indexedT
 				case failure:
 					table[key].Stats.ActualFailureCount += value
 				}
+			case promRetriesSkipped:
+				if string(sample.Metric[model.LabelName("skipped")]) == retrySkippedNoBudget {
+					table[key].Stats.RetryBudgetExhaustedCount += value
+				}
+			case promResponseStatuses:
+				if string(sample.Metric[model.LabelName("status_code")]) == gatewayTimeoutStatusCode {
+					table[key].Stats.GatewayTimeoutCount += value
+				}
 			case promLatencyP50:
 				table[key].Stats.LatencyMsP50 = value
 			case promLatencyP95:
@@ -347,3 +370,17 @@ func processRouteMetrics(results []promResult, timeWindow string, table indexedT
 		}
 	}
 }
+
+// computeRetryCounts sets the number of retries of each route, i.e. the number
+// of actual requests sent by the client proxies in excess of the effective
+// requests made by the application.
+func computeRetryCounts(table indexedTable) {
+	for _, row := range table {
+		stats := row.Stats
+		actual := stats.ActualSuccessCount + stats.ActualFailureCount
+		effective := stats.SuccessCount + stats.FailureCount
+		if actual > effective {
+			stats.RetryCount = actual - effective
+		}
+	}
+}
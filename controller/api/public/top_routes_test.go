@@ -435,6 +435,8 @@ func TestTopRoutes(t *testing.T) {
 						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
 						`sum(increase(route_response_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
 						`sum(increase(route_actual_response_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
+						`sum(increase(route_retry_skipped_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, skipped)`,
+						`sum(increase(route_response_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, status_code)`,
 					},
 					k8sConfigs: booksConfig,
 				},
@@ -474,6 +476,8 @@ func TestTopRoutes(t *testing.T) {
 						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
 						`sum(increase(route_response_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
 						`sum(increase(route_actual_response_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
+						`sum(increase(route_retry_skipped_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, skipped)`,
+						`sum(increase(route_response_total{deployment="books", direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, status_code)`,
 					},
 					k8sConfigs: booksConfig,
 				},
@@ -500,3 +504,67 @@ func TestTopRoutes(t *testing.T) {
 		testTopRoutes(t, expectations)
 	})
 }
+
+func TestProcessRouteMetrics(t *testing.T) {
+	sample := func(labels model.Metric, value model.SampleValue) *model.Sample {
+		labels["rt_route"] = "/a"
+		labels["dst"] = "books.default.svc.cluster.local:8080"
+		return &model.Sample{Metric: labels, Value: value}
+	}
+
+	results := []promResult{
+		{
+			prom: promRequests,
+			vec: model.Vector{
+				sample(model.Metric{"classification": success}, 100),
+				sample(model.Metric{"classification": failure}, 10),
+			},
+		},
+		{
+			prom: promActualRequests,
+			vec: model.Vector{
+				sample(model.Metric{"classification": success}, 120),
+				sample(model.Metric{"classification": failure}, 20),
+			},
+		},
+		{
+			prom: promRetriesSkipped,
+			vec: model.Vector{
+				sample(model.Metric{"skipped": "no_budget"}, 5),
+			},
+		},
+		{
+			prom: promResponseStatuses,
+			vec: model.Vector{
+				sample(model.Metric{"status_code": "200"}, 100),
+				sample(model.Metric{"status_code": "504"}, 7),
+				sample(model.Metric{"status_code": "500"}, 3),
+			},
+		},
+	}
+
+	key := dstAndRoute{dst: "books.default.svc.cluster.local", route: "/a"}
+	table := indexedTable{
+		key: &pb.RouteTable_Row{
+			Authority: "books",
+			Route:     "/a",
+			Stats:     &pb.BasicStats{},
+		},
+	}
+
+	processRouteMetrics(results, "1m", table)
+	computeRetryCounts(table)
+
+	expected := &pb.BasicStats{
+		SuccessCount:              100,
+		FailureCount:              10,
+		ActualSuccessCount:        120,
+		ActualFailureCount:        20,
+		RetryCount:                30,
+		RetryBudgetExhaustedCount: 5,
+		GatewayTimeoutCount:       7,
+	}
+	if !proto.Equal(table[key].Stats, expected) {
+		t.Fatalf("Expected: %+v\n Got: %+v", expected, table[key].Stats)
+	}
+}
@@ -0,0 +1,394 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/deislabs/smi-sdk-go/pkg/apis/split/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	consts "github.com/linkerd/linkerd2/pkg/k8s"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	eventTypeStepped       = "CanaryStepped"
+	eventTypePromoted      = "CanaryPromoted"
+	eventTypeRolledBack    = "CanaryRolledBack"
+	eventTypeInvalidConfig = "InvalidCanaryConfig"
+
+	defaultStepWeight     = "100m"
+	defaultMinSuccessRate = 0.99
+	defaultMinRequests    = 1
+)
+
+type (
+	// Controller promotes the canary backends of annotated TrafficSplits. A
+	// canary without any weight is first given one step of weight, so that it
+	// receives some traffic. Then at each interval, the success rate and
+	// latency of the canary over the interval are compared to its SLO: the
+	// weight of the canary is stepped up while it is healthy, until it
+	// receives all the traffic, and set back to zero as soon as it isn't.
+	Controller struct {
+		k8sAPI   *k8s.API
+		promAPI  promv1.API
+		recorder record.EventRecorder
+		interval time.Duration
+		log      *logging.Entry
+	}
+
+	// config is the canary configuration of a TrafficSplit, read from its
+	// annotations.
+	config struct {
+		leaf           string
+		stepMilli      int64 // in thousandths of the total weight
+		minSuccessRate float64
+		maxLatencyMs   uint64 // not checked when zero
+		minRequests    uint64
+	}
+
+	verdict int
+)
+
+const (
+	// hold leaves the weights unchanged, when there isn't enough traffic to
+	// judge the canary
+	hold verdict = iota
+	step
+	rollback
+)
+
+// NewController returns a controller analyzing TrafficSplits at the given
+// interval.
+func NewController(
+	k8sAPI *k8s.API,
+	promAPI promv1.API,
+	recorder record.EventRecorder,
+	interval time.Duration,
+) *Controller {
+	return &Controller{
+		k8sAPI:   k8sAPI,
+		promAPI:  promAPI,
+		recorder: recorder,
+		interval: interval,
+		log: logging.WithFields(logging.Fields{
+			"component": "canary-controller",
+		}),
+	}
+}
+
+// Run analyzes the TrafficSplits at every interval, until stopCh is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), c.interval)
+			c.analyzeAll(ctx)
+			cancel()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// analyzeAll analyzes the TrafficSplits that have a canary being promoted.
+func (c *Controller) analyzeAll(ctx context.Context) {
+	splits, err := c.k8sAPI.TS().Lister().List(labels.Everything())
+	if err != nil {
+		c.log.Errorf("Failed to list TrafficSplits: %s", err)
+		return
+	}
+
+	for _, ts := range splits {
+		if _, ok := ts.Annotations[consts.CanaryLeafAnnotation]; !ok {
+			continue
+		}
+		if status := ts.Annotations[consts.CanaryStatusAnnotation]; status != "" {
+			c.log.Debugf("Skipping TrafficSplit %s/%s: canary %s", ts.Namespace, ts.Name, status)
+			continue
+		}
+		if err := c.analyze(ctx, ts); err != nil {
+			c.log.Errorf("Failed to analyze TrafficSplit %s/%s: %s", ts.Namespace, ts.Name, err)
+		}
+	}
+}
+
+// analyze updates the weights of the TrafficSplit according to the stats of
+// its canary over the last interval.
+func (c *Controller) analyze(ctx context.Context, ts *v1alpha1.TrafficSplit) error {
+	cfg, err := parseConfig(ts)
+	if err != nil {
+		c.recorder.Eventf(ts, corev1.EventTypeWarning, eventTypeInvalidConfig, "Invalid canary configuration: %s", err)
+		return err
+	}
+
+	log := c.log.WithFields(logging.Fields{
+		"trafficsplit": fmt.Sprintf("%s/%s", ts.Namespace, ts.Name),
+		"leaf":         cfg.leaf,
+	})
+
+	if leafMilli(ts.Spec.Backends, cfg.leaf) == 0 {
+		return c.start(ts, cfg, log)
+	}
+
+	leafStats, err := public.TrafficSplitLeafStats(ctx, c.promAPI, ts, promDuration(c.interval))
+	if err != nil {
+		return err
+	}
+
+	v, reason := cfg.evaluate(leafStats[cfg.leaf])
+
+	if v == hold {
+		log.Debugf("Holding the canary weight: %s", reason)
+		return nil
+	}
+
+	updated := ts.DeepCopy()
+	switch v {
+	case rollback:
+		updated.Spec.Backends = rollbackWeights(ts.Spec.Backends, cfg.leaf)
+		setStatus(updated, consts.CanaryStatusRolledBack)
+		if err := c.update(updated); err != nil {
+			return err
+		}
+		log.Infof("Rolled back the canary: %s", reason)
+		c.recorder.Eventf(ts, corev1.EventTypeWarning, eventTypeRolledBack, "Rolled back canary %s: %s", cfg.leaf, reason)
+
+	case step:
+		backends, promoted := stepWeights(ts.Spec.Backends, cfg.leaf, cfg.stepMilli)
+		updated.Spec.Backends = backends
+		if promoted {
+			setStatus(updated, consts.CanaryStatusPromoted)
+		}
+		if err := c.update(updated); err != nil {
+			return err
+		}
+		if promoted {
+			log.Info("Promoted the canary")
+			c.recorder.Eventf(ts, corev1.EventTypeNormal, eventTypePromoted, "Promoted canary %s: %s", cfg.leaf, reason)
+		} else {
+			weight := leafWeight(backends, cfg.leaf)
+			log.Infof("Stepped the canary weight up to %s", weight)
+			c.recorder.Eventf(ts, corev1.EventTypeNormal, eventTypeStepped, "Stepped the weight of canary %s up to %s: %s", cfg.leaf, weight, reason)
+		}
+	}
+
+	return nil
+}
+
+// start gives its first step of weight to a canary that has none, without
+// evaluating it: it doesn't receive any traffic to be judged on yet. The canary
+// isn't promoted even when the step gives it all the weight, as it's evaluated
+// at the next interval like at any other step.
+func (c *Controller) start(ts *v1alpha1.TrafficSplit, cfg *config, log *logging.Entry) error {
+	updated := ts.DeepCopy()
+	updated.Spec.Backends, _ = stepWeights(ts.Spec.Backends, cfg.leaf, cfg.stepMilli)
+	if err := c.update(updated); err != nil {
+		return err
+	}
+
+	weight := leafWeight(updated.Spec.Backends, cfg.leaf)
+	log.Infof("Started the canary at weight %s", weight)
+	c.recorder.Eventf(ts, corev1.EventTypeNormal, eventTypeStepped, "Stepped the weight of canary %s up to %s: starting the canary", cfg.leaf, weight)
+	return nil
+}
+
+func (c *Controller) update(ts *v1alpha1.TrafficSplit) error {
+	_, err := c.k8sAPI.TsClient.SplitV1alpha1().TrafficSplits(ts.Namespace).Update(ts)
+	return err
+}
+
+// parseConfig reads the canary configuration of the TrafficSplit, falling back
+// to the defaults for the annotations that aren't set.
+func parseConfig(ts *v1alpha1.TrafficSplit) (*config, error) {
+	annotations := ts.Annotations
+	cfg := &config{
+		leaf:           annotations[consts.CanaryLeafAnnotation],
+		minSuccessRate: defaultMinSuccessRate,
+		minRequests:    defaultMinRequests,
+	}
+
+	found := false
+	for _, backend := range ts.Spec.Backends {
+		if backend.Service == cfg.leaf {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%s is not a backend of the TrafficSplit", cfg.leaf)
+	}
+
+	stepWeight := defaultStepWeight
+	if v, ok := annotations[consts.CanaryStepWeightAnnotation]; ok {
+		stepWeight = v
+	}
+	q, err := resource.ParseQuantity(stepWeight)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %s", consts.CanaryStepWeightAnnotation, err)
+	}
+	cfg.stepMilli = q.MilliValue()
+	if cfg.stepMilli <= 0 || cfg.stepMilli > 1000 {
+		return nil, fmt.Errorf("invalid %s annotation: %s is not in ]0, 1]", consts.CanaryStepWeightAnnotation, stepWeight)
+	}
+
+	if v, ok := annotations[consts.CanaryMinSuccessRateAnnotation]; ok {
+		cfg.minSuccessRate, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %s", consts.CanaryMinSuccessRateAnnotation, err)
+		}
+	}
+
+	if v, ok := annotations[consts.CanaryMaxLatencyAnnotation]; ok {
+		latency, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %s", consts.CanaryMaxLatencyAnnotation, err)
+		}
+		cfg.maxLatencyMs = uint64(latency / time.Millisecond)
+	}
+
+	if v, ok := annotations[consts.CanaryMinRequestsAnnotation]; ok {
+		cfg.minRequests, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %s", consts.CanaryMinRequestsAnnotation, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// evaluate compares the stats of the canary to its SLO, and returns what
+// should be done with its weight, along with the reason.
+func (cfg *config) evaluate(stats *pb.BasicStats) (verdict, string) {
+	success := stats.GetSuccessCount()
+	requests := success + stats.GetFailureCount()
+	if requests == 0 || requests < cfg.minRequests {
+		return hold, fmt.Sprintf("%d requests received, %d required", requests, cfg.minRequests)
+	}
+
+	successRate := float64(success) / float64(requests)
+	if successRate < cfg.minSuccessRate {
+		return rollback, fmt.Sprintf("success rate %.2f%% is below %.2f%%", successRate*100, cfg.minSuccessRate*100)
+	}
+	if cfg.maxLatencyMs > 0 && stats.GetLatencyMsP99() > cfg.maxLatencyMs {
+		return rollback, fmt.Sprintf("p99 latency %dms is above %dms", stats.GetLatencyMsP99(), cfg.maxLatencyMs)
+	}
+
+	return step, fmt.Sprintf("success rate %.2f%%, p99 latency %dms", successRate*100, stats.GetLatencyMsP99())
+}
+
+// stepWeights moves the given thousandths of the total weight of the backends
+// from the other backends to the leaf, keeping the ratios between the other
+// backends. It returns true when the leaf ends up with all the weight.
+func stepWeights(backends []v1alpha1.TrafficSplitBackend, leaf string, stepMilli int64) ([]v1alpha1.TrafficSplitBackend, bool) {
+	total := int64(0)
+	leafMilli := int64(0)
+	for _, backend := range backends {
+		total += backend.Weight.MilliValue()
+		if backend.Service == leaf {
+			leafMilli = backend.Weight.MilliValue()
+		}
+	}
+
+	leafMilli += total * stepMilli / 1000
+	if leafMilli >= total {
+		return setWeights(backends, leaf, total, 0), true
+	}
+	return setWeights(backends, leaf, leafMilli, total-leafMilli), false
+}
+
+// rollbackWeights moves all the weight of the leaf to the other backends,
+// keeping the ratios between them.
+func rollbackWeights(backends []v1alpha1.TrafficSplitBackend, leaf string) []v1alpha1.TrafficSplitBackend {
+	total := int64(0)
+	for _, backend := range backends {
+		total += backend.Weight.MilliValue()
+	}
+	return setWeights(backends, leaf, 0, total)
+}
+
+// setWeights returns a copy of the backends where the leaf has the given
+// weight and the weight of the others adds up to rest, split in proportion to
+// their current weights, or evenly when they have none. Weights are expressed
+// in thousandths.
+func setWeights(backends []v1alpha1.TrafficSplitBackend, leaf string, leafMilli, rest int64) []v1alpha1.TrafficSplitBackend {
+	others := int64(0)
+	count := int64(0)
+	for _, backend := range backends {
+		if backend.Service != leaf {
+			others += backend.Weight.MilliValue()
+			count++
+		}
+	}
+
+	updated := make([]v1alpha1.TrafficSplitBackend, len(backends))
+	remaining := rest
+	last := -1
+	for i, backend := range backends {
+		updated[i] = *backend.DeepCopy()
+		if backend.Service == leaf {
+			updated[i].Weight = *resource.NewMilliQuantity(leafMilli, resource.DecimalSI)
+			continue
+		}
+
+		var weight int64
+		if others > 0 {
+			weight = rest * backend.Weight.MilliValue() / others
+		} else {
+			weight = rest / count
+		}
+		updated[i].Weight = *resource.NewMilliQuantity(weight, resource.DecimalSI)
+		remaining -= weight
+		last = i
+	}
+
+	// give the rounding leftovers to the last of the other backends, so that
+	// the total weight is unchanged
+	if last >= 0 && remaining != 0 {
+		weight := updated[last].Weight.MilliValue() + remaining
+		updated[last].Weight = *resource.NewMilliQuantity(weight, resource.DecimalSI)
+	}
+
+	return updated
+}
+
+func leafMilli(backends []v1alpha1.TrafficSplitBackend, leaf string) int64 {
+	for _, backend := range backends {
+		if backend.Service == leaf {
+			return backend.Weight.MilliValue()
+		}
+	}
+	return 0
+}
+
+func leafWeight(backends []v1alpha1.TrafficSplitBackend, leaf string) string {
+	for _, backend := range backends {
+		if backend.Service == leaf {
+			return backend.Weight.String()
+		}
+	}
+	return ""
+}
+
+func setStatus(ts *v1alpha1.TrafficSplit, status string) {
+	if ts.Annotations == nil {
+		ts.Annotations = map[string]string{}
+	}
+	ts.Annotations[consts.CanaryStatusAnnotation] = status
+}
+
+// promDuration formats a duration as a Prometheus range, e.g. 60s.
+func promDuration(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d/time.Second))
+}
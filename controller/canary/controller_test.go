@@ -0,0 +1,324 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/api/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	consts "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/client_golang/api"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeProm answers the latency queries with the latencies, and the other
+// queries with the requests.
+type fakeProm struct {
+	*public.MockProm
+	requests  model.Vector
+	latencies model.Vector
+}
+
+func (fp *fakeProm) Query(ctx context.Context, query string, ts time.Time) (model.Value, api.Warnings, error) {
+	// records the query
+	_, _, _ = fp.MockProm.Query(ctx, query, ts)
+	if strings.HasPrefix(query, "histogram_quantile") {
+		return fp.latencies, nil, nil
+	}
+	return fp.requests, nil, nil
+}
+
+func requestSample(leaf, classification string, value float64) *model.Sample {
+	return &model.Sample{
+		Metric: model.Metric{
+			"dst_service":    model.LabelValue(leaf),
+			"classification": model.LabelValue(classification),
+			"tls":            "true",
+		},
+		Value: model.SampleValue(value),
+	}
+}
+
+func latencySample(leaf string, ms float64) *model.Sample {
+	return &model.Sample{
+		Metric: model.Metric{
+			"dst_service": model.LabelValue(leaf),
+		},
+		Value: model.SampleValue(ms),
+	}
+}
+
+func trafficSplit(annotations map[string]string, booksWeight, canaryWeight string) string {
+	keys := make([]string, 0)
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	meta := ""
+	if len(keys) > 0 {
+		meta = "\n  annotations:"
+		for _, key := range keys {
+			meta += fmt.Sprintf("\n    %s: %q", key, annotations[key])
+		}
+	}
+
+	return fmt.Sprintf(`
+apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: books-split
+  namespace: default%s
+spec:
+  service: books
+  backends:
+  - service: books-v1
+    weight: %s
+  - service: books-v2
+    weight: %s`, meta, booksWeight, canaryWeight)
+}
+
+func TestAnalyze(t *testing.T) {
+	canaryAnnotations := map[string]string{
+		consts.CanaryLeafAnnotation:        "books-v2",
+		consts.CanaryMaxLatencyAnnotation:  "500ms",
+		consts.CanaryMinRequestsAnnotation: "10",
+	}
+	healthyRequests := model.Vector{
+		requestSample("books-v1", "success", 900),
+		requestSample("books-v2", "success", 99),
+		requestSample("books-v2", "failure", 1),
+	}
+	healthyLatencies := model.Vector{
+		latencySample("books-v1", 100),
+		latencySample("books-v2", 200),
+	}
+
+	for _, tt := range []struct {
+		name            string
+		annotations     map[string]string
+		weights         [2]string
+		requests        model.Vector
+		latencies       model.Vector
+		expectedWeights [2]string
+		expectedStatus  string
+		expectedEvents  []string
+	}{
+		{
+			name:            "steps the weight of a healthy canary up",
+			annotations:     canaryAnnotations,
+			weights:         [2]string{"900m", "100m"},
+			requests:        healthyRequests,
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"800m", "200m"},
+			expectedEvents: []string{
+				"Normal CanaryStepped Stepped the weight of canary books-v2 up to 200m: success rate 99.00%, p99 latency 200ms",
+			},
+		},
+		{
+			name: "steps the weight up by the configured step",
+			annotations: map[string]string{
+				consts.CanaryLeafAnnotation:       "books-v2",
+				consts.CanaryStepWeightAnnotation: "0.25",
+			},
+			weights:         [2]string{"3", "1"},
+			requests:        healthyRequests,
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"2", "2"},
+			expectedEvents: []string{
+				"Normal CanaryStepped Stepped the weight of canary books-v2 up to 2: success rate 99.00%, p99 latency 200ms",
+			},
+		},
+		{
+			name:            "promotes a healthy canary on the last step",
+			annotations:     canaryAnnotations,
+			weights:         [2]string{"50m", "950m"},
+			requests:        healthyRequests,
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"0", "1"},
+			expectedStatus:  consts.CanaryStatusPromoted,
+			expectedEvents: []string{
+				"Normal CanaryPromoted Promoted canary books-v2: success rate 99.00%, p99 latency 200ms",
+			},
+		},
+		{
+			name:        "rolls back a canary whose success rate is too low",
+			annotations: canaryAnnotations,
+			weights:     [2]string{"800m", "200m"},
+			requests: model.Vector{
+				requestSample("books-v1", "success", 900),
+				requestSample("books-v2", "success", 90),
+				requestSample("books-v2", "failure", 10),
+			},
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"1", "0"},
+			expectedStatus:  consts.CanaryStatusRolledBack,
+			expectedEvents: []string{
+				"Warning CanaryRolledBack Rolled back canary books-v2: success rate 90.00% is below 99.00%",
+			},
+		},
+		{
+			name:        "rolls back a canary whose latency is too high",
+			annotations: canaryAnnotations,
+			weights:     [2]string{"800m", "200m"},
+			requests:    healthyRequests,
+			latencies: model.Vector{
+				latencySample("books-v1", 100),
+				latencySample("books-v2", 750),
+			},
+			expectedWeights: [2]string{"1", "0"},
+			expectedStatus:  consts.CanaryStatusRolledBack,
+			expectedEvents: []string{
+				"Warning CanaryRolledBack Rolled back canary books-v2: p99 latency 750ms is above 500ms",
+			},
+		},
+		{
+			name:            "starts a canary without any weight before evaluating it",
+			annotations:     canaryAnnotations,
+			weights:         [2]string{"1", "0"},
+			requests:        model.Vector{},
+			latencies:       model.Vector{},
+			expectedWeights: [2]string{"900m", "100m"},
+			expectedEvents: []string{
+				"Normal CanaryStepped Stepped the weight of canary books-v2 up to 100m: starting the canary",
+			},
+		},
+		{
+			name: "doesn't promote a canary on its first step",
+			annotations: map[string]string{
+				consts.CanaryLeafAnnotation:       "books-v2",
+				consts.CanaryStepWeightAnnotation: "1",
+			},
+			weights:         [2]string{"1", "0"},
+			requests:        model.Vector{},
+			latencies:       model.Vector{},
+			expectedWeights: [2]string{"0", "1"},
+			expectedEvents: []string{
+				"Normal CanaryStepped Stepped the weight of canary books-v2 up to 1: starting the canary",
+			},
+		},
+		{
+			name:        "holds the weight of a canary without enough requests",
+			annotations: canaryAnnotations,
+			weights:     [2]string{"900m", "100m"},
+			requests: model.Vector{
+				requestSample("books-v1", "success", 900),
+				requestSample("books-v2", "success", 9),
+			},
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"900m", "100m"},
+			expectedEvents:  []string{},
+		},
+		{
+			name:            "ignores TrafficSplits without a canary",
+			annotations:     map[string]string{},
+			weights:         [2]string{"900m", "100m"},
+			requests:        healthyRequests,
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"900m", "100m"},
+			expectedEvents:  []string{},
+		},
+		{
+			name: "ignores TrafficSplits whose canary was rolled back",
+			annotations: map[string]string{
+				consts.CanaryLeafAnnotation:   "books-v2",
+				consts.CanaryStatusAnnotation: consts.CanaryStatusRolledBack,
+			},
+			weights:         [2]string{"1", "0"},
+			requests:        healthyRequests,
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"1", "0"},
+			expectedStatus:  consts.CanaryStatusRolledBack,
+			expectedEvents:  []string{},
+		},
+		{
+			name: "reports canaries that aren't backends",
+			annotations: map[string]string{
+				consts.CanaryLeafAnnotation: "books-v3",
+			},
+			weights:         [2]string{"900m", "100m"},
+			requests:        healthyRequests,
+			latencies:       healthyLatencies,
+			expectedWeights: [2]string{"900m", "100m"},
+			expectedEvents: []string{
+				"Warning InvalidCanaryConfig Invalid canary configuration: books-v3 is not a backend of the TrafficSplit",
+			},
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			k8sAPI, err := k8s.NewFakeAPI(trafficSplit(tt.annotations, tt.weights[0], tt.weights[1]))
+			if err != nil {
+				t.Fatalf("NewFakeAPI returned an error: %s", err)
+			}
+			k8sAPI.Sync()
+
+			prom := &fakeProm{
+				MockProm:  &public.MockProm{},
+				requests:  tt.requests,
+				latencies: tt.latencies,
+			}
+			recorder := record.NewFakeRecorder(10)
+			controller := NewController(k8sAPI, prom, recorder, time.Minute)
+
+			controller.analyzeAll(context.Background())
+
+			ts, err := k8sAPI.TsClient.SplitV1alpha1().TrafficSplits("default").Get("books-split", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			weights := [2]string{}
+			for i, backend := range ts.Spec.Backends {
+				weights[i] = backend.Weight.String()
+			}
+			if weights != tt.expectedWeights {
+				t.Fatalf("Expected weights %v, got %v", tt.expectedWeights, weights)
+			}
+			if status := ts.Annotations[consts.CanaryStatusAnnotation]; status != tt.expectedStatus {
+				t.Fatalf("Expected status %q, got %q", tt.expectedStatus, status)
+			}
+
+			events := []string{}
+			close(recorder.Events)
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, tt.expectedEvents) {
+				t.Fatalf("Expected events %v, got %v", tt.expectedEvents, events)
+			}
+		})
+	}
+}
+
+func TestAnalyzeQueries(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(trafficSplit(map[string]string{consts.CanaryLeafAnnotation: "books-v2"}, "900m", "100m"))
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Sync()
+
+	prom := &fakeProm{MockProm: &public.MockProm{}}
+	controller := NewController(k8sAPI, prom, record.NewFakeRecorder(10), 30*time.Second)
+
+	controller.analyzeAll(context.Background())
+
+	// these are the queries StatSummary uses for TrafficSplits
+	expected := []string{
+		`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{authority=~"^books.default.svc.+", direction="outbound"}[30s])) by (le, dst_service))`,
+		`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{authority=~"^books.default.svc.+", direction="outbound"}[30s])) by (le, dst_service))`,
+		`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{authority=~"^books.default.svc.+", direction="outbound"}[30s])) by (le, dst_service))`,
+		`sum(increase(response_total{authority=~"^books.default.svc.+", direction="outbound"}[30s])) by (dst_service, classification, tls)`,
+	}
+	queries := prom.QueriesExecuted
+	sort.Strings(queries)
+	if !reflect.DeepEqual(queries, expected) {
+		t.Fatalf("Expected queries %v, got %v", expected, queries)
+	}
+}
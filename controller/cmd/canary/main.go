@@ -0,0 +1,74 @@
+package canary
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/canary"
+	tsscheme "github.com/linkerd/linkerd2/controller/gen/client/split/clientset/versioned/scheme"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/linkerd/linkerd2/pkg/admin"
+	"github.com/linkerd/linkerd2/pkg/flags"
+	promApi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const componentName = "linkerd-canary"
+
+// Main executes the canary subcommand
+func Main(args []string) {
+	cmd := flag.NewFlagSet("canary", flag.ExitOnError)
+
+	kubeConfigPath := cmd.String("kubeconfig", "", "path to kube config")
+	metricsAddr := cmd.String("metrics-addr", ":9993", "address to serve scrapable metrics on")
+	prometheusURL := cmd.String("prometheus-url", "http://127.0.0.1:9090", "prometheus url")
+	interval := cmd.Duration("interval", time.Minute, "interval between two analyses of the canaries, also used as the window of their metrics")
+
+	flags.ConfigureAndParse(cmd, args)
+
+	if *interval < time.Second {
+		log.Fatalf("The interval must be at least 1s, got %s", *interval)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	k8sAPI, err := k8s.InitializeAPI(*kubeConfigPath, k8s.TS)
+	if err != nil {
+		log.Fatalf("Failed to initialize K8s API: %s", err)
+	}
+
+	prometheusClient, err := promApi.NewClient(promApi.Config{Address: *prometheusURL})
+	if err != nil {
+		log.Fatalf("Failed to initialize Prometheus client: %s", err)
+	}
+
+	// the events are recorded on the TrafficSplits, whose types are only
+	// known to the SMI scheme
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: k8sAPI.Client.CoreV1().Events(""),
+	})
+	recorder := eventBroadcaster.NewRecorder(tsscheme.Scheme, v1.EventSource{Component: componentName})
+
+	controller := canary.NewController(k8sAPI, promv1.NewAPI(prometheusClient), recorder, *interval)
+
+	done := make(chan struct{})
+	k8sAPI.Sync()
+	go controller.Run(done)
+	log.Info("Started canary controller")
+
+	go admin.StartServer(*metricsAddr)
+
+	<-stop
+
+	log.Info("Stopping canary controller")
+	close(done)
+}
@@ -27,6 +27,7 @@ func Main(args []string) {
 	enableH2Upgrade := cmd.Bool("enable-h2-upgrade", true, "Enable transparently upgraded HTTP2 connections among pods in the service mesh")
 	disableIdentity := cmd.Bool("disable-identity", false, "Disable identity configuration")
 	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
+	enableEndpointSlices := cmd.Bool("enable-endpoint-slices", false, "Enable the usage of EndpointSlice informers and resources")
 
 	traceCollector := flags.AddTraceFlags(cmd)
 
@@ -35,10 +36,14 @@ func Main(args []string) {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
-	k8sAPI, err := k8s.InitializeAPI(
-		*kubeConfigPath,
-		k8s.Endpoint, k8s.Pod, k8s.RS, k8s.Svc, k8s.SP, k8s.TS, k8s.Job,
-	)
+	resources := []k8s.APIResource{k8s.Pod, k8s.RS, k8s.Svc, k8s.SP, k8s.TS, k8s.Authz, k8s.Job, k8s.Node}
+	if *enableEndpointSlices {
+		resources = append(resources, k8s.ES)
+	} else {
+		resources = append(resources, k8s.Endpoint)
+	}
+
+	k8sAPI, err := k8s.InitializeAPI(*kubeConfigPath, resources...)
 	if err != nil {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
@@ -80,6 +85,7 @@ func Main(args []string) {
 		*controllerNamespace,
 		trustDomain,
 		*enableH2Upgrade,
+		*enableEndpointSlices,
 		k8sAPI,
 		clusterDomain,
 		done,
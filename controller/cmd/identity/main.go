@@ -4,10 +4,12 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 
 	v1 "k8s.io/api/core/v1"
@@ -33,7 +35,6 @@ import (
 
 // TODO watch trustAnchorsPath for changes
 // TODO watch issuerPath for changes
-// TODO restrict servicetoken audiences (and lifetimes)
 
 // Main executes the identity subcommand
 func Main(args []string) {
@@ -45,6 +46,26 @@ func Main(args []string) {
 	issuerPath := cmd.String("issuer",
 		"/var/run/linkerd/identity/issuer",
 		"path to directory containing issuer credentials")
+	signerBackend := cmd.String("issuer-signer-backend", identity.SignerBackendFile,
+		fmt.Sprintf("backend holding the private key of the issuer (%s)", strings.Join(identity.SignerBackends, "|")))
+	signerAddr := cmd.String("issuer-signer-addr", "localhost:8086",
+		"address of the remote signing service, when the issuer signer backend is \"remote\"")
+	auditLog := cmd.String("audit-log", "",
+		"path of the file the audit records of the certification requests are appended to, as JSON lines (\"-\" for stdout, disabled when empty)")
+	auditRecords := cmd.Int("audit-records", identity.DefaultAuditRecords,
+		"number of recent audit records kept in memory to be served to the public API")
+	auditAddr := cmd.String("audit-addr", ":8083",
+		"address to serve the audit records on, apart from the certification requests")
+	auditClients := cmd.String("audit-clients", "linkerd-controller",
+		"comma separated list of the service accounts of the control plane components allowed to read the audit records, authenticated by their token")
+	renewalAnchorPath := cmd.String("issuer-renewal-anchor", "",
+		"path to directory containing the trust anchor credentials the issuer is renewed with before it expires (renewal disabled when empty)")
+	renewalThreshold := cmd.Duration("issuer-renewal-threshold", idctl.DefaultIssuerRenewalThreshold,
+		"remaining validity of the issuer certificate under which it gets renewed")
+	renewalLifetime := cmd.Duration("issuer-renewal-lifetime", idctl.DefaultIssuerRenewalLifetime,
+		"lifetime of the renewed issuer certificates")
+	renewalInterval := cmd.Duration("issuer-renewal-interval", idctl.DefaultIssuerRenewalInterval,
+		"interval between two checks of the issuer certificate expiry")
 
 	var issuerPathCrt string
 	var issuerPathKey string
@@ -78,6 +99,34 @@ func Main(args []string) {
 		issuerPathKey = filepath.Join(*issuerPath, corev1.TLSPrivateKeyKey)
 	}
 
+	var signer identity.SignerBackend
+	switch *signerBackend {
+	case identity.SignerBackendFile:
+		signer = identity.NewFileSignerBackend(issuerPathKey)
+	case identity.SignerBackendRemote:
+		signer, err = identity.NewRemoteSignerBackend(*signerAddr)
+		if err != nil {
+			log.Fatalf("Failed to connect to the remote signer at %s: %s", *signerAddr, err)
+		}
+	default:
+		log.Fatalf("Invalid issuer signer backend %q, must be one of: %s", *signerBackend, strings.Join(identity.SignerBackends, ", "))
+	}
+
+	var auditSink io.Writer
+	switch *auditLog {
+	case "":
+	case "-":
+		auditSink = os.Stdout
+	default:
+		f, err := os.OpenFile(*auditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Fatalf("Failed to open the audit log %s: %s", *auditLog, err)
+		}
+		defer f.Close()
+		auditSink = f
+	}
+	auditor := identity.NewAuditor(auditSink, *auditRecords)
+
 	trustDomain := idctx.GetTrustDomain()
 	dom, err := idctl.NewTrustDomain(controllerNS, trustDomain)
 	if err != nil {
@@ -110,6 +159,18 @@ func Main(args []string) {
 		}
 	}
 
+	maxLifetime := validity.Lifetime
+	if pbd := idctx.GetMaxIssuanceLifetime(); pbd != nil {
+		mil, err := ptypes.Duration(pbd)
+		if err != nil {
+			log.Warnf("Invalid max issuance lifetime: %s", err)
+		} else if mil < validity.Lifetime {
+			log.Warnf("Max issuance lifetime %s is shorter than the issuance lifetime %s, ignoring it", mil, validity.Lifetime)
+		} else {
+			maxLifetime = mil
+		}
+	}
+
 	expectedName := fmt.Sprintf("identity.%s.%s", controllerNS, trustDomain)
 	issuerEvent := make(chan struct{})
 	issuerError := make(chan error)
@@ -131,7 +192,7 @@ func Main(args []string) {
 	if err != nil {
 		log.Fatalf("Failed to load kubeconfig: %s: %s", *kubeConfigPath, err)
 	}
-	v, err := idctl.NewK8sTokenValidator(k8sAPI, dom)
+	v, err := idctl.NewK8sTokenValidator(k8sAPI, dom, idctx.GetTokenAudience())
 	if err != nil {
 		log.Fatalf("Failed to initialize identity service: %s", err)
 	}
@@ -152,10 +213,51 @@ func Main(args []string) {
 		recorder.Event(deployment, eventType, reason, message)
 	}
 
+	//
+	// Create and run issuer renewer
+	//
+	if *renewalAnchorPath != "" {
+		if idctx.Scheme != k8s.IdentityIssuerSchemeLinkerd || *signerBackend != identity.SignerBackendFile {
+			log.Fatalf("Issuer renewal is only supported with the %s issuer scheme and the %s signer backend", k8s.IdentityIssuerSchemeLinkerd, identity.SignerBackendFile)
+		}
+		if *renewalInterval <= 0 {
+			log.Fatalf("Invalid issuer renewal interval: %s", *renewalInterval)
+		}
+		anchor, err := tls.ReadPEMCreds(
+			filepath.Join(*renewalAnchorPath, corev1.TLSPrivateKeyKey),
+			filepath.Join(*renewalAnchorPath, corev1.TLSCertKey),
+		)
+		if err != nil {
+			log.Fatalf("Failed to read the issuer renewal anchor: %s", err)
+		}
+		renewer, err := idctl.NewIssuerRenewer(k8sAPI, controllerNS, anchor, trustAnchors, expectedName, *renewalLifetime, *renewalThreshold, recordEventFunc)
+		if err != nil {
+			log.Fatalf("Failed to initialize issuer renewer: %s", err)
+		}
+		go renewer.Run(ctx, *renewalInterval)
+	}
+
+	//
+	// Create and sync the deny list
+	//
+	denyList := &identity.DenyList{}
+	denyListWatcher := idctl.NewDenyListWatcher(k8sAPI, controllerNS, denyList, recordEventFunc)
+	if !denyListWatcher.Run(ctx.Done()) {
+		log.Fatal("Failed to sync the identity deny list")
+	}
+
 	//
 	// Create, initialize and run service
 	//
-	svc := identity.NewService(v, trustAnchors, &validity, recordEventFunc, expectedName, issuerPathCrt, issuerPathKey)
+	spiffeTrustDomain := ""
+	if idctx.GetSpiffeIdentities() {
+		spiffeTrustDomain = trustDomain
+	}
+	lifetimePolicy := idctl.NewK8sLifetimePolicy(k8sAPI, maxLifetime)
+	if !lifetimePolicy.Run(ctx.Done()) {
+		log.Fatal("Failed to sync the issuance lifetime policy")
+	}
+	svc := identity.NewService(v, trustAnchors, &validity, recordEventFunc, expectedName, issuerPathCrt, signer, auditor, spiffeTrustDomain, lifetimePolicy, denyList)
 	if err = svc.Initialize(); err != nil {
 		log.Fatalf("Failed to initialize identity service: %s", err)
 	}
@@ -183,7 +285,26 @@ func Main(args []string) {
 		log.Infof("starting gRPC server on %s", *addr)
 		srv.Serve(lis)
 	}()
+
+	// the audit records are only served to the allowed control plane
+	// components, on their own listener
+	auditLis, err := net.Listen("tcp", *auditAddr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %s", *auditAddr, err)
+	}
+	auditClientNames := []string{}
+	for _, sa := range strings.Split(*auditClients, ",") {
+		auditClientNames = append(auditClientNames, identity.ComponentName(sa, controllerNS, trustDomain))
+	}
+	auditSrv := prometheus.NewGrpcServer()
+	identity.RegisterAudit(auditSrv, auditor, v, auditClientNames...)
+	go func() {
+		log.Infof("starting audit gRPC server on %s", *auditAddr)
+		auditSrv.Serve(auditLis)
+	}()
+
 	<-stop
 	log.Infof("shutting down gRPC server on %s", *addr)
 	srv.GracefulStop()
+	auditSrv.GracefulStop()
 }
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/linkerd/linkerd2/controller/cmd/canary"
 	"github.com/linkerd/linkerd2/controller/cmd/destination"
 	"github.com/linkerd/linkerd2/controller/cmd/heartbeat"
 	"github.com/linkerd/linkerd2/controller/cmd/identity"
 	proxyinjector "github.com/linkerd/linkerd2/controller/cmd/proxy-injector"
 	publicapi "github.com/linkerd/linkerd2/controller/cmd/public-api"
+	servicemirror "github.com/linkerd/linkerd2/controller/cmd/service-mirror"
+	"github.com/linkerd/linkerd2/controller/cmd/signer"
 	spvalidator "github.com/linkerd/linkerd2/controller/cmd/sp-validator"
 	"github.com/linkerd/linkerd2/controller/cmd/tap"
 )
@@ -20,6 +23,8 @@ func main() {
 	}
 
 	switch os.Args[1] {
+	case "canary":
+		canary.Main(os.Args[2:])
 	case "destination":
 		destination.Main(os.Args[2:])
 	case "heartbeat":
@@ -30,6 +35,10 @@ func main() {
 		proxyinjector.Main(os.Args[2:])
 	case "public-api":
 		publicapi.Main(os.Args[2:])
+	case "service-mirror":
+		servicemirror.Main(os.Args[2:])
+	case "signer":
+		signer.Main(os.Args[2:])
 	case "sp-validator":
 		spvalidator.Main(os.Args[2:])
 	case "tap":
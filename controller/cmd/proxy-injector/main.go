@@ -4,14 +4,16 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	injector "github.com/linkerd/linkerd2/controller/proxy-injector"
 	"github.com/linkerd/linkerd2/controller/webhook"
+	"github.com/linkerd/linkerd2/pkg/inject"
 )
 
 // Main executes the proxy-injector subcommand
 func Main(args []string) {
 	webhook.Launch(
-		[]k8s.APIResource{k8s.NS, k8s.Deploy, k8s.RC, k8s.RS, k8s.Job, k8s.DS, k8s.SS, k8s.Pod, k8s.CJ},
+		[]k8s.APIResource{k8s.NS, k8s.Deploy, k8s.RC, k8s.RS, k8s.Job, k8s.DS, k8s.SS, k8s.Pod, k8s.CJ, k8s.PC},
 		9995,
 		injector.Inject,
+		map[string]webhook.AuthenticatedHandlerFunc{inject.DryRunPath: injector.DryRun},
 		"linkerd-proxy-injector",
 		"proxy-injector",
 		args,
@@ -3,6 +3,8 @@ package publicapi
 import (
 	"context"
 	"flag"
+	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -10,14 +12,18 @@ import (
 
 	"github.com/linkerd/linkerd2/controller/api/destination"
 	"github.com/linkerd/linkerd2/controller/api/public"
+	auditPb "github.com/linkerd/linkerd2/controller/gen/controller/identity"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/admin"
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/flags"
+	"github.com/linkerd/linkerd2/pkg/identity"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/trace"
 	promApi "github.com/prometheus/client_golang/api"
 	log "github.com/sirupsen/logrus"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
 )
 
 // Main executes the public-api subcommand
@@ -29,10 +35,16 @@ func Main(args []string) {
 	prometheusURL := cmd.String("prometheus-url", "http://127.0.0.1:9090", "prometheus url")
 	metricsAddr := cmd.String("metrics-addr", ":9995", "address to serve scrapable metrics on")
 	destinationAPIAddr := cmd.String("destination-addr", "127.0.0.1:8086", "address of destination service")
+	identityAddr := cmd.String("identity-addr", "", "address of the identity service (defaults to the linkerd-identity service of the controller namespace)")
+	identityAuditAddr := cmd.String("identity-audit-addr", "", "address of the audit records of the identity service (defaults to the linkerd-identity service of the controller namespace)")
 	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
 	ignoredNamespaces := cmd.String("ignore-namespaces", "kube-system", "comma separated list of namespaces to not list pods from")
+	mtlsAddr := cmd.String("mtls-addr", "", "address to serve on with mTLS, for the other control plane components; requires -identity-service-account")
+	mtlsClients := cmd.String("mtls-clients", "linkerd-web", "comma separated list of the service accounts of the control plane components allowed to call the mTLS server")
+	prometheusServiceAccount := cmd.String("prometheus-service-account", "", "service account of prometheus; when set along with -identity-service-account, prometheus is called with mTLS")
 
 	traceCollector := flags.AddTraceFlags(cmd)
+	serviceAccount, tokenPath := flags.AddComponentIdentityFlags(cmd)
 
 	flags.ConfigureAndParse(cmd, args)
 
@@ -53,11 +65,6 @@ func Main(args []string) {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
 
-	prometheusClient, err := promApi.NewClient(promApi.Config{Address: *prometheusURL})
-	if err != nil {
-		log.Fatal(err.Error())
-	}
-
 	globalConfig, err := config.Global(pkgK8s.MountPathGlobalConfig)
 	if err != nil {
 		log.Fatal(err)
@@ -68,6 +75,53 @@ func Main(args []string) {
 	}
 	log.Info("Using cluster domain: ", clusterDomain)
 
+	if *identityAddr == "" {
+		*identityAddr = fmt.Sprintf("linkerd-identity.%s.svc.%s:8080", *controllerNamespace, clusterDomain)
+	}
+	if *identityAuditAddr == "" {
+		*identityAuditAddr = fmt.Sprintf("linkerd-identity.%s.svc.%s:8083", *controllerNamespace, clusterDomain)
+	}
+	// the audit records are only served to the callers authenticated by their
+	// service account token
+	auditConn, err := grpc.Dial(*identityAuditAddr,
+		grpc.WithInsecure(),
+		grpc.WithPerRPCCredentials(identity.NewAuditTokenCredentials(*tokenPath)),
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+	)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	defer auditConn.Close()
+
+	var creds *identity.ComponentCredentials
+	if *serviceAccount != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		creds, err = identity.DialComponentCredentials(ctx, *identityAddr, *serviceAccount, *tokenPath, *controllerNamespace, globalConfig.GetIdentityContext())
+		if err != nil {
+			log.Fatalf("Failed to obtain the identity of the component: %s", err)
+		}
+	} else if *mtlsAddr != "" {
+		log.Fatal("-mtls-addr requires -identity-service-account")
+	}
+
+	prometheusConfig := promApi.Config{Address: *prometheusURL}
+	if creds != nil && *prometheusServiceAccount != "" {
+		// prometheus doesn't handle TLS, so the server name is its identity for
+		// its proxy to terminate TLS. Neither prometheus nor its proxy
+		// authorize their clients though, so this only authenticates
+		// prometheus and encrypts the queries: other clients, meshed or not,
+		// can still query it.
+		prometheusIdentity := identity.ComponentName(*prometheusServiceAccount, *controllerNamespace, globalConfig.GetIdentityContext().GetTrustDomain())
+		tlsConfig := creds.ClientTLSConfig(prometheusIdentity)
+		tlsConfig.ServerName = prometheusIdentity
+		prometheusConfig.RoundTripper = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	prometheusClient, err := promApi.NewClient(prometheusConfig)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
 	if *traceCollector != "" {
 		if err := trace.InitializeTracing("linkerd-public-api", *traceCollector); err != nil {
 			log.Warnf("failed to initialize tracing: %s", err)
@@ -78,6 +132,7 @@ func Main(args []string) {
 		*addr,
 		prometheusClient,
 		destinationClient,
+		auditPb.NewAuditClient(auditConn),
 		k8sAPI,
 		*controllerNamespace,
 		clusterDomain,
@@ -91,10 +146,30 @@ func Main(args []string) {
 		server.ListenAndServe()
 	}()
 
+	var mtlsServer *http.Server
+	if *mtlsAddr != "" {
+		clients := []string{}
+		for _, sa := range strings.Split(*mtlsClients, ",") {
+			clients = append(clients, identity.ComponentName(sa, *controllerNamespace, globalConfig.GetIdentityContext().GetTrustDomain()))
+		}
+		mtlsServer = &http.Server{
+			Addr:      *mtlsAddr,
+			Handler:   server.Handler,
+			TLSConfig: creds.ServerTLSConfig(clients...),
+		}
+		go func() {
+			log.Infof("starting mTLS server on %+v", *mtlsAddr)
+			mtlsServer.ListenAndServeTLS("", "")
+		}()
+	}
+
 	go admin.StartServer(*metricsAddr)
 
 	<-stop
 
 	log.Infof("shutting down HTTP server on %+v", *addr)
 	server.Shutdown(context.Background())
+	if mtlsServer != nil {
+		mtlsServer.Shutdown(context.Background())
+	}
 }
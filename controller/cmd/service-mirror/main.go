@@ -0,0 +1,59 @@
+package servicemirror
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	servicemirror "github.com/linkerd/linkerd2/controller/service-mirror"
+	"github.com/linkerd/linkerd2/pkg/admin"
+	"github.com/linkerd/linkerd2/pkg/flags"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/informers"
+)
+
+// Main executes the service-mirror subcommand
+func Main(args []string) {
+	cmd := flag.NewFlagSet("service-mirror", flag.ExitOnError)
+
+	kubeConfigPath := cmd.String("kubeconfig", "", "path to the local kube config")
+	metricsAddr := cmd.String("metrics-addr", ":9999", "address to serve scrapable metrics on")
+	namespace := cmd.String("namespace", "linkerd", "namespace containing the remote cluster credentials")
+	requeueLimit := cmd.Int("event-requeue-limit", 3, "number of times an event is retried before giving up")
+
+	flags.ConfigureAndParse(cmd, args)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	k8sAPI, err := k8s.InitializeAPI(*kubeConfigPath)
+	if err != nil {
+		log.Fatalf("Failed to initialize K8s API: %s", err)
+	}
+
+	// only watch the Secrets in the given namespace, to avoid requiring
+	// cluster-wide access to Secrets
+	secretInformers := informers.NewSharedInformerFactoryWithOptions(
+		k8sAPI.Client,
+		10*time.Minute,
+		informers.WithNamespace(*namespace),
+	)
+	secrets := secretInformers.Core().V1().Secrets()
+
+	watcher := servicemirror.NewRemoteClusterConfigWatcher(k8sAPI, secrets, *requeueLimit)
+	log.Info("Started cluster config watcher")
+
+	done := make(chan struct{})
+	secretInformers.Start(done)
+
+	go admin.StartServer(*metricsAddr)
+
+	<-stop
+
+	log.Info("Stopping cluster config watcher")
+	close(done)
+	watcher.Stop()
+}
@@ -0,0 +1,58 @@
+package signer
+
+import (
+	"flag"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/linkerd/linkerd2/pkg/admin"
+	"github.com/linkerd/linkerd2/pkg/flags"
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/prometheus"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	log "github.com/sirupsen/logrus"
+)
+
+// Main executes the signer subcommand. It serves the Signer gRPC API with a
+// key read from disk, as a local stand-in for the signing services of HSMs
+// and KMSs.
+func Main(args []string) {
+	cmd := flag.NewFlagSet("signer", flag.ExitOnError)
+
+	addr := cmd.String("addr", "localhost:8086", "address to serve on")
+	adminAddr := cmd.String("admin-addr", ":9994", "address of HTTP admin server")
+	keyPath := cmd.String("key", "/var/run/linkerd/identity/issuer/key.pem", "path to the PEM-encoded private key of the issuer")
+
+	flags.ConfigureAndParse(cmd, args)
+
+	keyb, err := ioutil.ReadFile(*keyPath)
+	if err != nil {
+		log.Fatalf("Failed to read the private key: %s", err)
+	}
+	key, err := tls.DecodePEMKey(string(keyb))
+	if err != nil {
+		log.Fatalf("Failed to decode the private key: %s", err)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	go admin.StartServer(*adminAddr)
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %s", *addr, err)
+	}
+
+	srv := prometheus.NewGrpcServer()
+	identity.RegisterSigner(srv, identity.NewSignerServer(key))
+	go func() {
+		log.Infof("starting gRPC server on %s", *addr)
+		srv.Serve(lis)
+	}()
+	<-stop
+	log.Infof("shutting down gRPC server on %s", *addr)
+	srv.GracefulStop()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -13,6 +14,7 @@ import (
 	"github.com/linkerd/linkerd2/pkg/admin"
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/flags"
+	"github.com/linkerd/linkerd2/pkg/identity"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/trace"
 	log "github.com/sirupsen/logrus"
@@ -32,8 +34,10 @@ func Main(args []string) {
 	tlsCertPath := cmd.String("tls-cert", pkgK8s.MountPathTLSCrtPEM, "path to TLS Cert PEM")
 	tlsKeyPath := cmd.String("tls-key", pkgK8s.MountPathTLSKeyPEM, "path to TLS Key PEM")
 	disableCommonNames := cmd.Bool("disable-common-names", false, "disable checks for Common Names (for development)")
+	identityAddr := cmd.String("identity-addr", "", "address of the identity service (defaults to the linkerd-identity service of the controller namespace)")
 
 	traceCollector := flags.AddTraceFlags(cmd)
+	serviceAccount, identityTokenPath := flags.AddComponentIdentityFlags(cmd)
 
 	flags.ConfigureAndParse(cmd, args)
 
@@ -68,12 +72,29 @@ func Main(args []string) {
 	}
 	log.Infof("Using trust domain: %s", trustDomain)
 
+	var creds *identity.ComponentCredentials
+	if *serviceAccount != "" {
+		if *identityAddr == "" {
+			clusterDomain := globalConfig.GetClusterDomain()
+			if clusterDomain == "" {
+				clusterDomain = defaultDomain
+			}
+			*identityAddr = fmt.Sprintf("linkerd-identity.%s.svc.%s:8080", *controllerNamespace, clusterDomain)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		creds, err = identity.DialComponentCredentials(ctx, *identityAddr, *serviceAccount, *identityTokenPath, *controllerNamespace, globalConfig.GetIdentityContext())
+		if err != nil {
+			log.Fatalf("Failed to obtain the identity of the component: %s", err)
+		}
+	}
+
 	if *traceCollector != "" {
 		if err := trace.InitializeTracing("linkerd-tap", *traceCollector); err != nil {
 			log.Warnf("failed to initialize tracing: %s", err)
 		}
 	}
-	grpcTapServer := tap.NewGrpcTapServer(*tapPort, *controllerNamespace, trustDomain, k8sAPI)
+	grpcTapServer := tap.NewGrpcTapServer(*tapPort, *controllerNamespace, trustDomain, k8sAPI, creds)
 
 	// TODO: make this configurable for local development
 	cert, err := tls.LoadX509KeyPair(*tlsCertPath, *tlsKeyPath)
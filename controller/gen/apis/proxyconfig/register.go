@@ -0,0 +1,4 @@
+package proxyconfig
+
+// GroupName identifies the API Group Name for a ProxyConfig.
+const GroupName = "config.linkerd.io"
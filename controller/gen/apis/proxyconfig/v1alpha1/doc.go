@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=config.linkerd.io
+
+package v1alpha1
@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProxyConfig describes the proxy settings of a set of pods, overriding the
+// global proxy configuration
+type ProxyConfig struct {
+	// TypeMeta is the metadata for the resource, like kind and apiversion
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta contains the metadata for the particular object, including
+	// things like...
+	//  - name
+	//  - namespace
+	//  - self link
+	//  - labels
+	//  - ... etc ...
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the custom resource spec
+	Spec ProxyConfigSpec `json:"spec"`
+}
+
+// ProxyConfigSpec specifies a ProxyConfig resource. It holds the same
+// settings as the global proxy configuration; the unset ones are left to the
+// global configuration.
+//
+// The settings apply to the pods injected after the ProxyConfig is created.
+// They're overridden by the config.linkerd.io annotations of the pod and of
+// its namespace. When several ProxyConfigs select a pod, each setting is taken
+// from the first ProxyConfig defining it, in the alphabetical order of their
+// names.
+type ProxyConfigSpec struct {
+	// PodSelector selects the pods of the ProxyConfig's namespace that the
+	// settings apply to. An empty selector selects all the pods of the
+	// namespace.
+	PodSelector *metav1.LabelSelector `json:"podSelector"`
+
+	// ProxyImage is the image of the proxy container. Its pull policy also
+	// applies to the proxy-init container.
+	ProxyImage *Image `json:"proxyImage,omitempty"`
+	// ProxyInitImage is the image of the proxy-init container. Its pull policy
+	// is ignored.
+	ProxyInitImage *Image `json:"proxyInitImage,omitempty"`
+	// DebugImage is the image of the debug container.
+	DebugImage *Image `json:"debugImage,omitempty"`
+
+	ControlPort  uint32 `json:"controlPort,omitempty"`
+	InboundPort  uint32 `json:"inboundPort,omitempty"`
+	AdminPort    uint32 `json:"adminPort,omitempty"`
+	OutboundPort uint32 `json:"outboundPort,omitempty"`
+
+	// IgnoreInboundPorts and IgnoreOutboundPorts are the ports and port
+	// ranges, like "4190-4191", that bypass the proxy.
+	IgnoreInboundPorts  []string `json:"ignoreInboundPorts,omitempty"`
+	IgnoreOutboundPorts []string `json:"ignoreOutboundPorts,omitempty"`
+
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	ProxyUID int64  `json:"proxyUID,omitempty"`
+	LogLevel string `json:"logLevel,omitempty"`
+
+	EnableExternalProfiles *bool `json:"enableExternalProfiles,omitempty"`
+}
+
+// Image describes a container image.
+type Image struct {
+	Name       string `json:"name,omitempty"`
+	Version    string `json:"version,omitempty"`
+	PullPolicy string `json:"pullPolicy,omitempty"`
+}
+
+// ResourceRequirements describes the CPU and memory requests and limits of the
+// proxy container.
+type ResourceRequirements struct {
+	RequestCPU    string `json:"requestCPU,omitempty"`
+	RequestMemory string `json:"requestMemory,omitempty"`
+	LimitCPU      string `json:"limitCPU,omitempty"`
+	LimitMemory   string `json:"limitMemory,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProxyConfigList is a list of ProxyConfig resources.
+type ProxyConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ProxyConfig `json:"items"`
+}
@@ -0,0 +1,4 @@
+package serverauthorization
+
+// GroupName identifies the API Group Name for a ServerAuthorization.
+const GroupName = "policy.linkerd.io"
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServerAuthorization describes which clients are authorized to connect to a
+// port of a set of pods
+type ServerAuthorization struct {
+	// TypeMeta is the metadata for the resource, like kind and apiversion
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta contains the metadata for the particular object, including
+	// things like...
+	//  - name
+	//  - namespace
+	//  - self link
+	//  - labels
+	//  - ... etc ...
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the custom resource spec
+	Spec ServerAuthorizationSpec `json:"spec"`
+}
+
+// ServerAuthorizationSpec specifies a ServerAuthorization resource.
+//
+// Once a port is selected by at least one ServerAuthorization, only the
+// clients matched by the Allow rules of those ServerAuthorizations may connect
+// to it. Deny rules take precedence over Allow rules.
+type ServerAuthorizationSpec struct {
+	// PodSelector selects the pods of the ServerAuthorization's namespace
+	// that the authorization applies to. An empty selector selects all the
+	// pods of the namespace.
+	PodSelector *metav1.LabelSelector `json:"podSelector"`
+	// Port is the number or the name of the container port the authorization
+	// applies to.
+	Port  intstr.IntOrString `json:"port"`
+	Allow []*Client          `json:"allow,omitempty"`
+	Deny  []*Client          `json:"deny,omitempty"`
+}
+
+// Client describes a set of clients. A client belongs to the set when it
+// matches any of the fields.
+type Client struct {
+	// Unauthenticated matches the clients that don't have a meshed identity.
+	Unauthenticated bool `json:"unauthenticated,omitempty"`
+	// ServiceAccounts matches the clients whose identity is the one of the
+	// given service accounts.
+	ServiceAccounts []*ServiceAccountRef `json:"serviceAccounts,omitempty"`
+	// Namespaces matches the clients whose identity is the one of any service
+	// account of the given namespaces.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// ServiceAccountRef references a service account. When the namespace is
+// omitted, it defaults to the namespace of the ServerAuthorization.
+type ServiceAccountRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServerAuthorizationList is a list of ServerAuthorization resources.
+type ServerAuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ServerAuthorization `json:"items"`
+}
@@ -49,6 +49,14 @@ type RequestMatch struct {
 	Any       []*RequestMatch `json:"any,omitempty"`
 	PathRegex string          `json:"pathRegex,omitempty"`
 	Method    string          `json:"method,omitempty"`
+	Authority *AuthorityMatch `json:"authority,omitempty"`
+}
+
+// AuthorityMatch describes the conditions under which to match the authority
+// of a request (e.g. "web.default.svc.cluster.local:8080").
+type AuthorityMatch struct {
+	Exact string `json:"exact,omitempty"`
+	Regex string `json:"regex,omitempty"`
 }
 
 // ResponseClass describes how to classify a response (e.g. success or
@@ -24,6 +24,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorityMatch) DeepCopyInto(out *AuthorityMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorityMatch.
+func (in *AuthorityMatch) DeepCopy() *AuthorityMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorityMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Range) DeepCopyInto(out *Range) {
 	*out = *in
@@ -70,6 +86,11 @@ func (in *RequestMatch) DeepCopyInto(out *RequestMatch) {
 			}
 		}
 	}
+	if in.Authority != nil {
+		in, out := &in.Authority, &out.Authority
+		*out = new(AuthorityMatch)
+		**out = **in
+	}
 	return
 }
 
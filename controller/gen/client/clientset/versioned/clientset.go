@@ -19,6 +19,8 @@ limitations under the License.
 package versioned
 
 import (
+	configv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/proxyconfig/v1alpha1"
+	policyv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serverauthorization/v1alpha1"
 	linkerdv1alpha2 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serviceprofile/v1alpha2"
 	"k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
@@ -27,16 +29,35 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	ConfigV1alpha1() configv1alpha1.ConfigV1alpha1Interface
+	// Deprecated: please explicitly pick a version if possible.
+	Config() configv1alpha1.ConfigV1alpha1Interface
 	LinkerdV1alpha2() linkerdv1alpha2.LinkerdV1alpha2Interface
 	// Deprecated: please explicitly pick a version if possible.
 	Linkerd() linkerdv1alpha2.LinkerdV1alpha2Interface
+	PolicyV1alpha1() policyv1alpha1.PolicyV1alpha1Interface
+	// Deprecated: please explicitly pick a version if possible.
+	Policy() policyv1alpha1.PolicyV1alpha1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
 // version included in a Clientset.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	configV1alpha1  *configv1alpha1.ConfigV1alpha1Client
 	linkerdV1alpha2 *linkerdv1alpha2.LinkerdV1alpha2Client
+	policyV1alpha1  *policyv1alpha1.PolicyV1alpha1Client
+}
+
+// ConfigV1alpha1 retrieves the ConfigV1alpha1Client
+func (c *Clientset) ConfigV1alpha1() configv1alpha1.ConfigV1alpha1Interface {
+	return c.configV1alpha1
+}
+
+// Deprecated: Config retrieves the default version of ConfigClient.
+// Please explicitly pick a version.
+func (c *Clientset) Config() configv1alpha1.ConfigV1alpha1Interface {
+	return c.configV1alpha1
 }
 
 // LinkerdV1alpha2 retrieves the LinkerdV1alpha2Client
@@ -50,6 +71,17 @@ func (c *Clientset) Linkerd() linkerdv1alpha2.LinkerdV1alpha2Interface {
 	return c.linkerdV1alpha2
 }
 
+// PolicyV1alpha1 retrieves the PolicyV1alpha1Client
+func (c *Clientset) PolicyV1alpha1() policyv1alpha1.PolicyV1alpha1Interface {
+	return c.policyV1alpha1
+}
+
+// Deprecated: Policy retrieves the default version of PolicyClient.
+// Please explicitly pick a version.
+func (c *Clientset) Policy() policyv1alpha1.PolicyV1alpha1Interface {
+	return c.policyV1alpha1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -66,10 +98,18 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	}
 	var cs Clientset
 	var err error
+	cs.configV1alpha1, err = configv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.linkerdV1alpha2, err = linkerdv1alpha2.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
+	cs.policyV1alpha1, err = policyv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -82,7 +122,9 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 // panics if there is an error in the config.
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
+	cs.configV1alpha1 = configv1alpha1.NewForConfigOrDie(c)
 	cs.linkerdV1alpha2 = linkerdv1alpha2.NewForConfigOrDie(c)
+	cs.policyV1alpha1 = policyv1alpha1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -91,7 +133,9 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.configV1alpha1 = configv1alpha1.New(c)
 	cs.linkerdV1alpha2 = linkerdv1alpha2.New(c)
+	cs.policyV1alpha1 = policyv1alpha1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -20,6 +20,10 @@ package fake
 
 import (
 	clientset "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
+	configv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/proxyconfig/v1alpha1"
+	fakeconfigv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/proxyconfig/v1alpha1/fake"
+	policyv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serverauthorization/v1alpha1"
+	fakepolicyv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serverauthorization/v1alpha1/fake"
 	linkerdv1alpha2 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serviceprofile/v1alpha2"
 	fakelinkerdv1alpha2 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serviceprofile/v1alpha2/fake"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -71,6 +75,16 @@ func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 
 var _ clientset.Interface = &Clientset{}
 
+// ConfigV1alpha1 retrieves the ConfigV1alpha1Client
+func (c *Clientset) ConfigV1alpha1() configv1alpha1.ConfigV1alpha1Interface {
+	return &fakeconfigv1alpha1.FakeConfigV1alpha1{Fake: &c.Fake}
+}
+
+// Config retrieves the ConfigV1alpha1Client
+func (c *Clientset) Config() configv1alpha1.ConfigV1alpha1Interface {
+	return &fakeconfigv1alpha1.FakeConfigV1alpha1{Fake: &c.Fake}
+}
+
 // LinkerdV1alpha2 retrieves the LinkerdV1alpha2Client
 func (c *Clientset) LinkerdV1alpha2() linkerdv1alpha2.LinkerdV1alpha2Interface {
 	return &fakelinkerdv1alpha2.FakeLinkerdV1alpha2{Fake: &c.Fake}
@@ -80,3 +94,13 @@ func (c *Clientset) LinkerdV1alpha2() linkerdv1alpha2.LinkerdV1alpha2Interface {
 func (c *Clientset) Linkerd() linkerdv1alpha2.LinkerdV1alpha2Interface {
 	return &fakelinkerdv1alpha2.FakeLinkerdV1alpha2{Fake: &c.Fake}
 }
+
+// PolicyV1alpha1 retrieves the PolicyV1alpha1Client
+func (c *Clientset) PolicyV1alpha1() policyv1alpha1.PolicyV1alpha1Interface {
+	return &fakepolicyv1alpha1.FakePolicyV1alpha1{Fake: &c.Fake}
+}
+
+// Policy retrieves the PolicyV1alpha1Client
+func (c *Clientset) Policy() policyv1alpha1.PolicyV1alpha1Interface {
+	return &fakepolicyv1alpha1.FakePolicyV1alpha1{Fake: &c.Fake}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
+	scheme "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ServerAuthorizationsGetter has a method to return a ServerAuthorizationInterface.
+// A group's client should implement this interface.
+type ServerAuthorizationsGetter interface {
+	ServerAuthorizations(namespace string) ServerAuthorizationInterface
+}
+
+// ServerAuthorizationInterface has methods to work with ServerAuthorization resources.
+type ServerAuthorizationInterface interface {
+	Create(*v1alpha1.ServerAuthorization) (*v1alpha1.ServerAuthorization, error)
+	Update(*v1alpha1.ServerAuthorization) (*v1alpha1.ServerAuthorization, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ServerAuthorization, error)
+	List(opts v1.ListOptions) (*v1alpha1.ServerAuthorizationList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ServerAuthorization, err error)
+	ServerAuthorizationExpansion
+}
+
+// serverAuthorizations implements ServerAuthorizationInterface
+type serverAuthorizations struct {
+	client rest.Interface
+	ns     string
+}
+
+// newServerAuthorizations returns a ServerAuthorizations
+func newServerAuthorizations(c *PolicyV1alpha1Client, namespace string) *serverAuthorizations {
+	return &serverAuthorizations{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the serverAuthorization, and returns the corresponding serverAuthorization object, and an error if there is any.
+func (c *serverAuthorizations) Get(name string, options v1.GetOptions) (result *v1alpha1.ServerAuthorization, err error) {
+	result = &v1alpha1.ServerAuthorization{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ServerAuthorizations that match those selectors.
+func (c *serverAuthorizations) List(opts v1.ListOptions) (result *v1alpha1.ServerAuthorizationList, err error) {
+	result = &v1alpha1.ServerAuthorizationList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested serverAuthorizations.
+func (c *serverAuthorizations) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Watch()
+}
+
+// Create takes the representation of a serverAuthorization and creates it.  Returns the server's representation of the serverAuthorization, and an error, if there is any.
+func (c *serverAuthorizations) Create(serverAuthorization *v1alpha1.ServerAuthorization) (result *v1alpha1.ServerAuthorization, err error) {
+	result = &v1alpha1.ServerAuthorization{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		Body(serverAuthorization).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a serverAuthorization and updates it. Returns the server's representation of the serverAuthorization, and an error, if there is any.
+func (c *serverAuthorizations) Update(serverAuthorization *v1alpha1.ServerAuthorization) (result *v1alpha1.ServerAuthorization, err error) {
+	result = &v1alpha1.ServerAuthorization{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		Name(serverAuthorization.Name).
+		Body(serverAuthorization).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the serverAuthorization and deletes it. Returns an error if one occurs.
+func (c *serverAuthorizations) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *serverAuthorizations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched serverAuthorization.
+func (c *serverAuthorizations) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ServerAuthorization, err error) {
+	result = &v1alpha1.ServerAuthorization{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("serverauthorizations").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
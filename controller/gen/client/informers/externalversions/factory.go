@@ -25,6 +25,8 @@ import (
 
 	versioned "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	internalinterfaces "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/internalinterfaces"
+	proxyconfig "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/proxyconfig"
+	serverauthorization "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serverauthorization"
 	serviceprofile "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serviceprofile"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -172,9 +174,19 @@ type SharedInformerFactory interface {
 	ForResource(resource schema.GroupVersionResource) (GenericInformer, error)
 	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
 
+	Config() proxyconfig.Interface
 	Linkerd() serviceprofile.Interface
+	Policy() serverauthorization.Interface
+}
+
+func (f *sharedInformerFactory) Config() proxyconfig.Interface {
+	return proxyconfig.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Linkerd() serviceprofile.Interface {
 	return serviceprofile.New(f, f.namespace, f.tweakListOptions)
 }
+
+func (f *sharedInformerFactory) Policy() serverauthorization.Interface {
+	return serverauthorization.New(f, f.namespace, f.tweakListOptions)
+}
@@ -21,6 +21,8 @@ package externalversions
 import (
 	"fmt"
 
+	proxyconfigv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/proxyconfig/v1alpha1"
+	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
 	v1alpha2 "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
@@ -52,10 +54,18 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=linkerd.io, Version=v1alpha2
+	// Group=config.linkerd.io, Version=v1alpha1
+	case proxyconfigv1alpha1.SchemeGroupVersion.WithResource("proxyconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Config().V1alpha1().ProxyConfigs().Informer()}, nil
+
+		// Group=linkerd.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithResource("serviceprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Linkerd().V1alpha2().ServiceProfiles().Informer()}, nil
 
+		// Group=policy.linkerd.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("serverauthorizations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().ServerAuthorizations().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -184,9 +184,12 @@ type Proxy struct {
 	ProxyInitImageVersion   string                `protobuf:"bytes,14,opt,name=proxy_init_image_version,json=proxyInitImageVersion,proto3" json:"proxy_init_image_version,omitempty"`
 	DebugImage              *Image                `protobuf:"bytes,15,opt,name=debug_image,json=debugImage,proto3" json:"debug_image,omitempty"`
 	DebugImageVersion       string                `protobuf:"bytes,16,opt,name=debug_image_version,json=debugImageVersion,proto3" json:"debug_image_version,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}              `json:"-"`
-	XXX_unrecognized        []byte                `json:"-"`
-	XXX_sizecache           int32                 `json:"-"`
+	// Rules selecting the workloads the proxy-injector injects or leaves
+	// alone, beyond the linkerd.io/inject annotation.
+	InjectionPolicy      *InjectionPolicy `protobuf:"bytes,17,opt,name=injection_policy,json=injectionPolicy,proto3" json:"injection_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Proxy) Reset()         { *m = Proxy{} }
@@ -326,6 +329,13 @@ func (m *Proxy) GetDebugImageVersion() string {
 	return ""
 }
 
+func (m *Proxy) GetInjectionPolicy() *InjectionPolicy {
+	if m != nil {
+		return m.InjectionPolicy
+	}
+	return nil
+}
+
 type Image struct {
 	ImageName            string   `protobuf:"bytes,1,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
 	PullPolicy           string   `protobuf:"bytes,2,opt,name=pull_policy,json=pullPolicy,proto3" json:"pull_policy,omitempty"`
@@ -547,11 +557,20 @@ func (m *AutoInjectContext) XXX_DiscardUnknown() {
 var xxx_messageInfo_AutoInjectContext proto.InternalMessageInfo
 
 type IdentityContext struct {
-	TrustDomain          string             `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
-	TrustAnchorsPem      string             `protobuf:"bytes,2,opt,name=trust_anchors_pem,json=trustAnchorsPem,proto3" json:"trust_anchors_pem,omitempty"`
-	IssuanceLifetime     *duration.Duration `protobuf:"bytes,3,opt,name=issuance_lifetime,json=issuanceLifetime,proto3" json:"issuance_lifetime,omitempty"`
-	ClockSkewAllowance   *duration.Duration `protobuf:"bytes,4,opt,name=clock_skew_allowance,json=clockSkewAllowance,proto3" json:"clock_skew_allowance,omitempty"`
-	Scheme               string             `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	TrustDomain        string             `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
+	TrustAnchorsPem    string             `protobuf:"bytes,2,opt,name=trust_anchors_pem,json=trustAnchorsPem,proto3" json:"trust_anchors_pem,omitempty"`
+	IssuanceLifetime   *duration.Duration `protobuf:"bytes,3,opt,name=issuance_lifetime,json=issuanceLifetime,proto3" json:"issuance_lifetime,omitempty"`
+	ClockSkewAllowance *duration.Duration `protobuf:"bytes,4,opt,name=clock_skew_allowance,json=clockSkewAllowance,proto3" json:"clock_skew_allowance,omitempty"`
+	Scheme             string             `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// The audience of the projected service account tokens the proxies
+	// authenticate with. The legacy service account tokens are used when empty.
+	TokenAudience string `protobuf:"bytes,6,opt,name=token_audience,json=tokenAudience,proto3" json:"token_audience,omitempty"`
+	// If true, the certificates of the proxies also hold their SPIFFE ID, as
+	// a URI SAN.
+	SpiffeIdentities bool `protobuf:"varint,7,opt,name=spiffe_identities,json=spiffeIdentities,proto3" json:"spiffe_identities,omitempty"`
+	// The upper bound of the issuance lifetimes set by the annotations of the
+	// service accounts and namespaces. Defaults to the issuance lifetime.
+	MaxIssuanceLifetime  *duration.Duration `protobuf:"bytes,8,opt,name=max_issuance_lifetime,json=maxIssuanceLifetime,proto3" json:"max_issuance_lifetime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -617,6 +636,27 @@ func (m *IdentityContext) GetScheme() string {
 	return ""
 }
 
+func (m *IdentityContext) GetTokenAudience() string {
+	if m != nil {
+		return m.TokenAudience
+	}
+	return ""
+}
+
+func (m *IdentityContext) GetSpiffeIdentities() bool {
+	if m != nil {
+		return m.SpiffeIdentities
+	}
+	return false
+}
+
+func (m *IdentityContext) GetMaxIssuanceLifetime() *duration.Duration {
+	if m != nil {
+		return m.MaxIssuanceLifetime
+	}
+	return nil
+}
+
 type LogLevel struct {
 	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -756,6 +796,129 @@ func (m *Install_Flag) GetValue() string {
 	return ""
 }
 
+// The cluster-level injection policy of the proxy-injector.
+type InjectionPolicy struct {
+	// The workloads matching any of these rules are injected without the
+	// linkerd.io/inject annotation, unless it disables the injection.
+	Include []*InjectionRule `protobuf:"bytes,1,rep,name=include,proto3" json:"include,omitempty"`
+	// The workloads matching any of these rules are never injected, whatever
+	// their annotations or the include rules.
+	Exclude              []*InjectionRule `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *InjectionPolicy) Reset()         { *m = InjectionPolicy{} }
+func (m *InjectionPolicy) String() string { return proto.CompactTextString(m) }
+func (*InjectionPolicy) ProtoMessage()    {}
+func (*InjectionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc332a44e926b360, []int{11}
+}
+
+func (m *InjectionPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InjectionPolicy.Unmarshal(m, b)
+}
+func (m *InjectionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InjectionPolicy.Marshal(b, m, deterministic)
+}
+func (m *InjectionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectionPolicy.Merge(m, src)
+}
+func (m *InjectionPolicy) XXX_Size() int {
+	return xxx_messageInfo_InjectionPolicy.Size(m)
+}
+func (m *InjectionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectionPolicy proto.InternalMessageInfo
+
+func (m *InjectionPolicy) GetInclude() []*InjectionRule {
+	if m != nil {
+		return m.Include
+	}
+	return nil
+}
+
+func (m *InjectionPolicy) GetExclude() []*InjectionRule {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+// A rule matches the workloads matching all its criteria. The criteria left
+// empty match all the workloads.
+type InjectionRule struct {
+	// Label selector of the workload's namespace, in the kubectl syntax.
+	NamespaceSelector string `protobuf:"bytes,1,opt,name=namespace_selector,json=namespaceSelector,proto3" json:"namespace_selector,omitempty"`
+	// Kinds of the workload, like deployment or cronjob. Pods are matched by
+	// the kind of the workload owning them.
+	Kinds []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// Glob patterns of the name of the workload, or of the workload owning the
+	// pod.
+	OwnerNames []string `protobuf:"bytes,3,rep,name=owner_names,json=ownerNames,proto3" json:"owner_names,omitempty"`
+	// Glob patterns of the images, matched by any container of the pod.
+	Images               []string `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InjectionRule) Reset()         { *m = InjectionRule{} }
+func (m *InjectionRule) String() string { return proto.CompactTextString(m) }
+func (*InjectionRule) ProtoMessage()    {}
+func (*InjectionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc332a44e926b360, []int{12}
+}
+
+func (m *InjectionRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InjectionRule.Unmarshal(m, b)
+}
+func (m *InjectionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InjectionRule.Marshal(b, m, deterministic)
+}
+func (m *InjectionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectionRule.Merge(m, src)
+}
+func (m *InjectionRule) XXX_Size() int {
+	return xxx_messageInfo_InjectionRule.Size(m)
+}
+func (m *InjectionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectionRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectionRule proto.InternalMessageInfo
+
+func (m *InjectionRule) GetNamespaceSelector() string {
+	if m != nil {
+		return m.NamespaceSelector
+	}
+	return ""
+}
+
+func (m *InjectionRule) GetKinds() []string {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *InjectionRule) GetOwnerNames() []string {
+	if m != nil {
+		return m.OwnerNames
+	}
+	return nil
+}
+
+func (m *InjectionRule) GetImages() []string {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*All)(nil), "linkerd2.config.All")
 	proto.RegisterType((*Global)(nil), "linkerd2.config.Global")
@@ -769,77 +932,90 @@ func init() {
 	proto.RegisterType((*LogLevel)(nil), "linkerd2.config.LogLevel")
 	proto.RegisterType((*Install)(nil), "linkerd2.config.Install")
 	proto.RegisterType((*Install_Flag)(nil), "linkerd2.config.Install.Flag")
+	proto.RegisterType((*InjectionPolicy)(nil), "linkerd2.config.InjectionPolicy")
+	proto.RegisterType((*InjectionRule)(nil), "linkerd2.config.InjectionRule")
 }
 
 func init() { proto.RegisterFile("config/config.proto", fileDescriptor_cc332a44e926b360) }
 
 var fileDescriptor_cc332a44e926b360 = []byte{
-	// 1064 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xdf, 0x6f, 0xdb, 0x36,
-	0x10, 0x86, 0x1d, 0x3b, 0xb6, 0xcf, 0x76, 0x63, 0x33, 0x69, 0xa3, 0x64, 0xe8, 0x96, 0x69, 0x28,
-	0x10, 0x74, 0x83, 0xbd, 0x25, 0x43, 0x5b, 0xe4, 0x69, 0x69, 0x9b, 0x16, 0x59, 0xb3, 0x2e, 0x50,
-	0xb1, 0x0e, 0xd8, 0x8b, 0x20, 0x4b, 0x67, 0x85, 0x0b, 0x45, 0xba, 0x12, 0x95, 0xa4, 0x7f, 0xc4,
-	0x9e, 0xb7, 0xa7, 0x01, 0xfb, 0x1f, 0xf7, 0x07, 0x0c, 0x3c, 0x52, 0xce, 0x0f, 0x37, 0xd9, 0x93,
-	0xc5, 0xef, 0xbe, 0xef, 0xbb, 0x93, 0x78, 0x3c, 0x1a, 0x56, 0x63, 0x25, 0xa7, 0x3c, 0x1d, 0xdb,
-	0x9f, 0xd1, 0x2c, 0x57, 0x5a, 0xb1, 0x15, 0xc1, 0xe5, 0x29, 0xe6, 0xc9, 0xce, 0xc8, 0xc2, 0x9b,
-	0x9f, 0xa7, 0x4a, 0xa5, 0x02, 0xc7, 0x14, 0x9e, 0x94, 0xd3, 0x71, 0x52, 0xe6, 0x91, 0xe6, 0x4a,
-	0x5a, 0x81, 0xff, 0x57, 0x0d, 0x96, 0xf6, 0x85, 0x60, 0x63, 0x58, 0x4e, 0x85, 0x9a, 0x44, 0xc2,
-	0xab, 0x6d, 0xd5, 0xb6, 0xbb, 0x3b, 0xeb, 0xa3, 0x1b, 0x4e, 0xa3, 0xd7, 0x14, 0x0e, 0x1c, 0x8d,
-	0x7d, 0x03, 0xcd, 0x59, 0xae, 0x2e, 0x3e, 0x7a, 0x75, 0xe2, 0x3f, 0x58, 0xe0, 0x1f, 0x9b, 0x68,
-	0x60, 0x49, 0x6c, 0x07, 0x5a, 0x5c, 0x16, 0x3a, 0x12, 0xc2, 0x5b, 0x22, 0xbe, 0xb7, 0xc0, 0x3f,
-	0xb4, 0xf1, 0xa0, 0x22, 0xfa, 0xff, 0xd6, 0x61, 0xd9, 0x26, 0x65, 0x5f, 0xc3, 0xd0, 0xd1, 0x43,
-	0x19, 0x65, 0x58, 0xcc, 0xa2, 0x18, 0xa9, 0xd0, 0x4e, 0x30, 0x70, 0x81, 0xb7, 0x15, 0xce, 0xbe,
-	0x80, 0x6e, 0x2c, 0x79, 0x88, 0x32, 0x9a, 0x08, 0x4c, 0xa8, 0xbe, 0x76, 0x00, 0xb1, 0xe4, 0x07,
-	0x16, 0x61, 0x1e, 0xb4, 0xce, 0x30, 0x2f, 0xb8, 0x92, 0x54, 0x4c, 0x27, 0xa8, 0x96, 0xec, 0x0d,
-	0x0c, 0x78, 0x82, 0x52, 0x73, 0xfd, 0x31, 0x8c, 0x95, 0xd4, 0x78, 0xa1, 0xbd, 0x06, 0xd5, 0xbb,
-	0xb5, 0x58, 0xaf, 0x23, 0xbe, 0xb0, 0xbc, 0x60, 0x85, 0x5f, 0x07, 0xd8, 0x7b, 0x58, 0x8d, 0x4a,
-	0xad, 0x42, 0x2e, 0x7f, 0xc7, 0x58, 0xcf, 0xfd, 0x96, 0xc9, 0xcf, 0x5f, 0xf0, 0xdb, 0x2f, 0xb5,
-	0x3a, 0x24, 0xaa, 0x33, 0x78, 0x5e, 0xf7, 0x6a, 0xc1, 0x30, 0xba, 0x09, 0xb3, 0x27, 0xf0, 0x40,
-	0x65, 0x5c, 0xff, 0x8a, 0x93, 0x13, 0xa5, 0x4e, 0xdf, 0xf1, 0x04, 0x0f, 0xa6, 0x53, 0x8c, 0x75,
-	0xe1, 0xb5, 0xe8, 0x55, 0x6f, 0x89, 0xb2, 0x47, 0x70, 0x2f, 0x16, 0x65, 0xa1, 0x31, 0x0f, 0x13,
-	0x95, 0x45, 0x5c, 0x7a, 0x6d, 0x7a, 0xfb, 0xbe, 0x43, 0x5f, 0x12, 0xe8, 0xff, 0xd3, 0x82, 0x26,
-	0xed, 0x1d, 0x7b, 0x0a, 0x5d, 0xda, 0xbd, 0x90, 0x67, 0x51, 0x8a, 0xae, 0x31, 0x16, 0x37, 0xfa,
-	0xd0, 0x44, 0x03, 0x20, 0x2a, 0x3d, 0xb3, 0x1f, 0x60, 0xe0, 0x84, 0x92, 0x6b, 0xa7, 0xae, 0xdf,
-	0xa9, 0xbe, 0x67, 0xd5, 0x92, 0x6b, 0xeb, 0xf0, 0x0c, 0x7a, 0xe6, 0x7b, 0xe5, 0x4a, 0x84, 0x33,
-	0x95, 0x6b, 0xd7, 0x34, 0xf7, 0x17, 0x9b, 0x4c, 0xe5, 0x3a, 0xe8, 0x3a, 0xaa, 0x59, 0xb0, 0x23,
-	0x58, 0xe3, 0xa9, 0x54, 0x39, 0x86, 0x5c, 0x4e, 0x54, 0x29, 0x13, 0x32, 0x28, 0xbc, 0xc6, 0xd6,
-	0xd2, 0x76, 0x77, 0x67, 0xf3, 0xd3, 0x0e, 0x91, 0x4c, 0x31, 0x60, 0x56, 0x77, 0x68, 0x65, 0x06,
-	0x2f, 0xd8, 0x5b, 0xb8, 0xef, 0xdc, 0x54, 0xa9, 0xaf, 0xda, 0x35, 0xff, 0xd7, 0x6e, 0xd5, 0x0a,
-	0x7f, 0x76, 0x3a, 0xeb, 0xf7, 0x0c, 0x7a, 0x57, 0xcb, 0x72, 0xcd, 0x70, 0xdb, 0x7b, 0xf1, 0xcb,
-	0x52, 0xd8, 0xf7, 0x00, 0x51, 0x92, 0x71, 0x69, 0x75, 0xad, 0xbb, 0x74, 0x1d, 0x22, 0x92, 0x6a,
-	0x0f, 0xfa, 0xd7, 0x0a, 0xa7, 0x2d, 0xbf, 0x55, 0xd8, 0x53, 0x57, 0x8a, 0x65, 0xfb, 0xd0, 0xce,
-	0xb1, 0x50, 0x65, 0x1e, 0xa3, 0xd7, 0x21, 0xd9, 0xa3, 0x05, 0x59, 0xe0, 0x08, 0x01, 0x7e, 0x28,
-	0x79, 0x8e, 0x19, 0x4a, 0x5d, 0x04, 0x73, 0x19, 0xfb, 0x0c, 0x3a, 0xb6, 0x11, 0x4a, 0x9e, 0x78,
-	0xb0, 0x55, 0xdb, 0x5e, 0x0a, 0xda, 0x04, 0xfc, 0xc2, 0x13, 0xf6, 0x04, 0x3a, 0x42, 0xa5, 0xa1,
-	0xc0, 0x33, 0x14, 0x5e, 0x97, 0x12, 0x6c, 0x2c, 0x24, 0x38, 0x52, 0xe9, 0x91, 0x21, 0x04, 0x6d,
-	0xe1, 0x9e, 0xd8, 0x1e, 0x6c, 0x24, 0xbc, 0x30, 0x47, 0x39, 0xc4, 0x0b, 0x8d, 0xb9, 0x8c, 0x44,
-	0x38, 0xcb, 0xd5, 0x94, 0x0b, 0x2c, 0xbc, 0x1e, 0x1d, 0x81, 0x75, 0x47, 0x38, 0x70, 0xf1, 0x63,
-	0x17, 0x66, 0x5f, 0x41, 0xdf, 0x16, 0x54, 0x0d, 0x80, 0x3e, 0x1d, 0x81, 0x1e, 0x81, 0xef, 0xdd,
-	0x14, 0x78, 0x0a, 0xde, 0xcd, 0xf6, 0x9d, 0xf3, 0xef, 0x11, 0xff, 0xfe, 0xf5, 0x76, 0xbd, 0x14,
-	0x76, 0x13, 0x9c, 0x94, 0xa9, 0x6b, 0xf9, 0x95, 0xbb, 0x0f, 0x0c, 0x51, 0x6d, 0xbb, 0x8f, 0x60,
-	0xf5, 0x8a, 0x70, 0x9e, 0x6c, 0x40, 0xc9, 0x86, 0x97, 0x44, 0x97, 0xc8, 0x7f, 0x0d, 0x4d, 0x2b,
-	0x7c, 0x08, 0x60, 0x25, 0x66, 0x2c, 0xba, 0x89, 0xd8, 0x21, 0xc4, 0xcc, 0x43, 0x33, 0x0a, 0x67,
-	0xa5, 0x30, 0x67, 0x48, 0xf0, 0xd8, 0x8e, 0xea, 0x4e, 0x00, 0x06, 0x3a, 0x26, 0xc4, 0xdf, 0x84,
-	0x06, 0xed, 0x35, 0x83, 0x06, 0xb5, 0x87, 0x71, 0xe8, 0x07, 0xf4, 0xec, 0x3f, 0x86, 0xce, 0xbc,
-	0x9b, 0x4d, 0x22, 0x03, 0x86, 0xb9, 0x59, 0x55, 0x89, 0x66, 0x55, 0xd8, 0xff, 0xbb, 0x06, 0x6b,
-	0x9f, 0xea, 0x05, 0x53, 0x41, 0x8e, 0x1f, 0x4a, 0x2c, 0x74, 0x18, 0xcf, 0x4a, 0x27, 0x04, 0x07,
-	0xbd, 0x98, 0x95, 0x66, 0x2a, 0x55, 0x84, 0x0c, 0x33, 0x95, 0x57, 0x55, 0xf6, 0x1d, 0xfa, 0x13,
-	0x81, 0xa6, 0x93, 0x04, 0xcf, 0xb8, 0x75, 0xb1, 0x53, 0xbb, 0x4d, 0x80, 0xf1, 0xf8, 0x12, 0x7a,
-	0x36, 0xe8, 0x1c, 0x1a, 0x14, 0xef, 0x12, 0x66, 0xf5, 0xfe, 0x3a, 0x0c, 0x17, 0x06, 0xec, 0x5e,
-	0xdd, 0xab, 0xf9, 0x7f, 0xd4, 0x61, 0xe5, 0xc6, 0x28, 0x37, 0x7e, 0x3a, 0x2f, 0x0b, 0x5d, 0xcd,
-	0x49, 0x5b, 0x75, 0x97, 0x30, 0x3b, 0x25, 0xd9, 0x63, 0x18, 0x5a, 0x4a, 0x24, 0xe3, 0x13, 0x95,
-	0x17, 0xe1, 0x0c, 0x33, 0x57, 0xf9, 0x0a, 0x05, 0xf6, 0x2d, 0x7e, 0x8c, 0x19, 0x7b, 0x05, 0x43,
-	0x5e, 0x14, 0x65, 0x24, 0x63, 0x0c, 0x05, 0x9f, 0xa2, 0xe6, 0x19, 0xba, 0x89, 0xb6, 0x31, 0xb2,
-	0xf7, 0xf3, 0xa8, 0xba, 0x9f, 0x47, 0x2f, 0xdd, 0xfd, 0x1c, 0x0c, 0x2a, 0xcd, 0x91, 0x93, 0xb0,
-	0x37, 0xb0, 0x16, 0x0b, 0x15, 0x9f, 0x86, 0xc5, 0x29, 0x9e, 0x87, 0x91, 0x10, 0xea, 0xdc, 0xc4,
-	0xdd, 0x0d, 0x75, 0x87, 0x15, 0x23, 0xd9, 0xbb, 0x53, 0x3c, 0xdf, 0xaf, 0x44, 0xec, 0x01, 0x2c,
-	0x17, 0xf1, 0x09, 0x66, 0xe8, 0x35, 0xa9, 0x6a, 0xb7, 0xf2, 0xb7, 0xa0, 0x5d, 0x9d, 0x39, 0xb6,
-	0x06, 0x4d, 0x7b, 0x3a, 0xed, 0x07, 0xb0, 0x0b, 0xff, 0xcf, 0x1a, 0xb4, 0xdc, 0x65, 0x4d, 0x77,
-	0xad, 0xe0, 0xf3, 0x86, 0x75, 0x0d, 0x16, 0x0b, 0x5e, 0x1d, 0x89, 0x5d, 0x68, 0x4e, 0x45, 0x94,
-	0x16, 0xde, 0x12, 0x0d, 0xcc, 0x87, 0xb7, 0x5d, 0xfb, 0xa3, 0x57, 0x22, 0x4a, 0x03, 0xcb, 0xdd,
-	0xfc, 0x16, 0x1a, 0x66, 0x69, 0xba, 0xf2, 0x4a, 0x5f, 0xd3, 0xb3, 0xa9, 0xe9, 0x2c, 0x12, 0x25,
-	0xba, 0x5c, 0x76, 0xf1, 0x63, 0xa3, 0x5d, 0x1b, 0xd4, 0x9f, 0xef, 0xfe, 0xf6, 0x5d, 0xca, 0xf5,
-	0x49, 0x39, 0x19, 0xc5, 0x2a, 0x1b, 0xbb, 0x4c, 0xd5, 0xef, 0xce, 0xd8, 0x5d, 0x13, 0x02, 0xf3,
-	0x71, 0x8a, 0xd2, 0xfd, 0x71, 0x9a, 0x2c, 0xd3, 0xf7, 0xda, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x45, 0xd3, 0x28, 0x78, 0x50, 0x09, 0x00, 0x00,
+	// 1240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x86, 0x64, 0x49, 0x96, 0x46, 0x56, 0x24, 0xad, 0xed, 0x84, 0xf1, 0x0f, 0xc9, 0xcf, 0x65,
+	0x11, 0x20, 0x48, 0x5b, 0xa9, 0x75, 0x8a, 0x24, 0xc8, 0xa9, 0xce, 0x5f, 0xb8, 0x71, 0x52, 0x83,
+	0x41, 0x53, 0xa0, 0x17, 0x82, 0x22, 0x47, 0xf4, 0x56, 0xcb, 0x5d, 0x85, 0x5c, 0xc6, 0xce, 0x03,
+	0xf4, 0xd8, 0x73, 0x7b, 0xea, 0x33, 0xf4, 0xc9, 0x7a, 0xea, 0x03, 0x14, 0x3b, 0xbb, 0x54, 0x6c,
+	0x29, 0x76, 0x7a, 0x22, 0xf7, 0x9b, 0xef, 0x9b, 0x19, 0x72, 0x67, 0x66, 0x17, 0x36, 0x63, 0x25,
+	0xa7, 0x3c, 0x1d, 0xdb, 0xc7, 0x68, 0x9e, 0x2b, 0xad, 0x58, 0x5f, 0x70, 0x39, 0xc3, 0x3c, 0xd9,
+	0x1b, 0x59, 0x78, 0xe7, 0x66, 0xaa, 0x54, 0x2a, 0x70, 0x4c, 0xe6, 0x49, 0x39, 0x1d, 0x27, 0x65,
+	0x1e, 0x69, 0xae, 0xa4, 0x15, 0xf8, 0x7f, 0xd4, 0x60, 0x6d, 0x5f, 0x08, 0x36, 0x86, 0x56, 0x2a,
+	0xd4, 0x24, 0x12, 0x5e, 0x6d, 0xb7, 0x76, 0xbb, 0xbb, 0x77, 0x6d, 0xb4, 0xe4, 0x69, 0xf4, 0x9c,
+	0xcc, 0x81, 0xa3, 0xb1, 0x2f, 0xa1, 0x39, 0xcf, 0xd5, 0xe9, 0x7b, 0xaf, 0x4e, 0xfc, 0xab, 0x2b,
+	0xfc, 0x23, 0x63, 0x0d, 0x2c, 0x89, 0xed, 0xc1, 0x3a, 0x97, 0x85, 0x8e, 0x84, 0xf0, 0xd6, 0x88,
+	0xef, 0xad, 0xf0, 0x0f, 0xac, 0x3d, 0xa8, 0x88, 0xfe, 0x3f, 0x75, 0x68, 0xd9, 0xa0, 0xec, 0x0b,
+	0x18, 0x3a, 0x7a, 0x28, 0xa3, 0x0c, 0x8b, 0x79, 0x14, 0x23, 0x25, 0xda, 0x09, 0x06, 0xce, 0xf0,
+	0xaa, 0xc2, 0xd9, 0xff, 0xa1, 0x1b, 0x4b, 0x1e, 0xa2, 0x8c, 0x26, 0x02, 0x13, 0xca, 0xaf, 0x1d,
+	0x40, 0x2c, 0xf9, 0x53, 0x8b, 0x30, 0x0f, 0xd6, 0xdf, 0x61, 0x5e, 0x70, 0x25, 0x29, 0x99, 0x4e,
+	0x50, 0x2d, 0xd9, 0x0b, 0x18, 0xf0, 0x04, 0xa5, 0xe6, 0xfa, 0x7d, 0x18, 0x2b, 0xa9, 0xf1, 0x54,
+	0x7b, 0x0d, 0xca, 0x77, 0x77, 0x35, 0x5f, 0x47, 0x7c, 0x6c, 0x79, 0x41, 0x9f, 0x9f, 0x07, 0xd8,
+	0x1b, 0xd8, 0x8c, 0x4a, 0xad, 0x42, 0x2e, 0x7f, 0xc1, 0x58, 0x2f, 0xfc, 0xb5, 0xc8, 0x9f, 0xbf,
+	0xe2, 0x6f, 0xbf, 0xd4, 0xea, 0x80, 0xa8, 0xce, 0xc1, 0xa3, 0xba, 0x57, 0x0b, 0x86, 0xd1, 0x32,
+	0xcc, 0xee, 0xc1, 0x55, 0x95, 0x71, 0xfd, 0x13, 0x4e, 0x8e, 0x95, 0x9a, 0xbd, 0xe6, 0x09, 0x3e,
+	0x9d, 0x4e, 0x31, 0xd6, 0x85, 0xb7, 0x4e, 0x9f, 0x7a, 0x81, 0x95, 0xdd, 0x82, 0x2b, 0xb1, 0x28,
+	0x0b, 0x8d, 0x79, 0x98, 0xa8, 0x2c, 0xe2, 0xd2, 0x6b, 0xd3, 0xd7, 0xf7, 0x1c, 0xfa, 0x84, 0x40,
+	0xff, 0xef, 0x75, 0x68, 0xd2, 0xde, 0xb1, 0xfb, 0xd0, 0xa5, 0xdd, 0x0b, 0x79, 0x16, 0xa5, 0xe8,
+	0xd5, 0x2e, 0xd8, 0xe8, 0x03, 0x63, 0x0d, 0x80, 0xa8, 0xf4, 0xce, 0xbe, 0x83, 0x81, 0x13, 0x4a,
+	0xae, 0x9d, 0xba, 0x7e, 0xa9, 0xfa, 0x8a, 0x55, 0x4b, 0xae, 0xad, 0x87, 0x07, 0xb0, 0x61, 0xfe,
+	0x57, 0xae, 0x44, 0x38, 0x57, 0xb9, 0x76, 0x45, 0xb3, 0xbd, 0x5a, 0x64, 0x2a, 0xd7, 0x41, 0xd7,
+	0x51, 0xcd, 0x82, 0x1d, 0xc2, 0x16, 0x4f, 0xa5, 0xca, 0x31, 0xe4, 0x72, 0xa2, 0x4a, 0x99, 0x90,
+	0x83, 0xc2, 0x6b, 0xec, 0xae, 0xdd, 0xee, 0xee, 0xed, 0x7c, 0xdc, 0x43, 0x24, 0x53, 0x0c, 0x98,
+	0xd5, 0x1d, 0x58, 0x99, 0xc1, 0x0b, 0xf6, 0x0a, 0xb6, 0x9d, 0x37, 0x55, 0xea, 0xb3, 0xee, 0x9a,
+	0x9f, 0x74, 0xb7, 0x69, 0x85, 0x3f, 0x38, 0x9d, 0xf5, 0xf7, 0x00, 0x36, 0xce, 0xa6, 0xe5, 0xb5,
+	0x2e, 0xfd, 0x2e, 0xfe, 0x21, 0x15, 0xf6, 0x2d, 0x40, 0x94, 0x64, 0x5c, 0x5a, 0xdd, 0xfa, 0x65,
+	0xba, 0x0e, 0x11, 0x49, 0xf5, 0x10, 0x7a, 0xe7, 0x12, 0xf7, 0xda, 0x97, 0x09, 0x37, 0xd4, 0x99,
+	0x64, 0xd9, 0x3e, 0xb4, 0x73, 0x2c, 0x54, 0x99, 0xc7, 0xe8, 0x75, 0x48, 0x76, 0x6b, 0x45, 0x16,
+	0x38, 0x42, 0x80, 0x6f, 0x4b, 0x9e, 0x63, 0x86, 0x52, 0x17, 0xc1, 0x42, 0xc6, 0xfe, 0x07, 0x1d,
+	0x5b, 0x08, 0x25, 0x4f, 0x3c, 0xd8, 0xad, 0xdd, 0x5e, 0x0b, 0xda, 0x04, 0xfc, 0xc8, 0x13, 0x76,
+	0x0f, 0x3a, 0x42, 0xa5, 0xa1, 0xc0, 0x77, 0x28, 0xbc, 0x2e, 0x05, 0xb8, 0xbe, 0x12, 0xe0, 0x50,
+	0xa5, 0x87, 0x86, 0x10, 0xb4, 0x85, 0x7b, 0x63, 0x0f, 0xe1, 0x7a, 0xc2, 0x0b, 0xd3, 0xca, 0x21,
+	0x9e, 0x6a, 0xcc, 0x65, 0x24, 0xc2, 0x79, 0xae, 0xa6, 0x5c, 0x60, 0xe1, 0x6d, 0x50, 0x0b, 0x5c,
+	0x73, 0x84, 0xa7, 0xce, 0x7e, 0xe4, 0xcc, 0xec, 0x73, 0xe8, 0xd9, 0x84, 0xaa, 0x01, 0xd0, 0xa3,
+	0x16, 0xd8, 0x20, 0xf0, 0x8d, 0xc5, 0xd8, 0x7d, 0xf0, 0x96, 0xcb, 0x77, 0xc1, 0xbf, 0x42, 0xfc,
+	0xed, 0xf3, 0xe5, 0xfa, 0x41, 0xd8, 0x4d, 0x70, 0x52, 0xa6, 0xae, 0xe4, 0xfb, 0x97, 0x37, 0x0c,
+	0x51, 0xe9, 0x9d, 0x8d, 0x60, 0xf3, 0x8c, 0x70, 0x11, 0x6c, 0x40, 0xc1, 0x86, 0x1f, 0x88, 0x6f,
+	0xce, 0xcc, 0x29, 0x9a, 0x09, 0x5c, 0x99, 0x82, 0x10, 0x3c, 0x7e, 0xef, 0x0d, 0x2f, 0x9a, 0x53,
+	0x15, 0xf1, 0x88, 0x78, 0x41, 0x9f, 0x9f, 0x07, 0xfc, 0xe7, 0xd0, 0xb4, 0x59, 0xdc, 0x00, 0xb0,
+	0xf1, 0xcd, 0x8c, 0x75, 0xe3, 0xb5, 0x43, 0x88, 0x19, 0xae, 0x66, 0xae, 0xce, 0x4b, 0x21, 0xaa,
+	0x78, 0x75, 0xb2, 0x83, 0x81, 0x9c, 0xa3, 0x1d, 0x68, 0x50, 0xe1, 0x30, 0x68, 0x50, 0xad, 0x19,
+	0x0f, 0xbd, 0x80, 0xde, 0xfd, 0x3b, 0xd0, 0x59, 0xb4, 0x86, 0x09, 0x64, 0xc0, 0x30, 0x37, 0xab,
+	0x2a, 0xd0, 0xbc, 0x32, 0xfb, 0x7f, 0xd6, 0x60, 0xeb, 0x63, 0x85, 0x65, 0x32, 0xc8, 0xf1, 0x6d,
+	0x89, 0x85, 0x0e, 0xe3, 0x79, 0xe9, 0x84, 0xe0, 0xa0, 0xc7, 0xf3, 0xd2, 0x8c, 0xb8, 0x8a, 0x90,
+	0x61, 0xa6, 0xf2, 0x2a, 0xcb, 0x9e, 0x43, 0x5f, 0x12, 0x68, 0xca, 0x52, 0xf0, 0x8c, 0x5b, 0x2f,
+	0xf6, 0x08, 0x68, 0x13, 0x60, 0x7c, 0x7c, 0x06, 0x1b, 0xd6, 0xe8, 0x3c, 0x34, 0xc8, 0xde, 0x25,
+	0xcc, 0xea, 0xfd, 0x6b, 0x30, 0x5c, 0x99, 0xd6, 0x0f, 0xeb, 0x5e, 0xcd, 0xff, 0x6b, 0x0d, 0xfa,
+	0x4b, 0xe7, 0x82, 0xf1, 0xa7, 0xf3, 0xb2, 0xd0, 0xd5, 0xd0, 0xb5, 0x59, 0x77, 0x09, 0xb3, 0x23,
+	0x97, 0xdd, 0x81, 0xa1, 0xa5, 0x44, 0x32, 0x3e, 0x56, 0x79, 0x11, 0xce, 0x31, 0x73, 0x99, 0xf7,
+	0xc9, 0xb0, 0x6f, 0xf1, 0x23, 0xcc, 0xd8, 0x33, 0x18, 0xf2, 0xa2, 0x28, 0x23, 0x19, 0x63, 0x28,
+	0xf8, 0x14, 0x35, 0xcf, 0xd0, 0x8d, 0xc7, 0xeb, 0x23, 0x7b, 0xd8, 0x8f, 0xaa, 0xc3, 0x7e, 0xf4,
+	0xc4, 0x1d, 0xf6, 0xc1, 0xa0, 0xd2, 0x1c, 0x3a, 0x09, 0x7b, 0x01, 0x5b, 0xb1, 0x50, 0xf1, 0x2c,
+	0x2c, 0x66, 0x78, 0x12, 0x46, 0x42, 0xa8, 0x13, 0x63, 0xf7, 0x1a, 0x9f, 0x72, 0xc5, 0x48, 0xf6,
+	0x7a, 0x86, 0x27, 0xfb, 0x95, 0x88, 0x5d, 0x85, 0x56, 0x11, 0x1f, 0x63, 0x86, 0x5e, 0x93, 0xb2,
+	0x76, 0x2b, 0xb3, 0x1f, 0x5a, 0xcd, 0x50, 0x86, 0x51, 0x99, 0x70, 0x34, 0xee, 0x5b, 0x76, 0x3f,
+	0x08, 0xdd, 0x77, 0xa0, 0x39, 0xde, 0x8b, 0x39, 0x9f, 0x4e, 0x31, 0x74, 0x67, 0x28, 0xc7, 0xea,
+	0x30, 0x1b, 0x58, 0xc3, 0xc1, 0x02, 0x67, 0x2f, 0x61, 0x3b, 0x8b, 0x4e, 0xc3, 0xd5, 0x9f, 0xd0,
+	0xfe, 0x54, 0xe6, 0x9b, 0x59, 0x74, 0x7a, 0xb0, 0xf4, 0x1f, 0xfc, 0x5d, 0x68, 0x57, 0x33, 0x86,
+	0x6d, 0x41, 0xd3, 0x4e, 0x23, 0xbb, 0x47, 0x76, 0xe1, 0xff, 0x5e, 0x83, 0x75, 0x77, 0x39, 0xa1,
+	0xbb, 0x85, 0xe0, 0x8b, 0x06, 0x75, 0x3d, 0x10, 0x0b, 0x5e, 0x75, 0xe6, 0x5d, 0x68, 0x4e, 0x45,
+	0x94, 0x16, 0xde, 0x1a, 0x1d, 0x10, 0x37, 0x2e, 0xba, 0xe6, 0x8c, 0x9e, 0x89, 0x28, 0x0d, 0x2c,
+	0x77, 0xe7, 0x6b, 0x68, 0x98, 0xa5, 0x69, 0x9c, 0x33, 0xad, 0x47, 0xef, 0x26, 0xa7, 0x77, 0x91,
+	0x28, 0xd1, 0xc5, 0xb2, 0x8b, 0xef, 0x1b, 0xed, 0xda, 0xa0, 0xee, 0xff, 0x5a, 0x83, 0xfe, 0x52,
+	0x7b, 0xb3, 0x07, 0xe6, 0xa6, 0x15, 0x8b, 0x32, 0x31, 0x6e, 0x4c, 0x0a, 0x37, 0x2f, 0x9e, 0x08,
+	0x41, 0x29, 0x30, 0xa8, 0xe8, 0x46, 0x89, 0xa7, 0x56, 0x59, 0xff, 0x6f, 0x4a, 0x47, 0xf7, 0x7f,
+	0xab, 0x41, 0xef, 0x9c, 0x89, 0x7d, 0x05, 0x6c, 0x71, 0x51, 0x0b, 0x0b, 0x14, 0x18, 0x6b, 0x95,
+	0xbb, 0xef, 0x1a, 0x2e, 0x2c, 0xaf, 0x9d, 0xc1, 0x7c, 0xe4, 0x8c, 0xcb, 0xa4, 0xa0, 0xc0, 0x9d,
+	0xc0, 0x2e, 0xcc, 0xcf, 0x56, 0x27, 0x12, 0x73, 0x7b, 0xe7, 0xa3, 0x3f, 0xda, 0x09, 0x80, 0x20,
+	0xba, 0xed, 0x99, 0xb2, 0xa3, 0xf1, 0x64, 0x4f, 0xf7, 0x4e, 0xe0, 0x56, 0x8f, 0xee, 0xfe, 0xfc,
+	0x4d, 0xca, 0xf5, 0x71, 0x39, 0x19, 0xc5, 0x2a, 0x1b, 0xbb, 0x8f, 0xa8, 0x9e, 0x7b, 0x63, 0x77,
+	0x5d, 0x10, 0x98, 0x8f, 0x53, 0x94, 0xee, 0x02, 0x3d, 0x69, 0x51, 0xc1, 0xdc, 0xfd, 0x77, 0x00,
+	0xb4, 0x12, 0xe9, 0xf1, 0x58, 0x0b, 0x00, 0x00,
 }
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: controller/identity.proto
+
+package identity
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	public "github.com/linkerd/linkerd2/controller/gen/public"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("controller/identity.proto", fileDescriptor_f8744f38ed8ef7f1) }
+
+var fileDescriptor_f8744f38ed8ef7f1 = []byte{
+	// 157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xce, 0xcf, 0x2b,
+	0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xc9, 0xc9, 0xcc, 0xcb, 0x4e, 0x2d, 0x4a, 0x31, 0xd2,
+	0x43, 0xa8, 0xd1, 0x83, 0xa9, 0x91, 0xe2, 0x29, 0x28, 0x4d, 0xca, 0xc9, 0x4c, 0x86, 0xa8, 0x35,
+	0x8a, 0xe6, 0x62, 0x75, 0x2c, 0x4d, 0xc9, 0x2c, 0x11, 0x0a, 0xe2, 0xe2, 0xf4, 0x2c, 0x2e, 0x2e,
+	0x4d, 0xcc, 0x4b, 0x4e, 0x2d, 0x16, 0x52, 0xd4, 0x83, 0x1b, 0x01, 0x55, 0x0d, 0x97, 0x0b, 0x4a,
+	0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x91, 0x52, 0xc2, 0xa7, 0xa4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5,
+	0xc9, 0x21, 0xca, 0x2e, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xaa,
+	0x1e, 0x46, 0x1b, 0xe9, 0x23, 0xf9, 0x20, 0x3d, 0x35, 0x4f, 0x1f, 0x8b, 0x87, 0x92, 0xd8, 0xc0,
+	0xae, 0x34, 0x06, 0x0c, 0x00, 0x08, 0x91, 0xc2, 0xd8, 0xee, 0x00, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditClient interface {
+	// Issuances returns the recent certificate issuances and rejections.
+	Issuances(ctx context.Context, in *public.IssuancesRequest, opts ...grpc.CallOption) (*public.IssuancesResponse, error)
+}
+
+type auditClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditClient(cc *grpc.ClientConn) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) Issuances(ctx context.Context, in *public.IssuancesRequest, opts ...grpc.CallOption) (*public.IssuancesResponse, error) {
+	out := new(public.IssuancesResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.controller.identity.Audit/Issuances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+type AuditServer interface {
+	// Issuances returns the recent certificate issuances and rejections.
+	Issuances(context.Context, *public.IssuancesRequest) (*public.IssuancesResponse, error)
+}
+
+// UnimplementedAuditServer can be embedded to have forward compatible implementations.
+type UnimplementedAuditServer struct {
+}
+
+func (*UnimplementedAuditServer) Issuances(ctx context.Context, req *public.IssuancesRequest) (*public.IssuancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issuances not implemented")
+}
+
+func RegisterAuditServer(s *grpc.Server, srv AuditServer) {
+	s.RegisterService(&_Audit_serviceDesc, srv)
+}
+
+func _Audit_Issuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(public.IssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).Issuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.controller.identity.Audit/Issuances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).Issuances(ctx, req.(*public.IssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Audit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkerd2.controller.identity.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Issuances",
+			Handler:    _Audit_Issuances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/identity.proto",
+}
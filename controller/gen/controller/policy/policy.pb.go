@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: controller/policy.proto
+
+package policy
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Action int32
+
+const (
+	Action_ALLOW Action = 0
+	Action_DENY  Action = 1
+)
+
+var Action_name = map[int32]string{
+	0: "ALLOW",
+	1: "DENY",
+}
+
+var Action_value = map[string]int32{
+	"ALLOW": 0,
+	"DENY":  1,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b4b01aeffa62e7c, []int{0}
+}
+
+type PortSpec struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pod                  string   `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
+	Port                 uint32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortSpec) Reset()         { *m = PortSpec{} }
+func (m *PortSpec) String() string { return proto.CompactTextString(m) }
+func (*PortSpec) ProtoMessage()    {}
+func (*PortSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4b01aeffa62e7c, []int{0}
+}
+
+func (m *PortSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSpec.Unmarshal(m, b)
+}
+func (m *PortSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSpec.Marshal(b, m, deterministic)
+}
+func (m *PortSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSpec.Merge(m, src)
+}
+func (m *PortSpec) XXX_Size() int {
+	return xxx_messageInfo_PortSpec.Size(m)
+}
+func (m *PortSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSpec proto.InternalMessageInfo
+
+func (m *PortSpec) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PortSpec) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *PortSpec) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+type ServerPolicy struct {
+	// The action applied to the clients that match none of the rules. It is
+	// ALLOW when no ServerAuthorization applies to the port, and DENY otherwise.
+	DefaultAction Action `protobuf:"varint,1,opt,name=default_action,json=defaultAction,proto3,enum=linkerd2.controller.policy.Action" json:"default_action,omitempty"`
+	// The rules are evaluated in order, and the first one that matches the
+	// client decides whether the connection is authorized.
+	Rules []*Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// The ServerAuthorizations the policy was built from, formatted as
+	// <namespace>/<name>.
+	Authorizations       []string `protobuf:"bytes,3,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerPolicy) Reset()         { *m = ServerPolicy{} }
+func (m *ServerPolicy) String() string { return proto.CompactTextString(m) }
+func (*ServerPolicy) ProtoMessage()    {}
+func (*ServerPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4b01aeffa62e7c, []int{1}
+}
+
+func (m *ServerPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerPolicy.Unmarshal(m, b)
+}
+func (m *ServerPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerPolicy.Marshal(b, m, deterministic)
+}
+func (m *ServerPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerPolicy.Merge(m, src)
+}
+func (m *ServerPolicy) XXX_Size() int {
+	return xxx_messageInfo_ServerPolicy.Size(m)
+}
+func (m *ServerPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerPolicy proto.InternalMessageInfo
+
+func (m *ServerPolicy) GetDefaultAction() Action {
+	if m != nil {
+		return m.DefaultAction
+	}
+	return Action_ALLOW
+}
+
+func (m *ServerPolicy) GetRules() []*Rule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *ServerPolicy) GetAuthorizations() []string {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+type Rule struct {
+	Action               Action       `protobuf:"varint,1,opt,name=action,proto3,enum=linkerd2.controller.policy.Action" json:"action,omitempty"`
+	Client               *ClientMatch `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Rule) Reset()         { *m = Rule{} }
+func (m *Rule) String() string { return proto.CompactTextString(m) }
+func (*Rule) ProtoMessage()    {}
+func (*Rule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4b01aeffa62e7c, []int{2}
+}
+
+func (m *Rule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rule.Unmarshal(m, b)
+}
+func (m *Rule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rule.Marshal(b, m, deterministic)
+}
+func (m *Rule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rule.Merge(m, src)
+}
+func (m *Rule) XXX_Size() int {
+	return xxx_messageInfo_Rule.Size(m)
+}
+func (m *Rule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rule proto.InternalMessageInfo
+
+func (m *Rule) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_ALLOW
+}
+
+func (m *Rule) GetClient() *ClientMatch {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+type ClientMatch struct {
+	// Types that are valid to be assigned to Match:
+	//	*ClientMatch_Unauthenticated
+	//	*ClientMatch_Identity
+	//	*ClientMatch_IdentitySuffix
+	Match                isClientMatch_Match `protobuf_oneof:"match"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ClientMatch) Reset()         { *m = ClientMatch{} }
+func (m *ClientMatch) String() string { return proto.CompactTextString(m) }
+func (*ClientMatch) ProtoMessage()    {}
+func (*ClientMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4b01aeffa62e7c, []int{3}
+}
+
+func (m *ClientMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientMatch.Unmarshal(m, b)
+}
+func (m *ClientMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientMatch.Marshal(b, m, deterministic)
+}
+func (m *ClientMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientMatch.Merge(m, src)
+}
+func (m *ClientMatch) XXX_Size() int {
+	return xxx_messageInfo_ClientMatch.Size(m)
+}
+func (m *ClientMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientMatch proto.InternalMessageInfo
+
+type isClientMatch_Match interface {
+	isClientMatch_Match()
+}
+
+type ClientMatch_Unauthenticated struct {
+	Unauthenticated bool `protobuf:"varint,1,opt,name=unauthenticated,proto3,oneof"`
+}
+
+type ClientMatch_Identity struct {
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3,oneof"`
+}
+
+type ClientMatch_IdentitySuffix struct {
+	IdentitySuffix string `protobuf:"bytes,3,opt,name=identity_suffix,json=identitySuffix,proto3,oneof"`
+}
+
+func (*ClientMatch_Unauthenticated) isClientMatch_Match() {}
+
+func (*ClientMatch_Identity) isClientMatch_Match() {}
+
+func (*ClientMatch_IdentitySuffix) isClientMatch_Match() {}
+
+func (m *ClientMatch) GetMatch() isClientMatch_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ClientMatch) GetUnauthenticated() bool {
+	if x, ok := m.GetMatch().(*ClientMatch_Unauthenticated); ok {
+		return x.Unauthenticated
+	}
+	return false
+}
+
+func (m *ClientMatch) GetIdentity() string {
+	if x, ok := m.GetMatch().(*ClientMatch_Identity); ok {
+		return x.Identity
+	}
+	return ""
+}
+
+func (m *ClientMatch) GetIdentitySuffix() string {
+	if x, ok := m.GetMatch().(*ClientMatch_IdentitySuffix); ok {
+		return x.IdentitySuffix
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ClientMatch) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ClientMatch_Unauthenticated)(nil),
+		(*ClientMatch_Identity)(nil),
+		(*ClientMatch_IdentitySuffix)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("linkerd2.controller.policy.Action", Action_name, Action_value)
+	proto.RegisterType((*PortSpec)(nil), "linkerd2.controller.policy.PortSpec")
+	proto.RegisterType((*ServerPolicy)(nil), "linkerd2.controller.policy.ServerPolicy")
+	proto.RegisterType((*Rule)(nil), "linkerd2.controller.policy.Rule")
+	proto.RegisterType((*ClientMatch)(nil), "linkerd2.controller.policy.ClientMatch")
+}
+
+func init() { proto.RegisterFile("controller/policy.proto", fileDescriptor_9b4b01aeffa62e7c) }
+
+var fileDescriptor_9b4b01aeffa62e7c = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x97, 0x36, 0xb4, 0xaf, 0xac, 0xab, 0x7c, 0x21, 0x9a, 0x86, 0x14, 0x45, 0x08, 0xc2,
+	0x0e, 0x09, 0x0a, 0x12, 0x07, 0x84, 0x40, 0x1b, 0x20, 0x0d, 0x69, 0x8c, 0xc9, 0x3d, 0x4c, 0xe3,
+	0x32, 0x79, 0x8e, 0xbb, 0x5a, 0xb8, 0x76, 0xe4, 0x38, 0x88, 0x71, 0xe5, 0xc8, 0x5f, 0xc4, 0x7f,
+	0x87, 0xe2, 0x24, 0xac, 0x2a, 0x22, 0x12, 0xa7, 0x3c, 0x7f, 0xfe, 0xbe, 0xe7, 0x2f, 0xef, 0x07,
+	0x3c, 0x60, 0x5a, 0x59, 0xa3, 0xa5, 0xe4, 0x26, 0x2d, 0xb4, 0x14, 0xec, 0x36, 0x29, 0x8c, 0xb6,
+	0x1a, 0xef, 0x4b, 0xa1, 0xbe, 0x70, 0x93, 0x67, 0xc9, 0x1d, 0x23, 0x69, 0x18, 0xd1, 0x19, 0x8c,
+	0xcf, 0xb5, 0xb1, 0x8b, 0x82, 0x33, 0x7c, 0x00, 0x13, 0x45, 0xd7, 0xbc, 0x2c, 0x28, 0xe3, 0x01,
+	0x0a, 0x51, 0x3c, 0x21, 0x77, 0x00, 0x9e, 0x83, 0x57, 0xe8, 0x3c, 0xd8, 0x71, 0x78, 0x1d, 0x62,
+	0x0c, 0xc3, 0x42, 0x1b, 0x1b, 0x78, 0x21, 0x8a, 0x77, 0x89, 0x8b, 0xa3, 0x5f, 0x08, 0xee, 0x2f,
+	0xb8, 0xf9, 0xca, 0xcd, 0xb9, 0x7b, 0x00, 0x7f, 0x80, 0x59, 0xce, 0x97, 0xb4, 0x92, 0xf6, 0x8a,
+	0x32, 0x2b, 0xb4, 0x72, 0x99, 0x67, 0x59, 0x94, 0xfc, 0xdb, 0x55, 0x72, 0xe4, 0x98, 0x64, 0xb7,
+	0x55, 0x36, 0x47, 0xfc, 0x02, 0x46, 0xa6, 0x92, 0xbc, 0x0c, 0x76, 0x42, 0x2f, 0x9e, 0x66, 0x61,
+	0x5f, 0x06, 0x52, 0x49, 0x4e, 0x1a, 0x3a, 0x7e, 0x0c, 0x33, 0x5a, 0xd9, 0x95, 0x36, 0xe2, 0x3b,
+	0xad, 0x13, 0x95, 0x81, 0x17, 0x7a, 0xf1, 0x84, 0x6c, 0xa1, 0xd1, 0x0f, 0x04, 0xc3, 0x5a, 0x87,
+	0x5f, 0x82, 0xff, 0xdf, 0x5e, 0x5b, 0x05, 0x7e, 0x03, 0x3e, 0x93, 0x82, 0x2b, 0xeb, 0x2a, 0x35,
+	0xcd, 0x9e, 0xf4, 0x69, 0xdf, 0x3a, 0xe6, 0x47, 0x6a, 0xd9, 0x8a, 0xb4, 0xb2, 0xe8, 0x27, 0x82,
+	0xe9, 0x06, 0x8e, 0x0f, 0x61, 0xaf, 0x52, 0xb5, 0x53, 0xae, 0xac, 0x60, 0xd4, 0xf2, 0xdc, 0xb9,
+	0x1a, 0x9f, 0x0c, 0xc8, 0xf6, 0x05, 0x3e, 0x80, 0xb1, 0xc8, 0xeb, 0xa3, 0xbd, 0x6d, 0x1a, 0x75,
+	0x32, 0x20, 0x7f, 0x10, 0xfc, 0x14, 0xf6, 0xba, 0xf8, 0xaa, 0xac, 0x96, 0x4b, 0xf1, 0x2d, 0xf0,
+	0x5a, 0xd2, 0xac, 0xbb, 0x58, 0x38, 0xfc, 0xf8, 0x1e, 0x8c, 0xd6, 0xf5, 0xeb, 0x87, 0x0f, 0xc1,
+	0x6f, 0xab, 0x3f, 0x81, 0xd1, 0xd1, 0xe9, 0xe9, 0xa7, 0x8b, 0xf9, 0x00, 0x8f, 0x61, 0xf8, 0xee,
+	0xfd, 0xd9, 0xe5, 0x1c, 0x65, 0x0c, 0xfc, 0xb6, 0xcf, 0x97, 0x30, 0xba, 0x70, 0x7e, 0x1f, 0xf5,
+	0xfd, 0x70, 0x37, 0x6b, 0xfb, 0x71, 0x1f, 0x6b, 0x73, 0x80, 0x9e, 0xa1, 0xe3, 0xd7, 0x9f, 0x5f,
+	0xdd, 0x08, 0xbb, 0xaa, 0xae, 0x13, 0xa6, 0xd7, 0x69, 0xab, 0xeb, 0xbe, 0x59, 0xba, 0x31, 0xf6,
+	0x37, 0x5c, 0xa5, 0x7f, 0x6d, 0xc1, 0xb5, 0xef, 0xd6, 0xe0, 0xf9, 0xef, 0x01, 0x00, 0x2c, 0xbb,
+	0xa0, 0xa3, 0x21, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PolicyClient is the client API for Policy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PolicyClient interface {
+	// Watch streams the effective policy of a pod port. A new policy is sent
+	// every time the set of ServerAuthorizations that apply to the port changes.
+	Watch(ctx context.Context, in *PortSpec, opts ...grpc.CallOption) (Policy_WatchClient, error)
+}
+
+type policyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPolicyClient(cc *grpc.ClientConn) PolicyClient {
+	return &policyClient{cc}
+}
+
+func (c *policyClient) Watch(ctx context.Context, in *PortSpec, opts ...grpc.CallOption) (Policy_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Policy_serviceDesc.Streams[0], "/linkerd2.controller.policy.Policy/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &policyWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Policy_WatchClient interface {
+	Recv() (*ServerPolicy, error)
+	grpc.ClientStream
+}
+
+type policyWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *policyWatchClient) Recv() (*ServerPolicy, error) {
+	m := new(ServerPolicy)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PolicyServer is the server API for Policy service.
+type PolicyServer interface {
+	// Watch streams the effective policy of a pod port. A new policy is sent
+	// every time the set of ServerAuthorizations that apply to the port changes.
+	Watch(*PortSpec, Policy_WatchServer) error
+}
+
+// UnimplementedPolicyServer can be embedded to have forward compatible implementations.
+type UnimplementedPolicyServer struct {
+}
+
+func (*UnimplementedPolicyServer) Watch(req *PortSpec, srv Policy_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterPolicyServer(s *grpc.Server, srv PolicyServer) {
+	s.RegisterService(&_Policy_serviceDesc, srv)
+}
+
+func _Policy_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PortSpec)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PolicyServer).Watch(m, &policyWatchServer{stream})
+}
+
+type Policy_WatchServer interface {
+	Send(*ServerPolicy) error
+	grpc.ServerStream
+}
+
+type policyWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *policyWatchServer) Send(m *ServerPolicy) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Policy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkerd2.controller.policy.Policy",
+	HandlerType: (*PolicyServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Policy_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "controller/policy.proto",
+}
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: controller/signer.proto
+
+package signer
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Hash int32
+
+const (
+	// The message is signed as is, e.g. with Ed25519 keys.
+	Hash_NONE   Hash = 0
+	Hash_SHA256 Hash = 1
+	Hash_SHA384 Hash = 2
+	Hash_SHA512 Hash = 3
+)
+
+var Hash_name = map[int32]string{
+	0: "NONE",
+	1: "SHA256",
+	2: "SHA384",
+	3: "SHA512",
+}
+
+var Hash_value = map[string]int32{
+	"NONE":   0,
+	"SHA256": 1,
+	"SHA384": 2,
+	"SHA512": 3,
+}
+
+func (x Hash) String() string {
+	return proto.EnumName(Hash_name, int32(x))
+}
+
+func (Hash) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4d87145025bc63ab, []int{0}
+}
+
+type PublicKeyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublicKeyRequest) Reset()         { *m = PublicKeyRequest{} }
+func (m *PublicKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*PublicKeyRequest) ProtoMessage()    {}
+func (*PublicKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d87145025bc63ab, []int{0}
+}
+
+func (m *PublicKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicKeyRequest.Unmarshal(m, b)
+}
+func (m *PublicKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *PublicKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicKeyRequest.Merge(m, src)
+}
+func (m *PublicKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_PublicKeyRequest.Size(m)
+}
+func (m *PublicKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicKeyRequest proto.InternalMessageInfo
+
+type PublicKeyResponse struct {
+	// The DER-encoded PKIX public key.
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublicKeyResponse) Reset()         { *m = PublicKeyResponse{} }
+func (m *PublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*PublicKeyResponse) ProtoMessage()    {}
+func (*PublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d87145025bc63ab, []int{1}
+}
+
+func (m *PublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicKeyResponse.Unmarshal(m, b)
+}
+func (m *PublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *PublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicKeyResponse.Merge(m, src)
+}
+func (m *PublicKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_PublicKeyResponse.Size(m)
+}
+func (m *PublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicKeyResponse proto.InternalMessageInfo
+
+func (m *PublicKeyResponse) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type SignRequest struct {
+	Digest []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// The hash function that produced the digest.
+	Hash                 Hash     `protobuf:"varint,2,opt,name=hash,proto3,enum=linkerd2.controller.signer.Hash" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRequest) Reset()         { *m = SignRequest{} }
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRequest) ProtoMessage()    {}
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d87145025bc63ab, []int{2}
+}
+
+func (m *SignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRequest.Unmarshal(m, b)
+}
+func (m *SignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRequest.Marshal(b, m, deterministic)
+}
+func (m *SignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRequest.Merge(m, src)
+}
+func (m *SignRequest) XXX_Size() int {
+	return xxx_messageInfo_SignRequest.Size(m)
+}
+func (m *SignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRequest proto.InternalMessageInfo
+
+func (m *SignRequest) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *SignRequest) GetHash() Hash {
+	if m != nil {
+		return m.Hash
+	}
+	return Hash_NONE
+}
+
+type SignResponse struct {
+	// The signature, in the format of the key's algorithm (e.g. ASN.1 DER for
+	// ECDSA).
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d87145025bc63ab, []int{3}
+}
+
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignResponse.Unmarshal(m, b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+}
+func (m *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(m, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return xxx_messageInfo_SignResponse.Size(m)
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("linkerd2.controller.signer.Hash", Hash_name, Hash_value)
+	proto.RegisterType((*PublicKeyRequest)(nil), "linkerd2.controller.signer.PublicKeyRequest")
+	proto.RegisterType((*PublicKeyResponse)(nil), "linkerd2.controller.signer.PublicKeyResponse")
+	proto.RegisterType((*SignRequest)(nil), "linkerd2.controller.signer.SignRequest")
+	proto.RegisterType((*SignResponse)(nil), "linkerd2.controller.signer.SignResponse")
+}
+
+func init() { proto.RegisterFile("controller/signer.proto", fileDescriptor_4d87145025bc63ab) }
+
+var fileDescriptor_4d87145025bc63ab = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4b, 0xf3, 0x40,
+	0x10, 0xc6, 0xdf, 0xf4, 0x0d, 0xc1, 0x8e, 0x45, 0xe2, 0x1e, 0xb4, 0x14, 0x85, 0x92, 0x8b, 0x45,
+	0xea, 0x06, 0xb7, 0xad, 0x78, 0x10, 0x41, 0x41, 0x2c, 0x08, 0x55, 0xda, 0x93, 0x7a, 0x90, 0xfe,
+	0x19, 0x92, 0xa5, 0x71, 0x37, 0xee, 0x6e, 0x0e, 0xfd, 0x96, 0x7e, 0x24, 0x69, 0xba, 0xa9, 0x41,
+	0xb1, 0x7a, 0xca, 0x64, 0xf8, 0xcd, 0xcc, 0xb3, 0x0f, 0x0f, 0xec, 0x4f, 0xa5, 0x30, 0x4a, 0x26,
+	0x09, 0xaa, 0x50, 0xf3, 0x48, 0xa0, 0xa2, 0xa9, 0x92, 0x46, 0x92, 0x46, 0xc2, 0xc5, 0x1c, 0xd5,
+	0x8c, 0xd1, 0x4f, 0x82, 0xae, 0x88, 0x80, 0x80, 0xff, 0x90, 0x4d, 0x12, 0x3e, 0xbd, 0xc3, 0xc5,
+	0x10, 0xdf, 0x32, 0xd4, 0x26, 0x60, 0xb0, 0x5b, 0xea, 0xe9, 0x54, 0x0a, 0x8d, 0xe4, 0x10, 0x20,
+	0xcd, 0x9b, 0x2f, 0x73, 0x5c, 0xd4, 0x9d, 0xa6, 0xd3, 0xaa, 0x0d, 0xab, 0x69, 0x81, 0x05, 0xcf,
+	0xb0, 0x3d, 0xe2, 0x91, 0xb0, 0x2b, 0xc8, 0x1e, 0x78, 0x33, 0x1e, 0xa1, 0x36, 0x96, 0xb4, 0x7f,
+	0xa4, 0x0b, 0x6e, 0x3c, 0xd6, 0x71, 0xbd, 0xd2, 0x74, 0x5a, 0x3b, 0xac, 0x49, 0x7f, 0x56, 0x46,
+	0xfb, 0x63, 0x1d, 0x0f, 0x73, 0x3a, 0x68, 0x43, 0x6d, 0xb5, 0xdc, 0x6a, 0x39, 0x80, 0xea, 0x12,
+	0x1a, 0x9b, 0x4c, 0x61, 0x21, 0x65, 0xdd, 0x38, 0xee, 0x82, 0xbb, 0x9c, 0x25, 0x5b, 0xe0, 0x0e,
+	0xee, 0x07, 0x37, 0xfe, 0x3f, 0x02, 0xe0, 0x8d, 0xfa, 0x57, 0xac, 0x77, 0xe6, 0x3b, 0xb6, 0xee,
+	0x9c, 0x77, 0xfd, 0x8a, 0xad, 0x7b, 0xa7, 0xcc, 0xff, 0xcf, 0xde, 0x1d, 0xf0, 0x46, 0xf9, 0x65,
+	0x32, 0x87, 0xda, 0x2d, 0x9a, 0xb5, 0x05, 0xa4, 0xbd, 0x49, 0xe6, 0x57, 0xf7, 0x1a, 0x27, 0x7f,
+	0xa4, 0xed, 0x5b, 0x1e, 0xc1, 0x5d, 0x9e, 0x25, 0x47, 0x9b, 0xc6, 0x4a, 0xd6, 0x36, 0x5a, 0xbf,
+	0x83, 0xab, 0xd5, 0xd7, 0x97, 0x4f, 0x17, 0x11, 0x37, 0x71, 0x36, 0xa1, 0x53, 0xf9, 0x1a, 0xda,
+	0xa9, 0xe2, 0xcb, 0xc2, 0x52, 0x5c, 0x22, 0x14, 0xe1, 0xb7, 0xf4, 0x4c, 0xbc, 0x3c, 0x3e, 0x9d,
+	0x8f, 0x01, 0x00, 0x1a, 0x59, 0x28, 0x97, 0x59, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SignerClient interface {
+	// GetPublicKey returns the public key matching the signing key, which must
+	// also be the public key of the issuer certificate.
+	GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyResponse, error)
+	// Sign signs a digest with the signing key.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKeyResponse, error) {
+	out := new(PublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.controller.signer.Signer/GetPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.controller.signer.Signer/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+type SignerServer interface {
+	// GetPublicKey returns the public key matching the signing key, which must
+	// also be the public key of the issuer certificate.
+	GetPublicKey(context.Context, *PublicKeyRequest) (*PublicKeyResponse, error)
+	// Sign signs a digest with the signing key.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+}
+
+// UnimplementedSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedSignerServer struct {
+}
+
+func (*UnimplementedSignerServer) GetPublicKey(ctx context.Context, req *PublicKeyRequest) (*PublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (*UnimplementedSignerServer) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.controller.signer.Signer/GetPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).GetPublicKey(ctx, req.(*PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.controller.signer.Signer/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkerd2.controller.signer.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _Signer_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Signer_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/signer.proto",
+}
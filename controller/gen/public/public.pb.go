@@ -3036,13 +3036,24 @@ func (m *StatSummaryResponse_Ok) GetStatTables() []*StatTable {
 }
 
 type BasicStats struct {
-	SuccessCount         uint64   `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailureCount         uint64   `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	LatencyMsP50         uint64   `protobuf:"varint,3,opt,name=latency_ms_p50,json=latencyMsP50,proto3" json:"latency_ms_p50,omitempty"`
-	LatencyMsP95         uint64   `protobuf:"varint,4,opt,name=latency_ms_p95,json=latencyMsP95,proto3" json:"latency_ms_p95,omitempty"`
-	LatencyMsP99         uint64   `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
-	ActualSuccessCount   uint64   `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
-	ActualFailureCount   uint64   `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
+	SuccessCount       uint64 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount       uint64 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	LatencyMsP50       uint64 `protobuf:"varint,3,opt,name=latency_ms_p50,json=latencyMsP50,proto3" json:"latency_ms_p50,omitempty"`
+	LatencyMsP95       uint64 `protobuf:"varint,4,opt,name=latency_ms_p95,json=latencyMsP95,proto3" json:"latency_ms_p95,omitempty"`
+	LatencyMsP99       uint64 `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
+	ActualSuccessCount uint64 `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
+	ActualFailureCount uint64 `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
+	// Number of requests retried by the client proxies, i.e. the number of
+	// actual requests in excess of the effective ones.
+	RetryCount uint64 `protobuf:"varint,8,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// Number of retries that were skipped because the retry budget of the route
+	// was exhausted.
+	RetryBudgetExhaustedCount uint64 `protobuf:"varint,9,opt,name=retry_budget_exhausted_count,json=retryBudgetExhaustedCount,proto3" json:"retry_budget_exhausted_count,omitempty"`
+	// Number of responses with a 504 Gateway Timeout status. These include the
+	// responses synthesized by the client proxies when a request exceeds the
+	// route timeout, which the proxy metrics don't tell apart from the 504
+	// responses of the servers.
+	GatewayTimeoutCount  uint64   `protobuf:"varint,10,opt,name=gateway_timeout_count,json=gatewayTimeoutCount,proto3" json:"gateway_timeout_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3122,6 +3133,27 @@ func (m *BasicStats) GetActualFailureCount() uint64 {
 	return 0
 }
 
+func (m *BasicStats) GetRetryCount() uint64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *BasicStats) GetRetryBudgetExhaustedCount() uint64 {
+	if m != nil {
+		return m.RetryBudgetExhaustedCount
+	}
+	return 0
+}
+
+func (m *BasicStats) GetGatewayTimeoutCount() uint64 {
+	if m != nil {
+		return m.GatewayTimeoutCount
+	}
+	return 0
+}
+
 type TcpStats struct {
 	// number of currently open connections
 	OpenConnections uint64 `protobuf:"varint,1,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
@@ -3354,10 +3386,13 @@ type StatTable_PodGroup_Row struct {
 	TcpStats       *TcpStats          `protobuf:"bytes,8,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
 	TsStats        *TrafficSplitStats `protobuf:"bytes,10,opt,name=ts_stats,json=tsStats,proto3" json:"ts_stats,omitempty"`
 	// Stores a set of errors for each pod name. If a pod has no errors, it may be omitted.
-	ErrorsByPod          map[string]*PodErrors `protobuf:"bytes,7,rep,name=errors_by_pod,json=errorsByPod,proto3" json:"errors_by_pod,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	ErrorsByPod map[string]*PodErrors `protobuf:"bytes,7,rep,name=errors_by_pod,json=errorsByPod,proto3" json:"errors_by_pod,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// number of the meshed pods counted in meshed_pod_count that have linkerd
+	// injected in outbound-only mode
+	OutboundOnlyPodCount uint64   `protobuf:"varint,11,opt,name=outbound_only_pod_count,json=outboundOnlyPodCount,proto3" json:"outbound_only_pod_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StatTable_PodGroup_Row) Reset()         { *m = StatTable_PodGroup_Row{} }
@@ -3455,6 +3490,13 @@ func (m *StatTable_PodGroup_Row) GetErrorsByPod() map[string]*PodErrors {
 	return nil
 }
 
+func (m *StatTable_PodGroup_Row) GetOutboundOnlyPodCount() uint64 {
+	if m != nil {
+		return m.OutboundOnlyPodCount
+	}
+	return 0
+}
+
 type EdgesRequest struct {
 	Selector             *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -4009,6 +4051,194 @@ func (m *RouteTable_Row) GetStats() *BasicStats {
 	return nil
 }
 
+type IssuancesRequest struct {
+	// The maximum number of records to return, keeping the most recent ones.
+	// All the records kept by the identity controller are returned when 0.
+	Limit                uint32   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssuancesRequest) Reset()         { *m = IssuancesRequest{} }
+func (m *IssuancesRequest) String() string { return proto.CompactTextString(m) }
+func (*IssuancesRequest) ProtoMessage()    {}
+func (*IssuancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{35}
+}
+
+func (m *IssuancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuancesRequest.Unmarshal(m, b)
+}
+func (m *IssuancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuancesRequest.Marshal(b, m, deterministic)
+}
+func (m *IssuancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuancesRequest.Merge(m, src)
+}
+func (m *IssuancesRequest) XXX_Size() int {
+	return xxx_messageInfo_IssuancesRequest.Size(m)
+}
+func (m *IssuancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuancesRequest proto.InternalMessageInfo
+
+func (m *IssuancesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type IssuancesResponse struct {
+	// The records, from the oldest to the most recent.
+	Issuances            []*Issuance `protobuf:"bytes,1,rep,name=issuances,proto3" json:"issuances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *IssuancesResponse) Reset()         { *m = IssuancesResponse{} }
+func (m *IssuancesResponse) String() string { return proto.CompactTextString(m) }
+func (*IssuancesResponse) ProtoMessage()    {}
+func (*IssuancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{36}
+}
+
+func (m *IssuancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuancesResponse.Unmarshal(m, b)
+}
+func (m *IssuancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuancesResponse.Marshal(b, m, deterministic)
+}
+func (m *IssuancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuancesResponse.Merge(m, src)
+}
+func (m *IssuancesResponse) XXX_Size() int {
+	return xxx_messageInfo_IssuancesResponse.Size(m)
+}
+func (m *IssuancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuancesResponse proto.InternalMessageInfo
+
+func (m *IssuancesResponse) GetIssuances() []*Issuance {
+	if m != nil {
+		return m.Issuances
+	}
+	return nil
+}
+
+// The audit record of a certificate issued by the identity controller, or of
+// a certification request it rejected.
+type Issuance struct {
+	// When the request was processed, in RFC 3339 format.
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The identity requested by the proxy.
+	RequestedIdentity string `protobuf:"bytes,2,opt,name=requested_identity,json=requestedIdentity,proto3" json:"requested_identity,omitempty"`
+	// The service account of the request's token, as `namespace/name`. Empty
+	// when the token couldn't be validated.
+	ServiceAccount string `protobuf:"bytes,3,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	// The serial number of the issued certificate, in hexadecimal.
+	Serial string `protobuf:"bytes,4,opt,name=serial,proto3" json:"serial,omitempty"`
+	// When the issued certificate expires, in RFC 3339 format.
+	NotAfter string `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// The gRPC status code of the response, `OK` when a certificate was issued.
+	Code string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	// Why the request was rejected, e.g. `identity_mismatch`. Empty when a
+	// certificate was issued.
+	RejectionReason string `protobuf:"bytes,7,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// The details of the rejection.
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Issuance) Reset()         { *m = Issuance{} }
+func (m *Issuance) String() string { return proto.CompactTextString(m) }
+func (*Issuance) ProtoMessage()    {}
+func (*Issuance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_413a91106d7bcce8, []int{37}
+}
+
+func (m *Issuance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Issuance.Unmarshal(m, b)
+}
+func (m *Issuance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Issuance.Marshal(b, m, deterministic)
+}
+func (m *Issuance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Issuance.Merge(m, src)
+}
+func (m *Issuance) XXX_Size() int {
+	return xxx_messageInfo_Issuance.Size(m)
+}
+func (m *Issuance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Issuance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Issuance proto.InternalMessageInfo
+
+func (m *Issuance) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *Issuance) GetRequestedIdentity() string {
+	if m != nil {
+		return m.RequestedIdentity
+	}
+	return ""
+}
+
+func (m *Issuance) GetServiceAccount() string {
+	if m != nil {
+		return m.ServiceAccount
+	}
+	return ""
+}
+
+func (m *Issuance) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *Issuance) GetNotAfter() string {
+	if m != nil {
+		return m.NotAfter
+	}
+	return ""
+}
+
+func (m *Issuance) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Issuance) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *Issuance) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("linkerd2.public.HttpMethod_Registered", HttpMethod_Registered_name, HttpMethod_Registered_value)
 	proto.RegisterEnum("linkerd2.public.Scheme_Registered", Scheme_Registered_name, Scheme_Registered_value)
@@ -4073,219 +4303,237 @@ func init() {
 	proto.RegisterType((*TopRoutesResponse_Ok)(nil), "linkerd2.public.TopRoutesResponse.Ok")
 	proto.RegisterType((*RouteTable)(nil), "linkerd2.public.RouteTable")
 	proto.RegisterType((*RouteTable_Row)(nil), "linkerd2.public.RouteTable.Row")
+	proto.RegisterType((*IssuancesRequest)(nil), "linkerd2.public.IssuancesRequest")
+	proto.RegisterType((*IssuancesResponse)(nil), "linkerd2.public.IssuancesResponse")
+	proto.RegisterType((*Issuance)(nil), "linkerd2.public.Issuance")
 }
 
 func init() { proto.RegisterFile("public.proto", fileDescriptor_413a91106d7bcce8) }
 
 var fileDescriptor_413a91106d7bcce8 = []byte{
-	// 3301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4d, 0x6f, 0x23, 0x49,
-	0xd5, 0xdf, 0x1f, 0xcf, 0x4e, 0xe2, 0xa9, 0xc9, 0x0e, 0x5e, 0xef, 0xee, 0x7c, 0xf4, 0x7c, 0x6c,
-	0x98, 0x01, 0x27, 0x93, 0xf9, 0xd8, 0xc9, 0xcc, 0x2e, 0x10, 0x27, 0xde, 0x71, 0x60, 0x26, 0xf1,
-	0xb6, 0x3d, 0xbb, 0x68, 0xb5, 0xc8, 0xea, 0x74, 0x57, 0x9c, 0x26, 0xed, 0xae, 0x9e, 0xee, 0x72,
-	0x32, 0xfe, 0x03, 0x08, 0x09, 0x21, 0x24, 0x24, 0x6e, 0x48, 0x1c, 0x38, 0xb1, 0xe2, 0xcc, 0x05,
-	0x89, 0x03, 0x57, 0xae, 0x48, 0x88, 0xd3, 0x9e, 0x38, 0xad, 0x38, 0xc1, 0x89, 0x03, 0x42, 0xf5,
-	0xd5, 0xee, 0x8e, 0xed, 0x7c, 0xcc, 0x72, 0x80, 0x93, 0xeb, 0xbd, 0x7a, 0xef, 0xd5, 0xab, 0x57,
-	0xef, 0xab, 0xca, 0x0d, 0x65, 0x6f, 0xb8, 0xeb, 0xd8, 0x66, 0xdd, 0xf3, 0x09, 0x25, 0x68, 0xc1,
-	0xb1, 0xdd, 0x03, 0xec, 0x5b, 0xab, 0x75, 0x81, 0xae, 0x5d, 0xee, 0x13, 0xd2, 0x77, 0xf0, 0x32,
-	0x9f, 0xde, 0x1d, 0xee, 0x2d, 0x5b, 0x43, 0xdf, 0xa0, 0x36, 0x71, 0x05, 0x43, 0xad, 0x6a, 0x92,
-	0xc1, 0x80, 0xb8, 0xcb, 0xfb, 0xd8, 0x70, 0xe8, 0xbe, 0xb9, 0x8f, 0xcd, 0x03, 0x39, 0x73, 0xd1,
-	0x24, 0xee, 0x9e, 0xdd, 0x5f, 0x16, 0x3f, 0x02, 0xa9, 0xe5, 0x21, 0xdb, 0x1c, 0x78, 0x74, 0xa4,
-	0xbd, 0x84, 0xd2, 0xc7, 0xd8, 0x0f, 0x6c, 0xe2, 0x6e, 0xb9, 0x7b, 0x04, 0xbd, 0x0d, 0xc5, 0x3e,
-	0x91, 0x88, 0x6a, 0xf2, 0x6a, 0x72, 0xa9, 0xa8, 0x8f, 0x11, 0x6c, 0x76, 0x77, 0x68, 0x3b, 0xd6,
-	0xa6, 0x41, 0x71, 0x35, 0x25, 0x66, 0x43, 0x04, 0xba, 0x05, 0xf3, 0x3e, 0x76, 0xb0, 0x11, 0x60,
-	0x25, 0x20, 0xcd, 0x49, 0x8e, 0x61, 0xb5, 0x7b, 0x70, 0xf1, 0x99, 0x1d, 0xd0, 0x0e, 0xf6, 0x0f,
-	0x6d, 0x13, 0x07, 0x3a, 0x7e, 0x39, 0xc4, 0x01, 0x65, 0xc2, 0x5d, 0x63, 0x80, 0x03, 0xcf, 0x30,
-	0xb1, 0x5a, 0x3a, 0x44, 0x68, 0xcf, 0x60, 0x31, 0xce, 0x14, 0x78, 0xc4, 0x0d, 0x30, 0xba, 0x0f,
-	0x85, 0x40, 0xe2, 0xaa, 0xc9, 0xab, 0xe9, 0xa5, 0xd2, 0x6a, 0xb5, 0x7e, 0xcc, 0x76, 0x75, 0xc9,
-	0xa4, 0x87, 0x94, 0xda, 0x13, 0xc8, 0x4b, 0x24, 0x42, 0x90, 0x61, 0xab, 0xc8, 0x15, 0xf9, 0x38,
-	0xae, 0x4a, 0xea, 0xb8, 0x2a, 0x01, 0x2c, 0x30, 0x55, 0xda, 0xc4, 0x0a, 0x75, 0xbf, 0x3a, 0xa1,
-	0x7b, 0x23, 0x55, 0x4d, 0x46, 0x98, 0xd0, 0xb7, 0x98, 0x9e, 0x0e, 0x36, 0x29, 0xf1, 0xb9, 0xc4,
-	0xd2, 0xaa, 0x36, 0xa1, 0xa7, 0x8e, 0x03, 0x32, 0xf4, 0x4d, 0xdc, 0xe1, 0x84, 0x36, 0x71, 0xf5,
-	0x90, 0x47, 0x7b, 0x1f, 0x2a, 0xe3, 0x45, 0xe5, 0xde, 0x97, 0x20, 0xe3, 0x11, 0x4b, 0xed, 0x7b,
-	0x71, 0x42, 0x5e, 0x9b, 0x58, 0x3a, 0xa7, 0xd0, 0xfe, 0x95, 0x81, 0x74, 0x9b, 0x58, 0x53, 0x37,
-	0xbb, 0x08, 0x59, 0x8f, 0x58, 0x5b, 0x6d, 0xb9, 0x51, 0x01, 0xa0, 0xab, 0x00, 0x16, 0xf6, 0x1c,
-	0x32, 0x1a, 0x60, 0x97, 0x8a, 0x83, 0x6c, 0x25, 0xf4, 0x08, 0x0e, 0x5d, 0x83, 0x92, 0x8f, 0x3d,
-	0xc7, 0x36, 0x8d, 0x5e, 0x80, 0x69, 0x15, 0x14, 0x89, 0x44, 0x76, 0x30, 0x45, 0xef, 0xc1, 0x25,
-	0x09, 0xb1, 0xdd, 0xf4, 0x4c, 0xe2, 0x52, 0x9f, 0x38, 0x0e, 0xf6, 0xab, 0x25, 0x49, 0xfd, 0x46,
-	0x64, 0x7e, 0x23, 0x9c, 0x46, 0xd7, 0xa1, 0x1c, 0x50, 0x83, 0xe2, 0xbd, 0xa1, 0xc3, 0x85, 0x97,
-	0x25, 0x79, 0x49, 0x61, 0x99, 0xf4, 0x2b, 0x00, 0x96, 0x81, 0x07, 0xc4, 0xe5, 0x24, 0x73, 0x92,
-	0xa4, 0x28, 0x70, 0x8c, 0x00, 0x41, 0xfa, 0x87, 0x64, 0xb7, 0x3a, 0x2f, 0x67, 0x18, 0x80, 0x2e,
-	0x41, 0x8e, 0xc9, 0x18, 0x06, 0xd5, 0x0c, 0xdf, 0xae, 0x84, 0x98, 0x15, 0x0c, 0xcb, 0xc2, 0x56,
-	0x35, 0x7b, 0x35, 0xb9, 0x54, 0xd0, 0x05, 0x80, 0x36, 0x60, 0x21, 0xb0, 0x5d, 0x13, 0x3f, 0x33,
-	0x02, 0xaa, 0x63, 0x8f, 0xf8, 0xb4, 0x9a, 0xe3, 0x87, 0xf7, 0x66, 0x5d, 0xc4, 0x63, 0x5d, 0xc5,
-	0x63, 0x7d, 0x53, 0xc6, 0xa3, 0x7e, 0x9c, 0x03, 0xad, 0xc0, 0xc5, 0xf1, 0xce, 0xb7, 0x43, 0x37,
-	0xc9, 0xf3, 0xf5, 0xa7, 0x4d, 0x21, 0x0d, 0xca, 0x12, 0xdd, 0x76, 0x0c, 0x17, 0x57, 0x0b, 0x5c,
-	0xa7, 0x18, 0x0e, 0xdd, 0x85, 0xdc, 0xd0, 0xa3, 0xf6, 0x00, 0x57, 0x8b, 0xa7, 0x69, 0x24, 0x09,
-	0xd1, 0x65, 0x00, 0xcf, 0x27, 0xaf, 0x46, 0x3a, 0x36, 0xac, 0x51, 0x75, 0x81, 0x0b, 0x8d, 0x60,
-	0xd8, 0xb2, 0x1c, 0x52, 0xe1, 0x5b, 0xe1, 0x1a, 0xc6, 0x70, 0x68, 0x09, 0x16, 0x7c, 0xe9, 0xa6,
-	0x8a, 0xec, 0x02, 0x27, 0x3b, 0x8e, 0x6e, 0xe4, 0x21, 0x4b, 0x8e, 0x5c, 0xec, 0x6b, 0x9f, 0xa7,
-	0x00, 0xba, 0x86, 0xa7, 0x62, 0x05, 0x41, 0xda, 0x23, 0x96, 0x70, 0x41, 0x76, 0x2a, 0x1e, 0xb1,
-	0x8e, 0x79, 0x5b, 0x6a, 0x8a, 0xb7, 0x5d, 0x82, 0xdc, 0xc0, 0x78, 0xa5, 0x7b, 0x01, 0xf7, 0xc5,
-	0x94, 0x2e, 0x21, 0x86, 0xa7, 0xa4, 0xcd, 0x0e, 0x86, 0x9d, 0xe7, 0x9c, 0x2e, 0x21, 0xe6, 0xe9,
-	0x94, 0x6c, 0xb5, 0xf9, 0x71, 0x16, 0x75, 0x3e, 0x46, 0x35, 0x28, 0xec, 0xf9, 0x64, 0xd0, 0x56,
-	0xc7, 0x38, 0xa7, 0x87, 0x30, 0x93, 0xc3, 0xc6, 0x5b, 0x6d, 0x79, 0x2e, 0x12, 0xe2, 0xfe, 0x62,
-	0xee, 0xe3, 0x81, 0x38, 0x04, 0xe6, 0x2f, 0x1c, 0xe2, 0xfa, 0x60, 0xba, 0x4f, 0x2c, 0x6e, 0xfe,
-	0xa2, 0x2e, 0x21, 0x96, 0x3a, 0x8c, 0x21, 0xdd, 0x27, 0xbe, 0x4d, 0x47, 0x22, 0x26, 0xf4, 0x31,
-	0x82, 0x69, 0xe5, 0x19, 0x74, 0x5f, 0xb8, 0xbf, 0xce, 0xc7, 0x8f, 0x53, 0xd5, 0x64, 0xa3, 0x00,
-	0x39, 0x6a, 0xf8, 0x7d, 0x4c, 0xb5, 0x1f, 0x15, 0x60, 0xb1, 0x6b, 0x78, 0x8d, 0x91, 0x4a, 0x06,
-	0xca, 0x6c, 0x8f, 0x15, 0x09, 0xb7, 0xdc, 0xd9, 0xd2, 0x87, 0xe4, 0x40, 0xeb, 0x90, 0x1d, 0x18,
-	0xd4, 0xdc, 0x97, 0x99, 0xe7, 0xce, 0x04, 0xeb, 0xb4, 0x15, 0xeb, 0xcf, 0x19, 0x8b, 0x2e, 0x38,
-	0x67, 0xda, 0xff, 0x29, 0xe4, 0xf1, 0x2b, 0xea, 0x1b, 0xa6, 0x38, 0x80, 0xd2, 0xea, 0x37, 0xcf,
-	0x26, 0xbc, 0x29, 0x98, 0x74, 0xc5, 0x5d, 0xfb, 0x5d, 0x06, 0xb2, 0x7c, 0x45, 0xb4, 0x01, 0x69,
-	0xc3, 0x71, 0xe4, 0x36, 0x97, 0xcf, 0xa1, 0x6b, 0xbd, 0x83, 0x5f, 0x32, 0x8f, 0x32, 0x1c, 0x87,
-	0x0b, 0x71, 0x47, 0x72, 0xc3, 0xaf, 0x25, 0xc4, 0x1d, 0xa1, 0x6f, 0x43, 0xda, 0x25, 0x22, 0xfb,
-	0x9d, 0xcf, 0x6a, 0x4c, 0x80, 0x4b, 0x28, 0x6a, 0x41, 0xd9, 0xc2, 0x01, 0xb5, 0x5d, 0x1e, 0x88,
-	0x81, 0x34, 0xd1, 0x19, 0x8e, 0xae, 0x95, 0xd0, 0x63, 0x9c, 0xe8, 0x43, 0xc8, 0xec, 0x53, 0xea,
-	0x71, 0x7f, 0x2e, 0xad, 0xae, 0x9c, 0x67, 0x43, 0x2d, 0x4a, 0xbd, 0x56, 0x42, 0xe7, 0xfc, 0xb5,
-	0x67, 0x90, 0xee, 0xe0, 0x97, 0xa8, 0x09, 0x79, 0x7e, 0xae, 0x61, 0xd5, 0x3c, 0x97, 0x4f, 0x28,
-	0xde, 0xda, 0x08, 0x32, 0x4c, 0x3a, 0xaa, 0x86, 0x51, 0xa2, 0xc2, 0x5a, 0xc5, 0x49, 0x35, 0x8c,
-	0x13, 0x15, 0xd5, 0x2a, 0x52, 0x2e, 0x47, 0x23, 0x45, 0x15, 0x98, 0x48, 0xac, 0x2c, 0xca, 0x58,
-	0xc9, 0xc8, 0x29, 0x0e, 0xb1, 0xac, 0xc2, 0x17, 0x0f, 0x07, 0xb5, 0x3f, 0x27, 0x21, 0x2f, 0xbd,
-	0x09, 0xb5, 0xa4, 0x95, 0x84, 0xef, 0xac, 0x9e, 0xcb, 0x15, 0xe3, 0x76, 0xa2, 0x72, 0x67, 0x1f,
-	0x43, 0x7e, 0x1f, 0x1b, 0x16, 0xf6, 0x03, 0x29, 0xf4, 0xf1, 0xf9, 0x85, 0xd6, 0x5b, 0x42, 0x42,
-	0x2b, 0xa1, 0x2b, 0x61, 0xb5, 0x22, 0xe4, 0x25, 0xb6, 0x51, 0x0c, 0x43, 0x28, 0x32, 0xd4, 0xfe,
-	0x99, 0x04, 0x60, 0xcc, 0xcf, 0x85, 0xb5, 0x5a, 0x00, 0x3e, 0xee, 0xdb, 0x01, 0xc5, 0x3e, 0x16,
-	0xc9, 0x73, 0x7e, 0xf5, 0xd6, 0x84, 0x2a, 0x63, 0x86, 0xba, 0x1e, 0x52, 0x8b, 0xa2, 0xac, 0x20,
-	0x74, 0x03, 0xca, 0x43, 0x37, 0x22, 0x4b, 0x9d, 0x4b, 0x0c, 0xab, 0xb9, 0x00, 0x63, 0x09, 0x28,
-	0x0f, 0xe9, 0xa7, 0xcd, 0x6e, 0x25, 0x81, 0x0a, 0x90, 0x69, 0xef, 0x74, 0xba, 0x95, 0x24, 0x43,
-	0xb5, 0x5f, 0x74, 0x2b, 0x29, 0x04, 0x90, 0xdb, 0x6c, 0x3e, 0x6b, 0x76, 0x9b, 0x95, 0x34, 0x2a,
-	0x42, 0xb6, 0xbd, 0xde, 0xdd, 0x68, 0x55, 0x32, 0xa8, 0x04, 0xf9, 0x9d, 0x76, 0x77, 0x6b, 0x67,
-	0xbb, 0x53, 0xc9, 0x32, 0x60, 0x63, 0x67, 0x7b, 0xbb, 0xb9, 0xd1, 0xad, 0xe4, 0x98, 0x8c, 0x56,
-	0x73, 0x7d, 0xb3, 0x92, 0x67, 0xe4, 0x5d, 0x7d, 0x7d, 0xa3, 0x59, 0x29, 0x34, 0x72, 0x90, 0xa1,
-	0x23, 0x0f, 0x6b, 0xbf, 0x4a, 0x42, 0xae, 0x23, 0x5c, 0x67, 0x73, 0xca, 0x96, 0x27, 0x43, 0x47,
-	0x10, 0x7f, 0xd5, 0xed, 0x5e, 0x8b, 0x6d, 0x97, 0x69, 0xd8, 0xed, 0xb6, 0x2b, 0x09, 0xa6, 0x21,
-	0x1b, 0x75, 0x2a, 0xc9, 0x50, 0xc3, 0xdf, 0x24, 0xc3, 0xa3, 0x43, 0x6b, 0x51, 0xef, 0x60, 0x61,
-	0x74, 0x65, 0xf2, 0x48, 0xc4, 0xbc, 0xfc, 0x1d, 0x3b, 0x80, 0x09, 0x39, 0x81, 0x9a, 0xda, 0x94,
-	0xbd, 0x03, 0xc5, 0x43, 0xc3, 0x19, 0xe2, 0x5e, 0x40, 0xfd, 0x50, 0xe5, 0x02, 0x47, 0x75, 0xa8,
-	0x3f, 0x9e, 0xde, 0xb5, 0x45, 0x97, 0x5d, 0x0e, 0xa7, 0x1b, 0x36, 0x2f, 0xbd, 0x7c, 0xac, 0x75,
-	0xa1, 0xb8, 0xd5, 0x5e, 0xb7, 0x2c, 0x1f, 0x07, 0xac, 0xc5, 0xc9, 0xd8, 0xde, 0xe1, 0x7d, 0xbe,
-	0x4e, 0x9e, 0x39, 0x3a, 0x83, 0xd0, 0x1d, 0x8e, 0x7d, 0x28, 0x33, 0xe5, 0x1b, 0x13, 0xfa, 0x6f,
-	0xb5, 0x0f, 0x1f, 0x4a, 0xe2, 0x87, 0x8d, 0x0c, 0xa4, 0x6c, 0x4f, 0x5b, 0x81, 0x0c, 0xc3, 0xb2,
-	0x9e, 0x69, 0xcf, 0xf6, 0x03, 0x51, 0x91, 0x72, 0xba, 0x00, 0xd8, 0x76, 0x1c, 0x23, 0x10, 0x55,
-	0x3c, 0xa7, 0xf3, 0xb1, 0xf6, 0x0c, 0xa0, 0x6b, 0x7a, 0x4a, 0x91, 0xdb, 0x4c, 0x8a, 0x0c, 0xa7,
-	0xda, 0x94, 0x05, 0x25, 0x9d, 0x9e, 0xb2, 0x3d, 0x5e, 0x31, 0x59, 0xbd, 0x4e, 0xf1, 0x7a, 0xcd,
-	0xc7, 0x9a, 0x05, 0xe9, 0x26, 0x61, 0x62, 0x2a, 0x7d, 0xdf, 0x33, 0x7b, 0xa2, 0x83, 0xeb, 0x99,
-	0xc4, 0x12, 0x36, 0x9c, 0x6b, 0x25, 0xf4, 0x79, 0x36, 0xd3, 0xe1, 0x13, 0x1b, 0xc4, 0xc2, 0x8c,
-	0xd6, 0xc7, 0x01, 0xa6, 0x3d, 0xec, 0xfb, 0xc4, 0x17, 0xb4, 0x29, 0x45, 0xcb, 0x67, 0x9a, 0x6c,
-	0x82, 0xd1, 0x36, 0xb2, 0x90, 0xc6, 0xae, 0xa5, 0xfd, 0x63, 0x01, 0x0a, 0x5d, 0xc3, 0x6b, 0x1e,
-	0xb2, 0xf6, 0xe3, 0x1e, 0xe4, 0x44, 0x7c, 0x4b, 0xb5, 0xdf, 0x9a, 0xcc, 0x02, 0xe1, 0xfe, 0x74,
-	0x49, 0x8a, 0x9e, 0x42, 0x49, 0x8c, 0x7a, 0x03, 0x4c, 0x0d, 0x99, 0xba, 0x6f, 0x4d, 0xcb, 0x1f,
-	0x7c, 0x91, 0x7a, 0xd3, 0xb5, 0x3c, 0x62, 0xbb, 0xf4, 0x39, 0xa6, 0x86, 0x0e, 0x82, 0x95, 0x8d,
-	0xd1, 0x07, 0x50, 0x8a, 0x14, 0x03, 0x79, 0x54, 0x27, 0xaa, 0x10, 0xa5, 0x47, 0x1f, 0x41, 0x25,
-	0x02, 0x0a, 0x65, 0x32, 0xe7, 0x52, 0x66, 0x21, 0xc2, 0xcf, 0x35, 0x6a, 0x00, 0xf8, 0x64, 0x48,
-	0xe5, 0xce, 0xf2, 0x5c, 0xd8, 0xf5, 0xd9, 0xc2, 0x74, 0x46, 0xcb, 0x25, 0x15, 0x7d, 0x35, 0x44,
-	0x1f, 0xc1, 0x02, 0x6f, 0x2d, 0x7b, 0x96, 0xed, 0x8b, 0xaa, 0xc7, 0xbb, 0xb2, 0xf9, 0xd5, 0xa5,
-	0xd9, 0x82, 0xda, 0x8c, 0x61, 0x53, 0xd1, 0xeb, 0xf3, 0x5e, 0x0c, 0x46, 0xf7, 0x65, 0xfe, 0x17,
-	0x15, 0xfb, 0xf2, 0x6c, 0x39, 0xb1, 0x5c, 0xff, 0x8b, 0x24, 0x94, 0xa3, 0xdb, 0x45, 0xdf, 0x85,
-	0x9c, 0x63, 0xec, 0x62, 0x47, 0x45, 0xf5, 0xea, 0xd9, 0xcc, 0x54, 0x7f, 0xc6, 0x99, 0x9a, 0x2e,
-	0xf5, 0x47, 0xba, 0x94, 0x50, 0x5b, 0x83, 0x52, 0x04, 0x8d, 0x2a, 0x90, 0x3e, 0xc0, 0x23, 0x19,
-	0xeb, 0x6c, 0xc8, 0xa2, 0x88, 0x07, 0xab, 0xba, 0x7f, 0x71, 0xe0, 0x71, 0xea, 0x51, 0xb2, 0xf6,
-	0xb3, 0x24, 0x14, 0x43, 0xcb, 0xa1, 0xa7, 0xc7, 0x94, 0x5a, 0x3e, 0x83, 0xb9, 0xff, 0xdb, 0x1a,
-	0xfd, 0xb2, 0x28, 0xcb, 0xe2, 0x0e, 0x94, 0x7d, 0x51, 0xe9, 0x7a, 0xb6, 0x6b, 0xab, 0x9e, 0xf4,
-	0xf6, 0xc9, 0x06, 0xaf, 0xcb, 0xe2, 0xb8, 0xe5, 0xda, 0x94, 0x5d, 0xe6, 0xfc, 0x31, 0x88, 0x74,
-	0x98, 0xf3, 0xe5, 0xbd, 0x56, 0x48, 0x3c, 0xa1, 0x55, 0x8d, 0x49, 0x14, 0x3c, 0x52, 0x64, 0xd9,
-	0x8f, 0xc0, 0x42, 0x49, 0x29, 0x13, 0xbb, 0x96, 0xf4, 0x8a, 0xdb, 0x67, 0x14, 0xd9, 0x74, 0x2d,
-	0xa1, 0x64, 0x08, 0xd6, 0x1e, 0x42, 0xa1, 0x43, 0x7d, 0x6c, 0x0c, 0xb6, 0xf8, 0x55, 0x7a, 0xd7,
-	0x08, 0x64, 0xc6, 0xd1, 0xf9, 0x58, 0x5c, 0x2e, 0xd9, 0x3c, 0xd7, 0x3e, 0xa3, 0x4b, 0xa8, 0xf6,
-	0xf3, 0x14, 0x94, 0x22, 0x7b, 0x47, 0xef, 0x41, 0xca, 0xb6, 0xa4, 0xcd, 0xde, 0x3d, 0x45, 0x1d,
-	0xb5, 0xa0, 0x9e, 0xb2, 0x2d, 0x96, 0x86, 0x22, 0xdd, 0xd4, 0xb4, 0x1c, 0x30, 0xee, 0x00, 0xc2,
-	0x46, 0x6b, 0x39, 0x6c, 0xce, 0x84, 0x01, 0xbe, 0x36, 0xa3, 0x86, 0x86, 0x3d, 0x5b, 0xec, 0x0e,
-	0x93, 0x99, 0x75, 0x87, 0xc9, 0x8e, 0xef, 0x30, 0x68, 0x75, 0x5c, 0x07, 0xc5, 0xfd, 0xb8, 0x3a,
-	0xab, 0x0e, 0x8e, 0x0b, 0xe0, 0xdf, 0x92, 0x50, 0x8e, 0x1e, 0xdf, 0xeb, 0x5b, 0xe5, 0x29, 0x20,
-	0x7e, 0xe7, 0xee, 0xc5, 0x5c, 0x32, 0x75, 0xda, 0xb5, 0xb8, 0xc2, 0x99, 0xa2, 0xe7, 0x72, 0x05,
-	0x4a, 0x2c, 0x21, 0xc8, 0x8a, 0xc2, 0xcd, 0x35, 0xa7, 0x03, 0x43, 0x89, 0x52, 0x12, 0xdd, 0x67,
-	0xe6, 0xac, 0xfb, 0xfc, 0x82, 0x1f, 0x7e, 0xe8, 0x44, 0xff, 0x03, 0xdb, 0xdc, 0x82, 0x8b, 0x4a,
-	0x50, 0x34, 0xe2, 0xd2, 0xa7, 0x49, 0xba, 0x20, 0x25, 0x45, 0xce, 0xec, 0x26, 0xcc, 0x87, 0x42,
-	0x76, 0x47, 0x14, 0x0b, 0xbb, 0x64, 0xf4, 0x30, 0x98, 0x1b, 0x0c, 0x89, 0x6e, 0x41, 0x1a, 0x93,
-	0x40, 0x56, 0xc0, 0xc9, 0x87, 0xaa, 0x26, 0x09, 0x74, 0x46, 0x80, 0xee, 0x43, 0x81, 0xfa, 0x86,
-	0xed, 0x9c, 0xc5, 0x91, 0x42, 0x4a, 0xd6, 0xee, 0x60, 0x66, 0x33, 0xed, 0x11, 0xcc, 0xc7, 0x0b,
-	0x04, 0x6b, 0x3c, 0x5f, 0x6c, 0x7f, 0x6f, 0x7b, 0xe7, 0x93, 0xed, 0x4a, 0x82, 0x01, 0x5b, 0xdb,
-	0x8d, 0x9d, 0x17, 0xdb, 0x9b, 0x95, 0x24, 0x2a, 0x43, 0x61, 0xe7, 0x45, 0x57, 0x40, 0xa9, 0xb1,
-	0x88, 0xab, 0x50, 0x58, 0xf7, 0x6c, 0xde, 0x0c, 0xb0, 0x3c, 0xc8, 0xdb, 0x05, 0x99, 0x1b, 0x05,
-	0xa0, 0x7d, 0x9e, 0x82, 0x62, 0x9b, 0x58, 0x9c, 0x24, 0x40, 0x4f, 0x20, 0xc7, 0xd1, 0x2a, 0x2b,
-	0x5f, 0x9f, 0xf6, 0x0a, 0x27, 0x68, 0xc3, 0x91, 0x2e, 0x59, 0x6a, 0x5f, 0x24, 0xa1, 0xa0, 0x90,
-	0x48, 0x87, 0xa2, 0x49, 0x5c, 0x6a, 0xd8, 0x2e, 0xf6, 0x67, 0x5e, 0x60, 0x26, 0x85, 0xd5, 0x37,
-	0x14, 0x13, 0x07, 0xd9, 0x1d, 0x2a, 0x14, 0x53, 0x3b, 0x84, 0xf9, 0xf8, 0x34, 0xaa, 0x42, 0x7e,
-	0x80, 0x83, 0xc0, 0xe8, 0xab, 0x7e, 0x53, 0x81, 0x2c, 0xea, 0xc7, 0xeb, 0xcb, 0x47, 0xcf, 0x10,
-	0xc1, 0x6c, 0x61, 0x0f, 0x18, 0x97, 0x78, 0xd3, 0x15, 0x00, 0x4b, 0x78, 0x3e, 0x36, 0x02, 0xe2,
-	0xaa, 0xd7, 0x34, 0x01, 0x71, 0x73, 0x72, 0x63, 0xb5, 0xa1, 0xa0, 0x6e, 0x46, 0x27, 0x3f, 0xf0,
-	0xf2, 0x07, 0x9b, 0x91, 0xa7, 0x6a, 0x0e, 0x1f, 0x87, 0x9d, 0x71, 0x7a, 0xdc, 0x19, 0x6b, 0x2f,
-	0xe1, 0xc2, 0xc4, 0x6d, 0x19, 0x3d, 0x80, 0x82, 0x7a, 0x7e, 0x92, 0xa6, 0x7b, 0x73, 0xe6, 0x1d,
-	0x5b, 0x0f, 0x49, 0x99, 0xf7, 0xf2, 0x9a, 0xd8, 0x8b, 0x3d, 0xcd, 0x16, 0xf5, 0x39, 0x8e, 0xed,
-	0xa8, 0xb7, 0xd7, 0xcf, 0x60, 0x4e, 0x31, 0x0b, 0x23, 0xbe, 0xe6, 0x72, 0xa1, 0x3f, 0xa5, 0xa2,
-	0xfe, 0xf4, 0x65, 0x0a, 0x10, 0x4b, 0x2f, 0x9d, 0xe1, 0x60, 0x60, 0xf8, 0x23, 0xf5, 0xde, 0x13,
-	0x7d, 0x30, 0x4e, 0x9e, 0xff, 0xc1, 0x98, 0xe5, 0x32, 0x6a, 0x0f, 0x70, 0xef, 0xc8, 0x76, 0x2d,
-	0x72, 0x24, 0x97, 0x04, 0x86, 0xfa, 0x84, 0x63, 0xd0, 0x37, 0x20, 0xe3, 0x12, 0x57, 0x15, 0x85,
-	0x4b, 0x93, 0x41, 0x39, 0xf0, 0xe8, 0x88, 0xf5, 0x48, 0x8c, 0x0a, 0xbd, 0x0f, 0x25, 0x4a, 0x7a,
-	0xe1, 0xae, 0x33, 0xa7, 0xec, 0x9a, 0x5d, 0xc2, 0x28, 0x09, 0x8f, 0xfe, 0x3b, 0x30, 0xb7, 0xe7,
-	0x93, 0xc1, 0x98, 0x3f, 0x7b, 0x3a, 0x7f, 0x99, 0x71, 0x84, 0x12, 0xde, 0x01, 0x08, 0x0e, 0x6c,
-	0x91, 0x9a, 0x45, 0x6e, 0x28, 0xe8, 0x45, 0x86, 0x61, 0xa6, 0x0b, 0xd0, 0x5b, 0x50, 0xa4, 0xa6,
-	0x9a, 0xcd, 0xf3, 0xd9, 0x02, 0x35, 0xc5, 0x64, 0x03, 0xa0, 0x40, 0x86, 0x74, 0x97, 0x0c, 0x5d,
-	0x4b, 0xfb, 0x4b, 0x12, 0x2e, 0xc6, 0xac, 0x2d, 0xdf, 0xd2, 0xd7, 0x20, 0x45, 0x0e, 0x66, 0x66,
-	0xe5, 0x29, 0x1c, 0xf5, 0x9d, 0x83, 0x56, 0x42, 0x4f, 0x91, 0x03, 0xf4, 0x30, 0x7a, 0xac, 0xd3,
-	0xba, 0xce, 0x98, 0xf3, 0xb4, 0x12, 0xf2, 0xe0, 0x6b, 0xeb, 0x90, 0xda, 0x39, 0x40, 0x4f, 0x80,
-	0x3f, 0x6a, 0xf7, 0xa8, 0xb1, 0xeb, 0x84, 0xaf, 0x31, 0xb5, 0xa9, 0x1a, 0x74, 0x19, 0x89, 0x0e,
-	0x81, 0x1a, 0xf2, 0x9d, 0xa9, 0x44, 0xab, 0xfd, 0x36, 0x05, 0xd0, 0x30, 0x02, 0xdb, 0x14, 0x16,
-	0xb9, 0x0e, 0x73, 0xc1, 0xd0, 0x34, 0x71, 0xc0, 0x6e, 0x46, 0x43, 0x57, 0xb4, 0x68, 0x19, 0xbd,
-	0x2c, 0x91, 0x1b, 0x0c, 0xc7, 0x88, 0xf6, 0x0c, 0xdb, 0x19, 0xfa, 0x58, 0x12, 0x89, 0xbe, 0xa5,
-	0x2c, 0x91, 0x82, 0xe8, 0x06, 0x8b, 0x12, 0x8a, 0x5d, 0x73, 0xd4, 0x1b, 0x04, 0x3d, 0xef, 0xc1,
-	0x0a, 0x77, 0x99, 0x8c, 0x5e, 0x96, 0xd8, 0xe7, 0x41, 0xfb, 0xc1, 0xca, 0x71, 0xaa, 0xb5, 0x07,
-	0xb2, 0x12, 0x44, 0xa8, 0xd6, 0x1e, 0x4c, 0x50, 0xad, 0x71, 0x4f, 0x88, 0x53, 0xad, 0xa1, 0x15,
-	0x58, 0x34, 0x4c, 0x3a, 0x34, 0x9c, 0x5e, 0x7c, 0x0b, 0x39, 0x4e, 0x8b, 0xc4, 0x5c, 0x27, 0xba,
-	0x91, 0x31, 0x47, 0x7c, 0x3f, 0xf9, 0x28, 0xc7, 0x87, 0x91, 0x5d, 0x69, 0x3f, 0x49, 0x42, 0xa1,
-	0x2b, 0x3d, 0x04, 0x7d, 0x1d, 0x2a, 0xc4, 0xc3, 0xfc, 0x1f, 0x0a, 0x57, 0x44, 0x52, 0x20, 0xed,
-	0xb5, 0xc0, 0xf0, 0x1b, 0x63, 0x34, 0x5a, 0x62, 0x37, 0x49, 0xc3, 0x12, 0xd5, 0xae, 0x47, 0x09,
-	0x35, 0x1c, 0x69, 0xb5, 0x79, 0x86, 0xe7, 0xf5, 0xae, 0xcb, 0xb0, 0xe8, 0x36, 0x5c, 0x38, 0xf2,
-	0x6d, 0x8a, 0x63, 0xa4, 0xc2, 0x74, 0x0b, 0x7c, 0x62, 0x4c, 0xab, 0x75, 0xe0, 0x42, 0xd7, 0x37,
-	0xf6, 0xf6, 0x6c, 0xb3, 0xe3, 0x39, 0x36, 0x15, 0x5a, 0x21, 0xc8, 0x18, 0x1e, 0x7e, 0xa5, 0x52,
-	0x22, 0x1b, 0xf3, 0xdb, 0x35, 0x36, 0xf6, 0x54, 0x4a, 0x64, 0x63, 0x96, 0x85, 0x8f, 0xb0, 0xdd,
-	0xdf, 0xa7, 0x2a, 0x0b, 0x0b, 0x48, 0xfb, 0x77, 0x16, 0x8a, 0xa1, 0xdf, 0xa0, 0x06, 0x14, 0x3d,
-	0x62, 0xf5, 0xfa, 0x3e, 0x19, 0xaa, 0xcb, 0xf7, 0xf5, 0xd9, 0x6e, 0xc6, 0xea, 0xcb, 0x53, 0x46,
-	0xda, 0x4a, 0xe8, 0x05, 0x4f, 0x8e, 0x6b, 0xbf, 0xce, 0xf2, 0x82, 0xc5, 0x01, 0xf4, 0x04, 0x32,
-	0x3e, 0x39, 0x52, 0x2e, 0xfb, 0xee, 0x19, 0x64, 0xd5, 0x75, 0x72, 0xa4, 0x73, 0xa6, 0xda, 0x5f,
-	0x33, 0x90, 0xd6, 0xc9, 0xd1, 0xeb, 0xa6, 0xd2, 0x53, 0xb3, 0xdb, 0xf8, 0x7f, 0x9e, 0x62, 0xec,
-	0x7f, 0x9e, 0x25, 0xa8, 0x0c, 0x70, 0xb0, 0x8f, 0xad, 0x1e, 0x33, 0x86, 0x70, 0x12, 0x71, 0x26,
-	0xf3, 0x02, 0xdf, 0x26, 0x96, 0x70, 0xa9, 0xdb, 0x70, 0xc1, 0x1f, 0xba, 0xae, 0xed, 0xf6, 0x23,
-	0xa4, 0xc2, 0xa7, 0x17, 0xe4, 0x44, 0x48, 0xbb, 0x04, 0x15, 0xe6, 0x77, 0x31, 0xa9, 0xc2, 0x59,
-	0xe7, 0x05, 0x3e, 0xa4, 0xbc, 0x0b, 0x59, 0x91, 0xa4, 0xb2, 0x33, 0x1a, 0xf8, 0x71, 0x08, 0xeb,
-	0x82, 0x12, 0x3d, 0x8c, 0xe6, 0xb6, 0xc2, 0x0c, 0x1b, 0x29, 0x57, 0x1e, 0xa7, 0x3d, 0xf4, 0x01,
-	0x14, 0x68, 0x20, 0xd9, 0x60, 0x46, 0x05, 0x99, 0x70, 0x3a, 0x3d, 0x4f, 0x03, 0xc1, 0xfe, 0x19,
-	0xcc, 0x89, 0x36, 0xa5, 0xb7, 0x3b, 0x62, 0xdb, 0xaa, 0xe6, 0xf9, 0x39, 0x3f, 0x3a, 0xe3, 0x39,
-	0xd7, 0x45, 0x9f, 0xd2, 0x18, 0xb1, 0x46, 0x85, 0xdf, 0x3f, 0x4b, 0x78, 0x8c, 0xa9, 0x7d, 0x0a,
-	0x95, 0xe3, 0x04, 0x53, 0x6e, 0xa2, 0x2b, 0xd1, 0x9b, 0xe8, 0xb4, 0xb4, 0x18, 0xf6, 0x43, 0x91,
-	0x5b, 0x2a, 0xeb, 0x3e, 0x78, 0x36, 0xd5, 0xb6, 0xa1, 0xdc, 0xb4, 0xfa, 0xe3, 0xbf, 0x98, 0xbf,
-	0x62, 0x4d, 0xd5, 0x7e, 0x9f, 0x84, 0x39, 0x29, 0x50, 0x96, 0x8d, 0x7b, 0x91, 0xb2, 0x71, 0x6d,
-	0xb2, 0x84, 0x46, 0x69, 0xbf, 0x7a, 0xc1, 0xb8, 0xcb, 0x0b, 0xc6, 0x1d, 0xc8, 0x62, 0x26, 0x57,
-	0xc6, 0xdd, 0x1b, 0x53, 0x57, 0xd5, 0x05, 0x4d, 0xac, 0x40, 0xfc, 0x31, 0x09, 0x19, 0x36, 0x87,
-	0xee, 0x40, 0x3a, 0xf0, 0xcd, 0xd3, 0xc3, 0x8d, 0x51, 0x31, 0x62, 0x2b, 0x18, 0x5f, 0x33, 0x66,
-	0x13, 0x5b, 0x01, 0x65, 0x65, 0xd8, 0x74, 0x6c, 0xec, 0xd2, 0x9e, 0x6d, 0xc9, 0x14, 0x55, 0x10,
-	0x88, 0x2d, 0x8b, 0x4d, 0x06, 0xd8, 0x3f, 0xc4, 0x3e, 0x9b, 0x14, 0x99, 0xaa, 0x20, 0x10, 0x5b,
-	0x16, 0xba, 0x05, 0x0b, 0x2e, 0xe9, 0xd9, 0x16, 0x76, 0xa9, 0x4d, 0x59, 0x71, 0xe8, 0xcb, 0x0b,
-	0xe6, 0x9c, 0x4b, 0xb6, 0x24, 0xf6, 0x79, 0xd0, 0xd7, 0xbe, 0x4c, 0x42, 0xa5, 0x4b, 0x3c, 0xfe,
-	0xc2, 0x11, 0xfc, 0x7f, 0xf4, 0x4a, 0xf9, 0x73, 0xf5, 0x4a, 0xb1, 0x6e, 0xe5, 0x4f, 0x49, 0xb8,
-	0x10, 0xd9, 0xad, 0x74, 0xba, 0xd7, 0xf4, 0x1f, 0x76, 0xf3, 0x24, 0x07, 0x72, 0x0f, 0x37, 0x27,
-	0x53, 0xc1, 0xf1, 0x75, 0x42, 0x87, 0xad, 0xad, 0x71, 0xc7, 0xbb, 0x07, 0x39, 0xfe, 0x78, 0xa7,
-	0x3c, 0x6f, 0x32, 0x77, 0x71, 0x7e, 0xd1, 0xa5, 0x48, 0xd2, 0x98, 0x03, 0xfe, 0x3d, 0x09, 0x30,
-	0x26, 0x41, 0xf7, 0x62, 0xf5, 0xe3, 0xca, 0x09, 0xd2, 0xc6, 0x75, 0x03, 0xd5, 0x22, 0xf5, 0x42,
-	0x9c, 0x53, 0x08, 0xd7, 0x7e, 0x9a, 0x14, 0x35, 0x65, 0x11, 0xb2, 0x7c, 0x75, 0x75, 0x6f, 0xe3,
-	0xc0, 0xe9, 0x87, 0x1c, 0x7b, 0xf6, 0xc8, 0x1d, 0x7f, 0xf6, 0x38, 0x7f, 0xe2, 0x5e, 0xfd, 0x43,
-	0x0e, 0xd2, 0xeb, 0x9e, 0x8d, 0x3e, 0x85, 0x52, 0xa4, 0x81, 0x44, 0xd7, 0x4f, 0x6e, 0x2f, 0xb9,
-	0x4b, 0xd7, 0x6e, 0x9c, 0xa5, 0x07, 0xd5, 0x12, 0xa8, 0x05, 0x59, 0x9e, 0x65, 0xd0, 0x3b, 0xb3,
-	0xb2, 0x8f, 0x90, 0x77, 0xf9, 0xe4, 0xe4, 0xa4, 0x25, 0x50, 0x17, 0x8a, 0xa1, 0x0b, 0xa0, 0x6b,
-	0x27, 0xb9, 0x87, 0x90, 0xa8, 0x9d, 0xee, 0x41, 0x5a, 0x02, 0x7d, 0x04, 0x05, 0xf5, 0xdd, 0x0a,
-	0xba, 0x3a, 0xc1, 0x71, 0xec, 0x3b, 0x9a, 0xda, 0xb5, 0x13, 0x28, 0x42, 0x91, 0x3f, 0x80, 0x72,
-	0xf4, 0x53, 0x20, 0x74, 0x63, 0x2a, 0xd3, 0xb1, 0xcf, 0x8b, 0x6a, 0x37, 0x4f, 0xa1, 0x0a, 0xc5,
-	0x6f, 0x42, 0xba, 0x6b, 0x78, 0xe8, 0xad, 0x69, 0x4f, 0x33, 0x4a, 0xd8, 0x9b, 0x33, 0xdf, 0x6d,
-	0xb4, 0xf4, 0x8f, 0x53, 0xc9, 0x95, 0x24, 0xfa, 0x3e, 0xcc, 0xc5, 0xfe, 0x17, 0x44, 0x37, 0xcf,
-	0xf4, 0xbf, 0xe1, 0x19, 0x24, 0xaf, 0x43, 0x5e, 0x7d, 0x8c, 0x31, 0x23, 0x11, 0xd5, 0xde, 0x9e,
-	0xc0, 0x47, 0xbe, 0xf1, 0xd2, 0x12, 0xc8, 0x81, 0x62, 0x07, 0x3b, 0x7b, 0x1b, 0xfb, 0xd8, 0x3c,
-	0x40, 0x91, 0x3f, 0xec, 0xc5, 0x37, 0x64, 0xf5, 0xe8, 0x37, 0x64, 0x21, 0x9d, 0x52, 0xb0, 0x7e,
-	0x56, 0xf2, 0xd0, 0xa0, 0x8f, 0x20, 0xb7, 0xc1, 0xbf, 0x3d, 0x9b, 0xa9, 0xef, 0x62, 0x54, 0x26,
-	0xff, 0x4a, 0x6d, 0xdd, 0x71, 0xb4, 0x44, 0xe3, 0xde, 0xa7, 0x77, 0xfb, 0x36, 0xdd, 0x1f, 0xee,
-	0xb2, 0xa5, 0x96, 0x25, 0x8d, 0xfa, 0x5d, 0x5d, 0x1e, 0x7f, 0x3a, 0xb3, 0xdc, 0xc7, 0xee, 0xb2,
-	0x10, 0xb9, 0x9b, 0xe3, 0x0f, 0x57, 0xf7, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x49, 0xb3, 0x10,
-	0x28, 0x51, 0x27, 0x00, 0x00,
+	// 3550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0x23, 0x49,
+	0x56, 0xd6, 0xb7, 0xf4, 0x24, 0xd9, 0x72, 0xb6, 0x67, 0x56, 0xa3, 0x99, 0xe9, 0x8f, 0xea, 0x99,
+	0x1e, 0x33, 0xc3, 0xca, 0x1e, 0xf7, 0x74, 0xcf, 0xb8, 0x7b, 0x97, 0xc5, 0xb2, 0xb5, 0x6d, 0x83,
+	0xdb, 0xd6, 0x94, 0xd4, 0xbb, 0xc4, 0xc4, 0x12, 0x8a, 0xb2, 0x2a, 0x2d, 0xd7, 0xba, 0x54, 0x59,
+	0x5d, 0x95, 0x6a, 0xb7, 0xfe, 0x00, 0x41, 0x40, 0x10, 0x10, 0x04, 0xdc, 0x88, 0xe0, 0x0c, 0x77,
+	0xb8, 0x70, 0xe3, 0xca, 0x95, 0x08, 0x22, 0xb8, 0xec, 0x89, 0xd3, 0x06, 0x27, 0x38, 0x71, 0x22,
+	0x5e, 0x7e, 0x54, 0x95, 0x2c, 0xc9, 0x1f, 0xbd, 0x73, 0x80, 0x93, 0xf2, 0xbd, 0x7c, 0xef, 0xe5,
+	0xcb, 0x97, 0xef, 0x2b, 0x53, 0x05, 0x15, 0x7f, 0x7c, 0xe2, 0x3a, 0x83, 0xa6, 0x1f, 0x30, 0xce,
+	0xc8, 0x8a, 0xeb, 0x78, 0xe7, 0x34, 0xb0, 0xb7, 0x9a, 0x12, 0xdd, 0xb8, 0x3b, 0x64, 0x6c, 0xe8,
+	0xd2, 0x0d, 0x31, 0x7d, 0x32, 0x3e, 0xdd, 0xb0, 0xc7, 0x81, 0xc5, 0x1d, 0xe6, 0x49, 0x86, 0x46,
+	0x7d, 0xc0, 0x46, 0x23, 0xe6, 0x6d, 0x9c, 0x51, 0xcb, 0xe5, 0x67, 0x83, 0x33, 0x3a, 0x38, 0x57,
+	0x33, 0x77, 0x06, 0xcc, 0x3b, 0x75, 0x86, 0x1b, 0xf2, 0x47, 0x22, 0x8d, 0x02, 0xe4, 0xda, 0x23,
+	0x9f, 0x4f, 0x8c, 0xd7, 0x50, 0xfe, 0x19, 0x0d, 0x42, 0x87, 0x79, 0x07, 0xde, 0x29, 0x23, 0x1f,
+	0x41, 0x69, 0xc8, 0x14, 0xa2, 0x9e, 0xba, 0x9f, 0x5a, 0x2f, 0x99, 0x31, 0x02, 0x67, 0x4f, 0xc6,
+	0x8e, 0x6b, 0xef, 0x59, 0x9c, 0xd6, 0xd3, 0x72, 0x36, 0x42, 0x90, 0x47, 0xb0, 0x1c, 0x50, 0x97,
+	0x5a, 0x21, 0xd5, 0x02, 0x32, 0x82, 0xe4, 0x12, 0xd6, 0x78, 0x0c, 0x77, 0x0e, 0x9d, 0x90, 0x77,
+	0x69, 0xf0, 0xc6, 0x19, 0xd0, 0xd0, 0xa4, 0xaf, 0xc7, 0x34, 0xe4, 0x28, 0xdc, 0xb3, 0x46, 0x34,
+	0xf4, 0xad, 0x01, 0xd5, 0x4b, 0x47, 0x08, 0xe3, 0x10, 0xd6, 0xa6, 0x99, 0x42, 0x9f, 0x79, 0x21,
+	0x25, 0x5f, 0x41, 0x31, 0x54, 0xb8, 0x7a, 0xea, 0x7e, 0x66, 0xbd, 0xbc, 0x55, 0x6f, 0x5e, 0xb2,
+	0x5d, 0x53, 0x31, 0x99, 0x11, 0xa5, 0xf1, 0x1c, 0x0a, 0x0a, 0x49, 0x08, 0x64, 0x71, 0x15, 0xb5,
+	0xa2, 0x18, 0x4f, 0xab, 0x92, 0xbe, 0xac, 0x4a, 0x08, 0x2b, 0xa8, 0x4a, 0x87, 0xd9, 0x91, 0xee,
+	0xf7, 0x67, 0x74, 0x6f, 0xa5, 0xeb, 0xa9, 0x04, 0x13, 0xf9, 0x1d, 0xd4, 0xd3, 0xa5, 0x03, 0xce,
+	0x02, 0x21, 0xb1, 0xbc, 0x65, 0xcc, 0xe8, 0x69, 0xd2, 0x90, 0x8d, 0x83, 0x01, 0xed, 0x0a, 0x42,
+	0x87, 0x79, 0x66, 0xc4, 0x63, 0xfc, 0x08, 0x6a, 0xf1, 0xa2, 0x6a, 0xef, 0xeb, 0x90, 0xf5, 0x99,
+	0xad, 0xf7, 0xbd, 0x36, 0x23, 0xaf, 0xc3, 0x6c, 0x53, 0x50, 0x18, 0xff, 0x93, 0x85, 0x4c, 0x87,
+	0xd9, 0x73, 0x37, 0xbb, 0x06, 0x39, 0x9f, 0xd9, 0x07, 0x1d, 0xb5, 0x51, 0x09, 0x90, 0xfb, 0x00,
+	0x36, 0xf5, 0x5d, 0x36, 0x19, 0x51, 0x8f, 0xcb, 0x83, 0xdc, 0x5f, 0x32, 0x13, 0x38, 0xf2, 0x00,
+	0xca, 0x01, 0xf5, 0x5d, 0x67, 0x60, 0xf5, 0x43, 0xca, 0xeb, 0xa0, 0x49, 0x14, 0xb2, 0x4b, 0x39,
+	0xf9, 0x1a, 0xde, 0x57, 0x10, 0xee, 0xa6, 0x3f, 0x60, 0x1e, 0x0f, 0x98, 0xeb, 0xd2, 0xa0, 0x5e,
+	0x56, 0xd4, 0xef, 0x25, 0xe6, 0x77, 0xa3, 0x69, 0xf2, 0x10, 0x2a, 0x21, 0xb7, 0x38, 0x3d, 0x1d,
+	0xbb, 0x42, 0x78, 0x45, 0x91, 0x97, 0x35, 0x16, 0xa5, 0xdf, 0x03, 0xb0, 0x2d, 0x3a, 0x62, 0x9e,
+	0x20, 0xa9, 0x2a, 0x92, 0x92, 0xc4, 0x21, 0x01, 0x81, 0xcc, 0x2f, 0xd9, 0x49, 0x7d, 0x59, 0xcd,
+	0x20, 0x40, 0xde, 0x87, 0x3c, 0xca, 0x18, 0x87, 0xf5, 0xac, 0xd8, 0xae, 0x82, 0xd0, 0x0a, 0x96,
+	0x6d, 0x53, 0xbb, 0x9e, 0xbb, 0x9f, 0x5a, 0x2f, 0x9a, 0x12, 0x20, 0xbb, 0xb0, 0x12, 0x3a, 0xde,
+	0x80, 0x1e, 0x5a, 0x21, 0x37, 0xa9, 0xcf, 0x02, 0x5e, 0xcf, 0x8b, 0xc3, 0xfb, 0xa0, 0x29, 0xe3,
+	0xb1, 0xa9, 0xe3, 0xb1, 0xb9, 0xa7, 0xe2, 0xd1, 0xbc, 0xcc, 0x41, 0x36, 0xe1, 0x4e, 0xbc, 0xf3,
+	0xa3, 0xc8, 0x4d, 0x0a, 0x62, 0xfd, 0x79, 0x53, 0xc4, 0x80, 0x8a, 0x42, 0x77, 0x5c, 0xcb, 0xa3,
+	0xf5, 0xa2, 0xd0, 0x69, 0x0a, 0x47, 0xbe, 0x84, 0xfc, 0xd8, 0xe7, 0xce, 0x88, 0xd6, 0x4b, 0xd7,
+	0x69, 0xa4, 0x08, 0xc9, 0x5d, 0x00, 0x3f, 0x60, 0x6f, 0x27, 0x26, 0xb5, 0xec, 0x49, 0x7d, 0x45,
+	0x08, 0x4d, 0x60, 0x70, 0x59, 0x01, 0xe9, 0xf0, 0xad, 0x09, 0x0d, 0xa7, 0x70, 0x64, 0x1d, 0x56,
+	0x02, 0xe5, 0xa6, 0x9a, 0x6c, 0x55, 0x90, 0x5d, 0x46, 0xb7, 0x0a, 0x90, 0x63, 0x17, 0x1e, 0x0d,
+	0x8c, 0xbf, 0x4f, 0x03, 0xf4, 0x2c, 0x5f, 0xc7, 0x0a, 0x81, 0x8c, 0xcf, 0xec, 0x7a, 0x4a, 0x9f,
+	0x8a, 0xcf, 0xec, 0x4b, 0xde, 0x96, 0x9e, 0xe3, 0x6d, 0xef, 0x43, 0x7e, 0x64, 0xbd, 0x35, 0xfd,
+	0x50, 0xf8, 0x62, 0xda, 0x54, 0x10, 0xe2, 0x39, 0xeb, 0xe0, 0xc1, 0xe0, 0x79, 0x56, 0x4d, 0x05,
+	0xa1, 0xa7, 0x73, 0x76, 0xd0, 0x11, 0xc7, 0x59, 0x32, 0xc5, 0x98, 0x34, 0xa0, 0x78, 0x1a, 0xb0,
+	0x51, 0x47, 0x1f, 0x63, 0xd5, 0x8c, 0x60, 0x94, 0x83, 0xe3, 0x83, 0x8e, 0x3a, 0x17, 0x05, 0x21,
+	0x3e, 0x1c, 0x9c, 0xd1, 0x91, 0x3c, 0x84, 0x92, 0xa9, 0x20, 0xa1, 0x0f, 0xe5, 0x67, 0xcc, 0x16,
+	0xe6, 0x2f, 0x99, 0x0a, 0xc2, 0xd4, 0x61, 0x8d, 0xf9, 0x19, 0x0b, 0x1c, 0x3e, 0x91, 0x31, 0x61,
+	0xc6, 0x08, 0xd4, 0xca, 0xb7, 0xf8, 0x99, 0x74, 0x7f, 0x53, 0x8c, 0x9f, 0xa5, 0xeb, 0xa9, 0x56,
+	0x11, 0xf2, 0xdc, 0x0a, 0x86, 0x94, 0x1b, 0x7f, 0x54, 0x84, 0xb5, 0x9e, 0xe5, 0xb7, 0x26, 0x3a,
+	0x19, 0x68, 0xb3, 0x3d, 0xd3, 0x24, 0xf5, 0xd4, 0x8d, 0xd3, 0x87, 0xe2, 0x20, 0x3b, 0x90, 0x1b,
+	0x59, 0x7c, 0x70, 0xa6, 0x32, 0xcf, 0x17, 0x33, 0xac, 0xf3, 0x56, 0x6c, 0xbe, 0x44, 0x16, 0x53,
+	0x72, 0x2e, 0xb4, 0xff, 0x0b, 0x28, 0xd0, 0xb7, 0x3c, 0xb0, 0x06, 0xf2, 0x00, 0xca, 0x5b, 0x3f,
+	0xbc, 0x99, 0xf0, 0xb6, 0x64, 0x32, 0x35, 0x77, 0xe3, 0x1f, 0xb3, 0x90, 0x13, 0x2b, 0x92, 0x5d,
+	0xc8, 0x58, 0xae, 0xab, 0xb6, 0xb9, 0x71, 0x0b, 0x5d, 0x9b, 0x5d, 0xfa, 0x1a, 0x3d, 0xca, 0x72,
+	0x5d, 0x21, 0xc4, 0x9b, 0xd4, 0xd3, 0xef, 0x2e, 0xc4, 0x9b, 0x90, 0x9f, 0x40, 0xc6, 0x63, 0x32,
+	0xfb, 0xdd, 0xce, 0x6a, 0x28, 0xc0, 0x63, 0x9c, 0xec, 0x43, 0xc5, 0xa6, 0x21, 0x77, 0x3c, 0x11,
+	0x88, 0x61, 0x3d, 0x7b, 0xd3, 0xa3, 0xdb, 0x5f, 0x32, 0xa7, 0x38, 0xc9, 0x4f, 0x21, 0x7b, 0xc6,
+	0xb9, 0x2f, 0xfc, 0xb9, 0xbc, 0xb5, 0x79, 0x9b, 0x0d, 0xed, 0x73, 0xee, 0xef, 0x2f, 0x99, 0x82,
+	0xbf, 0x71, 0x08, 0x99, 0x2e, 0x7d, 0x4d, 0xda, 0x50, 0x10, 0xe7, 0x1a, 0x55, 0xcd, 0x5b, 0xf9,
+	0x84, 0xe6, 0x6d, 0x4c, 0x20, 0x8b, 0xd2, 0x49, 0x3d, 0x8a, 0x12, 0x1d, 0xd6, 0x0a, 0xc6, 0x19,
+	0x15, 0x27, 0x3a, 0xaa, 0x15, 0x4c, 0xee, 0x26, 0x23, 0x45, 0x17, 0x98, 0x18, 0x45, 0xd6, 0x54,
+	0xac, 0x64, 0xd5, 0x94, 0x80, 0x30, 0xab, 0x88, 0xc5, 0xa3, 0x41, 0xe3, 0x5f, 0x53, 0x50, 0x50,
+	0xde, 0x44, 0xf6, 0x95, 0x95, 0xa4, 0xef, 0x6c, 0xdd, 0xca, 0x15, 0xa7, 0xed, 0xc4, 0xd5, 0xce,
+	0x7e, 0x06, 0x85, 0x33, 0x6a, 0xd9, 0x34, 0x08, 0x95, 0xd0, 0x67, 0xb7, 0x17, 0xda, 0xdc, 0x97,
+	0x12, 0xf6, 0x97, 0x4c, 0x2d, 0xac, 0x51, 0x82, 0x82, 0xc2, 0xb6, 0x4a, 0x51, 0x08, 0x25, 0x86,
+	0xc6, 0x7f, 0xa7, 0x00, 0x90, 0xf9, 0xa5, 0xb4, 0xd6, 0x3e, 0x40, 0x40, 0x87, 0x4e, 0xc8, 0x69,
+	0x40, 0x65, 0xf2, 0x5c, 0xde, 0x7a, 0x34, 0xa3, 0x4a, 0xcc, 0xd0, 0x34, 0x23, 0x6a, 0x59, 0x94,
+	0x35, 0x44, 0x3e, 0x81, 0xca, 0xd8, 0x4b, 0xc8, 0xd2, 0xe7, 0x32, 0x85, 0x35, 0x3c, 0x80, 0x58,
+	0x02, 0x29, 0x40, 0xe6, 0x45, 0xbb, 0x57, 0x5b, 0x22, 0x45, 0xc8, 0x76, 0x8e, 0xbb, 0xbd, 0x5a,
+	0x0a, 0x51, 0x9d, 0x57, 0xbd, 0x5a, 0x9a, 0x00, 0xe4, 0xf7, 0xda, 0x87, 0xed, 0x5e, 0xbb, 0x96,
+	0x21, 0x25, 0xc8, 0x75, 0x76, 0x7a, 0xbb, 0xfb, 0xb5, 0x2c, 0x29, 0x43, 0xe1, 0xb8, 0xd3, 0x3b,
+	0x38, 0x3e, 0xea, 0xd6, 0x72, 0x08, 0xec, 0x1e, 0x1f, 0x1d, 0xb5, 0x77, 0x7b, 0xb5, 0x3c, 0xca,
+	0xd8, 0x6f, 0xef, 0xec, 0xd5, 0x0a, 0x48, 0xde, 0x33, 0x77, 0x76, 0xdb, 0xb5, 0x62, 0x2b, 0x0f,
+	0x59, 0x3e, 0xf1, 0xa9, 0xf1, 0xb7, 0x29, 0xc8, 0x77, 0xa5, 0xeb, 0xec, 0xcd, 0xd9, 0xf2, 0x6c,
+	0xe8, 0x48, 0xe2, 0xdf, 0x74, 0xbb, 0x0f, 0xa6, 0xb6, 0x8b, 0x1a, 0xf6, 0x7a, 0x9d, 0xda, 0x12,
+	0x6a, 0x88, 0xa3, 0x6e, 0x2d, 0x15, 0x69, 0xf8, 0x77, 0xa9, 0xe8, 0xe8, 0xc8, 0x76, 0xd2, 0x3b,
+	0x30, 0x8c, 0xee, 0xcd, 0x1e, 0x89, 0x9c, 0x57, 0xbf, 0xb1, 0x03, 0x0c, 0x20, 0x2f, 0x51, 0x73,
+	0x9b, 0xb2, 0x8f, 0xa1, 0xf4, 0xc6, 0x72, 0xc7, 0xb4, 0x1f, 0xf2, 0x20, 0x52, 0xb9, 0x28, 0x50,
+	0x5d, 0x1e, 0xc4, 0xd3, 0x27, 0x8e, 0xec, 0xb2, 0x2b, 0xd1, 0x74, 0xcb, 0x11, 0xa5, 0x57, 0x8c,
+	0x8d, 0x1e, 0x94, 0x0e, 0x3a, 0x3b, 0xb6, 0x1d, 0xd0, 0x10, 0x5b, 0x9c, 0xac, 0xe3, 0xbf, 0xf9,
+	0x4a, 0xac, 0x53, 0x40, 0x47, 0x47, 0x88, 0x7c, 0x21, 0xb0, 0x4f, 0x55, 0xa6, 0x7c, 0x6f, 0x46,
+	0xff, 0x83, 0xce, 0x9b, 0xa7, 0x8a, 0xf8, 0x69, 0x2b, 0x0b, 0x69, 0xc7, 0x37, 0x36, 0x21, 0x8b,
+	0x58, 0xec, 0x99, 0x4e, 0x9d, 0x20, 0x94, 0x15, 0x29, 0x6f, 0x4a, 0x00, 0xb7, 0xe3, 0x5a, 0xa1,
+	0xac, 0xe2, 0x79, 0x53, 0x8c, 0x8d, 0x43, 0x80, 0xde, 0xc0, 0xd7, 0x8a, 0x7c, 0x8e, 0x52, 0x54,
+	0x38, 0x35, 0xe6, 0x2c, 0xa8, 0xe8, 0xcc, 0xb4, 0xe3, 0x8b, 0x8a, 0xc9, 0x02, 0x29, 0xad, 0x6a,
+	0x8a, 0xb1, 0x61, 0x43, 0xa6, 0xcd, 0x50, 0x4c, 0x6d, 0x18, 0xf8, 0x83, 0xbe, 0xec, 0xe0, 0xfa,
+	0x03, 0x66, 0x4b, 0x1b, 0x56, 0xf7, 0x97, 0xcc, 0x65, 0x9c, 0xe9, 0x8a, 0x89, 0x5d, 0x66, 0x53,
+	0xa4, 0x0d, 0x68, 0x48, 0x79, 0x9f, 0x06, 0x01, 0x0b, 0x24, 0x6d, 0x5a, 0xd3, 0x8a, 0x99, 0x36,
+	0x4e, 0x20, 0x6d, 0x2b, 0x07, 0x19, 0xea, 0xd9, 0xc6, 0x7f, 0xad, 0x40, 0xb1, 0x67, 0xf9, 0xed,
+	0x37, 0xd8, 0x7e, 0x3c, 0x86, 0xbc, 0x8c, 0x6f, 0xa5, 0xf6, 0x87, 0xb3, 0x59, 0x20, 0xda, 0x9f,
+	0xa9, 0x48, 0xc9, 0x0b, 0x28, 0xcb, 0x51, 0x7f, 0x44, 0xb9, 0xa5, 0x52, 0xf7, 0xa3, 0x79, 0xf9,
+	0x43, 0x2c, 0xd2, 0x6c, 0x7b, 0xb6, 0xcf, 0x1c, 0x8f, 0xbf, 0xa4, 0xdc, 0x32, 0x41, 0xb2, 0xe2,
+	0x98, 0xfc, 0x18, 0xca, 0x89, 0x62, 0x50, 0x4f, 0x5f, 0xaf, 0x42, 0x92, 0x9e, 0x7c, 0x0b, 0xb5,
+	0x04, 0x28, 0x95, 0xc9, 0xde, 0x4a, 0x99, 0x95, 0x04, 0xbf, 0xd0, 0xa8, 0x05, 0x10, 0xb0, 0x31,
+	0x57, 0x3b, 0x2b, 0x08, 0x61, 0x0f, 0x17, 0x0b, 0x33, 0x91, 0x56, 0x48, 0x2a, 0x05, 0x7a, 0x48,
+	0xbe, 0x85, 0x15, 0xd1, 0x5a, 0xf6, 0x6d, 0x27, 0x90, 0x55, 0x4f, 0x74, 0x65, 0xcb, 0x5b, 0xeb,
+	0x8b, 0x05, 0x75, 0x90, 0x61, 0x4f, 0xd3, 0x9b, 0xcb, 0xfe, 0x14, 0x4c, 0xbe, 0x52, 0xf9, 0x5f,
+	0x56, 0xec, 0xbb, 0x8b, 0xe5, 0x4c, 0xe5, 0xfa, 0xbf, 0x4e, 0x41, 0x25, 0xb9, 0x5d, 0xf2, 0x7b,
+	0x90, 0x77, 0xad, 0x13, 0xea, 0xea, 0xa8, 0xde, 0xba, 0x99, 0x99, 0x9a, 0x87, 0x82, 0xa9, 0xed,
+	0xf1, 0x60, 0x62, 0x2a, 0x09, 0x8d, 0x6d, 0x28, 0x27, 0xd0, 0xa4, 0x06, 0x99, 0x73, 0x3a, 0x51,
+	0xb1, 0x8e, 0x43, 0xb2, 0xa6, 0x82, 0x55, 0xdf, 0xbf, 0x04, 0xf0, 0x2c, 0xfd, 0x4d, 0xaa, 0xf1,
+	0xe7, 0x29, 0x28, 0x45, 0x96, 0x23, 0x2f, 0x2e, 0x29, 0xb5, 0x71, 0x03, 0x73, 0x7f, 0xdf, 0x1a,
+	0xfd, 0x4d, 0x49, 0x95, 0xc5, 0x63, 0xa8, 0x04, 0xb2, 0xd2, 0xf5, 0x1d, 0xcf, 0xd1, 0x3d, 0xe9,
+	0xe7, 0x57, 0x1b, 0xbc, 0xa9, 0x8a, 0xe3, 0x81, 0xe7, 0x70, 0xbc, 0xcc, 0x05, 0x31, 0x48, 0x4c,
+	0xa8, 0x06, 0xea, 0x5e, 0x2b, 0x25, 0x5e, 0xd1, 0xaa, 0x4e, 0x49, 0x94, 0x3c, 0x4a, 0x64, 0x25,
+	0x48, 0xc0, 0x52, 0x49, 0x25, 0x93, 0x7a, 0x76, 0x3d, 0x73, 0x43, 0x25, 0x25, 0x4b, 0xdb, 0xb3,
+	0xa5, 0x92, 0x11, 0xd8, 0x78, 0x0a, 0xc5, 0x2e, 0x0f, 0xa8, 0x35, 0x3a, 0x10, 0x57, 0xe9, 0x13,
+	0x2b, 0x54, 0x19, 0xc7, 0x14, 0x63, 0x79, 0xb9, 0xc4, 0x79, 0xa1, 0x7d, 0xd6, 0x54, 0x50, 0xe3,
+	0x2f, 0xd3, 0x50, 0x4e, 0xec, 0x9d, 0x7c, 0x0d, 0x69, 0xc7, 0x56, 0x36, 0xfb, 0xec, 0x1a, 0x75,
+	0xf4, 0x82, 0x66, 0xda, 0xb1, 0x31, 0x0d, 0x25, 0xba, 0xa9, 0x79, 0x39, 0x20, 0xee, 0x00, 0xa2,
+	0x46, 0x6b, 0x23, 0x6a, 0xce, 0xa4, 0x01, 0x7e, 0xb0, 0xa0, 0x86, 0x46, 0x3d, 0xdb, 0xd4, 0x1d,
+	0x26, 0xbb, 0xe8, 0x0e, 0x93, 0x8b, 0xef, 0x30, 0x64, 0x2b, 0xae, 0x83, 0xf2, 0x7e, 0x5c, 0x5f,
+	0x54, 0x07, 0xe3, 0x02, 0xf8, 0x1f, 0x29, 0xa8, 0x24, 0x8f, 0xef, 0xdd, 0xad, 0xf2, 0x02, 0x88,
+	0xb8, 0x73, 0xf7, 0xa7, 0x5c, 0x32, 0x7d, 0xdd, 0xb5, 0xb8, 0x26, 0x98, 0x92, 0xe7, 0x72, 0x0f,
+	0xca, 0x98, 0x10, 0x54, 0x45, 0x11, 0xe6, 0xaa, 0x9a, 0x80, 0x28, 0x59, 0x4a, 0x92, 0xfb, 0xcc,
+	0xde, 0x74, 0x9f, 0xbf, 0x12, 0x87, 0x1f, 0x39, 0xd1, 0xff, 0x81, 0x6d, 0x1e, 0xc0, 0x1d, 0x2d,
+	0x28, 0x19, 0x71, 0x99, 0xeb, 0x24, 0xad, 0x2a, 0x49, 0x89, 0x33, 0xfb, 0x14, 0xdf, 0xfc, 0x94,
+	0x90, 0x93, 0x09, 0xa7, 0xd2, 0x2e, 0x59, 0x33, 0x0a, 0xe6, 0x16, 0x22, 0xc9, 0x23, 0xc8, 0x50,
+	0x16, 0xaa, 0x0a, 0x38, 0xfb, 0x50, 0xd5, 0x66, 0xa1, 0x89, 0x04, 0xf8, 0x9a, 0xc7, 0x03, 0xcb,
+	0x71, 0x6f, 0xe2, 0x48, 0x11, 0x25, 0xb6, 0x3b, 0x14, 0x6d, 0x66, 0x7c, 0x03, 0xcb, 0xd3, 0x05,
+	0x02, 0x1b, 0xcf, 0x57, 0x47, 0xbf, 0x7f, 0x74, 0xfc, 0xf3, 0xa3, 0xda, 0x12, 0x02, 0x07, 0x47,
+	0xad, 0xe3, 0x57, 0x47, 0x7b, 0xb5, 0x14, 0xa9, 0x40, 0xf1, 0xf8, 0x55, 0x4f, 0x42, 0xe9, 0x58,
+	0xc4, 0x7d, 0x28, 0xee, 0xf8, 0x8e, 0x68, 0x06, 0x30, 0x0f, 0x8a, 0x76, 0x41, 0xe5, 0x46, 0x09,
+	0xe0, 0x73, 0x46, 0xa9, 0xc3, 0x6c, 0x41, 0x12, 0x92, 0xe7, 0x90, 0x17, 0x68, 0x9d, 0x95, 0x1f,
+	0xce, 0x7b, 0x85, 0x93, 0xb4, 0xd1, 0xc8, 0x54, 0x2c, 0x8d, 0x5f, 0xa5, 0xa0, 0xa8, 0x91, 0xc4,
+	0x84, 0x12, 0x3e, 0xf0, 0x58, 0x8e, 0x47, 0x83, 0x85, 0x17, 0x98, 0x59, 0x61, 0xcd, 0x5d, 0xcd,
+	0x24, 0x40, 0xbc, 0x43, 0x45, 0x62, 0x1a, 0x6f, 0x60, 0x79, 0x7a, 0x9a, 0xd4, 0xa1, 0x30, 0xa2,
+	0x61, 0x68, 0x0d, 0x75, 0xbf, 0xa9, 0x41, 0x8c, 0xfa, 0x78, 0x7d, 0xf5, 0xe8, 0x19, 0x21, 0xd0,
+	0x16, 0xce, 0x08, 0xb9, 0xe4, 0x9b, 0xae, 0x04, 0x30, 0xe1, 0x05, 0xd4, 0x0a, 0x99, 0xa7, 0x5f,
+	0xd3, 0x24, 0x24, 0xcc, 0x29, 0x8c, 0xd5, 0x81, 0xa2, 0xbe, 0x19, 0x5d, 0xfd, 0xc0, 0x2b, 0x1e,
+	0x6c, 0x26, 0xbe, 0xae, 0x39, 0x62, 0x1c, 0x75, 0xc6, 0x99, 0xb8, 0x33, 0x36, 0x5e, 0xc3, 0xea,
+	0xcc, 0x6d, 0x99, 0x3c, 0x81, 0xa2, 0x7e, 0x7e, 0x52, 0xa6, 0xfb, 0x60, 0xe1, 0x1d, 0xdb, 0x8c,
+	0x48, 0xd1, 0x7b, 0x45, 0x4d, 0xec, 0x4f, 0x3d, 0xcd, 0x96, 0xcc, 0xaa, 0xc0, 0x76, 0x15, 0xd2,
+	0xf8, 0x05, 0x54, 0x35, 0xb3, 0x34, 0xe2, 0x3b, 0x2e, 0x17, 0xf9, 0x53, 0x3a, 0xe9, 0x4f, 0xbf,
+	0x4e, 0x03, 0xc1, 0xf4, 0xd2, 0x1d, 0x8f, 0x46, 0x56, 0x30, 0xd1, 0xef, 0x3d, 0xc9, 0x07, 0xe3,
+	0xd4, 0xed, 0x1f, 0x8c, 0x31, 0x97, 0xe1, 0xa3, 0x5f, 0xff, 0xc2, 0xf1, 0x6c, 0x76, 0xa1, 0x96,
+	0x04, 0x44, 0xfd, 0x5c, 0x60, 0xc8, 0x6f, 0x43, 0xd6, 0x63, 0x9e, 0x2e, 0x0a, 0xef, 0xcf, 0x06,
+	0x25, 0xfe, 0x3f, 0x80, 0x3d, 0x12, 0x52, 0x91, 0x1f, 0x41, 0x99, 0xb3, 0x7e, 0xb4, 0xeb, 0xec,
+	0x35, 0xbb, 0xc6, 0x4b, 0x18, 0x67, 0x1a, 0x22, 0xbf, 0x0b, 0x55, 0x7c, 0x4f, 0x8b, 0xf9, 0x73,
+	0xd7, 0xf3, 0x57, 0x90, 0x23, 0x92, 0xf0, 0x31, 0x40, 0x78, 0xee, 0xc8, 0xd4, 0x2c, 0x73, 0x43,
+	0xd1, 0x2c, 0x21, 0x06, 0x4d, 0x17, 0x92, 0x0f, 0xa1, 0xc4, 0x07, 0x7a, 0xb6, 0x20, 0x66, 0x8b,
+	0x7c, 0x20, 0x27, 0x5b, 0x00, 0x45, 0x36, 0xe6, 0x27, 0x6c, 0xec, 0xd9, 0xc6, 0xbf, 0xa5, 0xe0,
+	0xce, 0x94, 0xb5, 0xd5, 0x5b, 0xfa, 0x36, 0xa4, 0xd9, 0xf9, 0xc2, 0xac, 0x3c, 0x87, 0xa3, 0x79,
+	0x7c, 0xbe, 0xbf, 0x64, 0xa6, 0xd9, 0x39, 0x79, 0x9a, 0x3c, 0xd6, 0x79, 0x5d, 0xe7, 0x94, 0xf3,
+	0xec, 0x2f, 0xa9, 0x83, 0x6f, 0xec, 0x40, 0xfa, 0xf8, 0x9c, 0x3c, 0x07, 0xf1, 0xa8, 0xdd, 0xe7,
+	0xd6, 0x89, 0x1b, 0xbd, 0xc6, 0x34, 0xe6, 0x6a, 0xd0, 0x43, 0x12, 0x13, 0x42, 0x3d, 0x14, 0x3b,
+	0xd3, 0x89, 0xd6, 0xf8, 0xe7, 0x0c, 0x40, 0xcb, 0x0a, 0x9d, 0x81, 0xb4, 0xc8, 0x43, 0xa8, 0x86,
+	0xe3, 0xc1, 0x80, 0x86, 0x78, 0x33, 0x1a, 0x7b, 0xb2, 0x45, 0xcb, 0x9a, 0x15, 0x85, 0xdc, 0x45,
+	0x1c, 0x12, 0x9d, 0x5a, 0x8e, 0x3b, 0x0e, 0xa8, 0x22, 0x92, 0x7d, 0x4b, 0x45, 0x21, 0x25, 0xd1,
+	0x27, 0x18, 0x25, 0x9c, 0x7a, 0x83, 0x49, 0x7f, 0x14, 0xf6, 0xfd, 0x27, 0x9b, 0xc2, 0x65, 0xb2,
+	0x66, 0x45, 0x61, 0x5f, 0x86, 0x9d, 0x27, 0x9b, 0x97, 0xa9, 0xb6, 0x9f, 0xd4, 0xb3, 0x97, 0xa9,
+	0xb6, 0x9f, 0xcc, 0x50, 0x6d, 0xd7, 0x73, 0x33, 0x54, 0xdb, 0x64, 0x13, 0xd6, 0xac, 0x01, 0x1f,
+	0x5b, 0x6e, 0x7f, 0x7a, 0x0b, 0x79, 0x41, 0x4b, 0xe4, 0x5c, 0x37, 0xb9, 0x91, 0x98, 0x63, 0x7a,
+	0x3f, 0x85, 0x24, 0xc7, 0x4f, 0x93, 0xbb, 0xba, 0x87, 0x7f, 0x5f, 0xf0, 0x60, 0xa2, 0x08, 0x8b,
+	0x82, 0x10, 0x04, 0x4a, 0x12, 0xfc, 0x04, 0x3e, 0x92, 0x04, 0x27, 0x63, 0x7b, 0x88, 0x37, 0xc7,
+	0xb7, 0x67, 0xd6, 0x38, 0xe4, 0xd4, 0x56, 0x1c, 0x25, 0xc1, 0xf1, 0x81, 0xa0, 0x69, 0x09, 0x92,
+	0xb6, 0xa6, 0x90, 0x02, 0xb6, 0xe0, 0xbd, 0xa1, 0xc5, 0xe9, 0x85, 0x35, 0xe9, 0x63, 0xd8, 0xb1,
+	0x31, 0x57, 0x9c, 0x20, 0x38, 0xef, 0xa8, 0xc9, 0x9e, 0x9c, 0x13, 0x3c, 0xc6, 0x9f, 0xa6, 0xa0,
+	0xd8, 0x53, 0x7e, 0x4b, 0x7e, 0x0b, 0x6a, 0xcc, 0xa7, 0xe2, 0x7f, 0x13, 0x4f, 0xc6, 0x77, 0xa8,
+	0x4e, 0x71, 0x05, 0xf1, 0xbb, 0x31, 0x9a, 0xac, 0xe3, 0xfd, 0xd6, 0xb2, 0x65, 0x0d, 0xee, 0x73,
+	0xc6, 0x2d, 0x57, 0x9d, 0xe5, 0x32, 0xe2, 0x45, 0x15, 0xee, 0x21, 0x96, 0x7c, 0x0e, 0xab, 0x17,
+	0x81, 0xc3, 0xe9, 0x14, 0xa9, 0x3c, 0xd0, 0x15, 0x31, 0x11, 0xd3, 0x1a, 0x5d, 0x58, 0xed, 0x05,
+	0xd6, 0xe9, 0xa9, 0x33, 0xe8, 0xfa, 0xae, 0xc3, 0xa5, 0x56, 0x04, 0xb2, 0x96, 0x4f, 0xdf, 0xea,
+	0x44, 0x8d, 0x63, 0xc4, 0xb9, 0xd4, 0x3a, 0xd5, 0x89, 0x1a, 0xc7, 0x58, 0x1b, 0x2e, 0xa8, 0x33,
+	0x3c, 0xe3, 0xba, 0x36, 0x48, 0xc8, 0xf8, 0x87, 0x3c, 0x94, 0x22, 0x6f, 0x26, 0x2d, 0x28, 0xf9,
+	0xcc, 0xee, 0x0f, 0x03, 0x36, 0xd6, 0x4f, 0x02, 0x0f, 0x17, 0x3b, 0x3f, 0x56, 0xbd, 0x17, 0x48,
+	0x8a, 0xcf, 0x1d, 0xbe, 0x1a, 0x37, 0xfe, 0x3d, 0x27, 0xca, 0xa8, 0x00, 0xc8, 0x73, 0xc8, 0x06,
+	0xec, 0x42, 0x07, 0xd2, 0x67, 0x37, 0x90, 0xd5, 0x34, 0xd9, 0x85, 0x29, 0x98, 0x1a, 0x7f, 0x91,
+	0x83, 0x8c, 0xc9, 0x2e, 0xde, 0x35, 0xc1, 0x5f, 0x9b, 0x73, 0xe3, 0x7f, 0x9f, 0x4a, 0x53, 0xff,
+	0x3e, 0xad, 0x43, 0x6d, 0x44, 0xc3, 0x33, 0x6a, 0xf7, 0xd1, 0x18, 0xd2, 0x4b, 0xe4, 0x99, 0x2c,
+	0x4b, 0x7c, 0x87, 0x29, 0xa7, 0xfa, 0x1c, 0x56, 0x83, 0xb1, 0xe7, 0x39, 0xde, 0x30, 0x41, 0x2a,
+	0x23, 0x6d, 0x45, 0x4d, 0x44, 0xb4, 0xeb, 0x50, 0xc3, 0x68, 0x98, 0x92, 0x2a, 0x43, 0x68, 0x59,
+	0xe2, 0x23, 0xca, 0x2f, 0x21, 0x27, 0x53, 0x67, 0x6e, 0xc1, 0xb5, 0x22, 0x4e, 0x2c, 0xa6, 0xa4,
+	0x24, 0x4f, 0x93, 0x19, 0xb7, 0xb8, 0xc0, 0x46, 0xda, 0x95, 0xe3, 0x64, 0x4c, 0x7e, 0x0c, 0x45,
+	0x1e, 0x2a, 0x36, 0x58, 0x50, 0xd7, 0x66, 0x9c, 0xce, 0x2c, 0xf0, 0x50, 0xb2, 0xff, 0x02, 0xaa,
+	0xb2, 0x79, 0xea, 0x9f, 0x4c, 0x70, 0x5b, 0xf5, 0x82, 0x38, 0xe7, 0x6f, 0x6e, 0x78, 0xce, 0x4d,
+	0xd9, 0x3d, 0xb5, 0x26, 0xd8, 0x3e, 0x89, 0x5b, 0x71, 0x99, 0xc6, 0x18, 0xf2, 0x04, 0x7e, 0xa0,
+	0x2b, 0x45, 0x9f, 0x79, 0xee, 0x24, 0x61, 0xb8, 0xb2, 0x30, 0xdc, 0x9a, 0x9e, 0x3e, 0xf6, 0xdc,
+	0x89, 0x36, 0x5f, 0xe3, 0x3b, 0xa8, 0x5d, 0x96, 0x3b, 0xe7, 0x5a, 0xbd, 0x99, 0xbc, 0x56, 0xcf,
+	0xcb, 0xf1, 0x51, 0x73, 0x97, 0xb8, 0x72, 0x63, 0x2b, 0x25, 0x4a, 0x83, 0x71, 0x04, 0x95, 0xb6,
+	0x3d, 0xa4, 0xe1, 0xf7, 0xd4, 0x20, 0x18, 0xff, 0x94, 0x82, 0xaa, 0x12, 0xa8, 0x6a, 0xe0, 0xe3,
+	0x44, 0x0d, 0x7c, 0x30, 0xdb, 0x0f, 0x24, 0x69, 0x7f, 0xf3, 0xea, 0xf7, 0xa5, 0xa8, 0x7e, 0x5f,
+	0x40, 0x8e, 0xa2, 0x5c, 0x15, 0xae, 0xef, 0xcd, 0x5d, 0xd5, 0x94, 0x34, 0xd3, 0xd5, 0x2e, 0x05,
+	0x59, 0x9c, 0x23, 0x5f, 0x40, 0x26, 0x0c, 0x06, 0xd7, 0x47, 0x29, 0x52, 0x21, 0xb1, 0x1d, 0xc6,
+	0x77, 0xa6, 0xc5, 0xc4, 0x76, 0xc8, 0xb1, 0xa7, 0x18, 0xb8, 0x0e, 0xf5, 0x78, 0xdf, 0xb1, 0x55,
+	0x66, 0x2b, 0x4a, 0xc4, 0x81, 0x8d, 0x93, 0xf8, 0x35, 0x01, 0x0d, 0x70, 0x52, 0x26, 0xb8, 0xa2,
+	0x44, 0x1c, 0xd8, 0xe4, 0x11, 0xac, 0x78, 0xac, 0xef, 0xd8, 0xd4, 0xe3, 0x0e, 0xc7, 0x4a, 0x37,
+	0x54, 0xb7, 0xe5, 0xaa, 0xc7, 0x0e, 0x14, 0xf6, 0x65, 0x38, 0x34, 0x7e, 0x9d, 0x82, 0x5a, 0x8f,
+	0xf9, 0xe2, 0xb9, 0x26, 0xfc, 0xff, 0xd1, 0xf8, 0x15, 0x6e, 0xd5, 0xf8, 0x4d, 0xb5, 0x5e, 0xff,
+	0x92, 0x82, 0xd5, 0xc4, 0x6e, 0x95, 0xd3, 0xbd, 0xa3, 0xff, 0xe0, 0x35, 0x9a, 0x9d, 0xab, 0x3d,
+	0x7c, 0x3a, 0x9b, 0x41, 0x2e, 0xaf, 0x13, 0x39, 0x6c, 0x63, 0x5b, 0x38, 0xde, 0x63, 0xc8, 0x8b,
+	0x97, 0x48, 0xed, 0x79, 0xb3, 0x29, 0x4f, 0xf0, 0xcb, 0x96, 0x4b, 0x91, 0x4e, 0x39, 0xe0, 0x7f,
+	0xa6, 0x00, 0x62, 0x12, 0xf2, 0x78, 0xaa, 0xec, 0xdc, 0xbb, 0x42, 0x5a, 0x5c, 0x6e, 0xf0, 0x0f,
+	0xe9, 0xc8, 0xb0, 0xf2, 0x9c, 0x22, 0xb8, 0xf1, 0x67, 0x29, 0x59, 0x8a, 0xd6, 0x20, 0x27, 0x56,
+	0xd7, 0x97, 0x50, 0x01, 0x5c, 0x7f, 0xc8, 0x53, 0x6f, 0x38, 0xf9, 0xcb, 0x6f, 0x38, 0xb7, 0xcf,
+	0xf7, 0xc6, 0x3a, 0xd4, 0x0e, 0xc2, 0x70, 0x6c, 0x79, 0x89, 0x4f, 0x76, 0xd6, 0x20, 0xe7, 0x3a,
+	0x23, 0xf5, 0xfc, 0x57, 0x35, 0x25, 0x60, 0x1c, 0xc2, 0x6a, 0x82, 0x52, 0x1d, 0xf3, 0xd7, 0x50,
+	0x72, 0x34, 0x52, 0x19, 0x69, 0xd6, 0x89, 0x34, 0x9b, 0x19, 0xd3, 0x1a, 0x7f, 0x92, 0x86, 0xa2,
+	0xc6, 0xe3, 0xae, 0x70, 0x8f, 0x21, 0xb7, 0x46, 0xbe, 0xbe, 0x42, 0x46, 0x08, 0xf2, 0x43, 0x20,
+	0xea, 0x69, 0x84, 0xda, 0x51, 0xf4, 0x29, 0xdb, 0xac, 0x46, 0x33, 0x3a, 0x00, 0xc9, 0x67, 0xb0,
+	0xa2, 0x3e, 0x08, 0xea, 0x5b, 0x83, 0xb8, 0xe6, 0x96, 0xcc, 0x65, 0x85, 0xde, 0x91, 0x58, 0x51,
+	0xb5, 0x69, 0xe0, 0x58, 0x6e, 0xf4, 0xcd, 0x88, 0x80, 0x30, 0x07, 0x78, 0x8c, 0xf7, 0xad, 0x53,
+	0x4e, 0x03, 0x15, 0xe0, 0x45, 0x8f, 0xf1, 0x1d, 0x84, 0xb1, 0x25, 0x12, 0xff, 0x32, 0x48, 0xdb,
+	0x8b, 0x31, 0x36, 0x74, 0x01, 0xfd, 0xa5, 0x8c, 0xd8, 0xbe, 0xba, 0x38, 0x17, 0xf4, 0xd7, 0x13,
+	0x0a, 0x6f, 0x0a, 0x74, 0x7c, 0x57, 0x2c, 0x26, 0xee, 0x8a, 0x5b, 0x7f, 0x55, 0x80, 0xcc, 0x8e,
+	0xef, 0x90, 0xef, 0xa0, 0x9c, 0xb8, 0x92, 0x90, 0x87, 0x57, 0x5f, 0x58, 0xc4, 0xc6, 0x1b, 0x9f,
+	0xdc, 0xe4, 0x56, 0x63, 0x2c, 0x91, 0x7d, 0xc8, 0x89, 0x54, 0x4f, 0x3e, 0x5e, 0x54, 0x02, 0xa4,
+	0xbc, 0xbb, 0x57, 0x57, 0x08, 0x63, 0x89, 0xf4, 0xa0, 0x14, 0xc5, 0x21, 0x79, 0x70, 0x55, 0x8c,
+	0x4a, 0x89, 0xc6, 0xf5, 0x61, 0x6c, 0x2c, 0x91, 0x6f, 0xa1, 0xa8, 0xbf, 0x84, 0x22, 0xf7, 0x67,
+	0x38, 0x2e, 0x7d, 0x99, 0xd5, 0x78, 0x70, 0x05, 0x45, 0x24, 0xf2, 0x0f, 0xa1, 0x92, 0xfc, 0xb8,
+	0x8c, 0x7c, 0x32, 0x97, 0xe9, 0xd2, 0x07, 0x6b, 0x8d, 0x4f, 0xaf, 0xa1, 0x8a, 0xc4, 0xef, 0x41,
+	0xa6, 0x67, 0xf9, 0xe4, 0xc3, 0x79, 0x8f, 0x7d, 0x5a, 0xd8, 0x07, 0x0b, 0x5f, 0x02, 0x8d, 0xcc,
+	0x1f, 0xa7, 0x53, 0x9b, 0x29, 0xf2, 0x07, 0x50, 0x9d, 0xfa, 0xa7, 0x99, 0x7c, 0x7a, 0xa3, 0x7f,
+	0xa2, 0x6f, 0x20, 0x79, 0x07, 0x0a, 0xfa, 0xf3, 0x9e, 0x05, 0xd5, 0xa0, 0xf1, 0xd1, 0x0c, 0x3e,
+	0xf1, 0xd5, 0xa0, 0xb1, 0x44, 0x5c, 0x28, 0x75, 0xa9, 0x7b, 0xba, 0x8b, 0xdf, 0x1d, 0x92, 0xc4,
+	0x27, 0x20, 0xf2, 0xab, 0xc4, 0x66, 0xf2, 0xab, 0xc4, 0x88, 0x4e, 0x2b, 0xd8, 0xbc, 0x29, 0x79,
+	0x64, 0xd0, 0x6f, 0x20, 0xbf, 0x2b, 0xbe, 0x66, 0x5c, 0xa8, 0xef, 0x5a, 0x52, 0x26, 0x52, 0x36,
+	0x77, 0x5c, 0x57, 0xba, 0x64, 0x94, 0x9b, 0xe6, 0xb8, 0xe4, 0xe5, 0x0c, 0xd7, 0x30, 0xae, 0x22,
+	0xd1, 0xfa, 0xb4, 0x1e, 0x7f, 0xf7, 0xe5, 0xd0, 0xe1, 0x67, 0xe3, 0x13, 0xdc, 0xc0, 0x86, 0xe2,
+	0xd0, 0xbf, 0x5b, 0x1b, 0xf1, 0x27, 0x5e, 0x1b, 0x43, 0xea, 0x6d, 0x48, 0x41, 0x27, 0x79, 0xf1,
+	0xc0, 0xfa, 0xf8, 0x7f, 0x07, 0x00, 0xea, 0xcb, 0xdd, 0x15, 0xf9, 0x29, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4312,6 +4560,9 @@ type ApiClient interface {
 	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionInfo, error)
 	SelfCheck(ctx context.Context, in *healthcheck.SelfCheckRequest, opts ...grpc.CallOption) (*healthcheck.SelfCheckResponse, error)
 	Config(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*config.All, error)
+	// Lists the recent certificate issuances and rejections of the identity
+	// controller.
+	Issuances(ctx context.Context, in *IssuancesRequest, opts ...grpc.CallOption) (*IssuancesResponse, error)
 }
 
 type apiClient struct {
@@ -4460,6 +4711,15 @@ func (c *apiClient) Config(ctx context.Context, in *Empty, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *apiClient) Issuances(ctx context.Context, in *IssuancesRequest, opts ...grpc.CallOption) (*IssuancesResponse, error) {
+	out := new(IssuancesResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/Issuances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServer is the server API for Api service.
 type ApiServer interface {
 	StatSummary(context.Context, *StatSummaryRequest) (*StatSummaryResponse, error)
@@ -4474,6 +4734,9 @@ type ApiServer interface {
 	Version(context.Context, *Empty) (*VersionInfo, error)
 	SelfCheck(context.Context, *healthcheck.SelfCheckRequest) (*healthcheck.SelfCheckResponse, error)
 	Config(context.Context, *Empty) (*config.All, error)
+	// Lists the recent certificate issuances and rejections of the identity
+	// controller.
+	Issuances(context.Context, *IssuancesRequest) (*IssuancesResponse, error)
 }
 
 // UnimplementedApiServer can be embedded to have forward compatible implementations.
@@ -4510,6 +4773,9 @@ func (*UnimplementedApiServer) SelfCheck(ctx context.Context, req *healthcheck.S
 func (*UnimplementedApiServer) Config(ctx context.Context, req *Empty) (*config.All, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedApiServer) Issuances(ctx context.Context, req *IssuancesRequest) (*IssuancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issuances not implemented")
+}
 
 func RegisterApiServer(s *grpc.Server, srv ApiServer) {
 	s.RegisterService(&_Api_serviceDesc, srv)
@@ -4701,6 +4967,24 @@ func _Api_Config_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_Issuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Issuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.public.Api/Issuances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Issuances(ctx, req.(*IssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Api_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "linkerd2.public.Api",
 	HandlerType: (*ApiServer)(nil),
@@ -4737,6 +5021,10 @@ var _Api_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Api_Config_Handler,
 		},
+		{
+			MethodName: "Issuances",
+			Handler:    _Api_Issuances_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
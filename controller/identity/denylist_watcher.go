@@ -0,0 +1,98 @@
+package identity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	denyListResync = 10 * time.Minute
+
+	eventTypeDenyListUpdated = "DenyListUpdated"
+	eventTypeDenyListInvalid = "DenyListInvalid"
+)
+
+// DenyListWatcher keeps a DenyList in sync with the linkerd-identity-deny-list
+// ConfigMap of the control plane namespace. The DenyList is emptied when the
+// ConfigMap is deleted, and left unchanged when the ConfigMap is invalid.
+type DenyListWatcher struct {
+	informer    cache.SharedIndexInformer
+	namespace   string
+	denyList    *identity.DenyList
+	recordEvent func(eventType, reason, message string)
+}
+
+// NewDenyListWatcher creates a DenyListWatcher updating denyList.
+func NewDenyListWatcher(k8sAPI kubernetes.Interface, namespace string, denyList *identity.DenyList, recordEvent func(eventType, reason, message string)) *DenyListWatcher {
+	factory := informers.NewSharedInformerFactoryWithOptions(k8sAPI, denyListResync,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", k8s.IdentityDenyListConfigMapName).String()
+		}),
+	)
+
+	w := &DenyListWatcher{
+		informer:    factory.Core().V1().ConfigMaps().Informer(),
+		namespace:   namespace,
+		denyList:    denyList,
+		recordEvent: recordEvent,
+	}
+	w.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.update(obj.(*v1.ConfigMap))
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			w.update(obj.(*v1.ConfigMap))
+		},
+		DeleteFunc: func(interface{}) {
+			w.update(nil)
+		},
+	})
+	return w
+}
+
+// Run watches the ConfigMap until stop is closed, and returns once the
+// DenyList holds its initial entries, or false if stop is closed before.
+func (w *DenyListWatcher) Run(stop <-chan struct{}) bool {
+	go w.informer.Run(stop)
+	if !cache.WaitForCacheSync(stop, w.informer.HasSynced) {
+		return false
+	}
+
+	// The event handlers are notified asynchronously, so the initial entries
+	// are loaded from the cache to be in place before any certificate is issued.
+	obj, exists, err := w.informer.GetStore().GetByKey(w.namespace + "/" + k8s.IdentityDenyListConfigMapName)
+	if err != nil || !exists {
+		return true
+	}
+	if err := w.denyList.UpdateFromConfigMap(obj.(*v1.ConfigMap)); err != nil {
+		log.Warnf("Skipping identity deny list update: %s", err)
+	}
+	return true
+}
+
+func (w *DenyListWatcher) update(cm *v1.ConfigMap) {
+	if err := w.denyList.UpdateFromConfigMap(cm); err != nil {
+		message := fmt.Sprintf("Skipping identity deny list update: %s", err)
+		log.Warn(message)
+		w.recordEvent(v1.EventTypeWarning, eventTypeDenyListInvalid, message)
+		return
+	}
+
+	message := "Updated identity deny list"
+	if cm == nil {
+		message = "Cleared identity deny list"
+	}
+	log.Info(message)
+	w.recordEvent(v1.EventTypeNormal, eventTypeDenyListUpdated, message)
+}
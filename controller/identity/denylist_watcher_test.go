@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/identity"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const deniedIdentity = "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+
+func TestDenyListWatcher(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k8s.IdentityDenyListConfigMapName,
+			Namespace: "linkerd",
+		},
+		Data: map[string]string{identity.DenyListIdentitiesKey: deniedIdentity},
+	}
+	clientset := fake.NewSimpleClientset(cm)
+
+	events := make(chan string, 10)
+	denyList := &identity.DenyList{}
+	watcher := NewDenyListWatcher(clientset, "linkerd", denyList, func(_, reason, _ string) {
+		events <- reason
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	if !watcher.Run(stop) {
+		t.Fatal("Expected the deny list to be synced")
+	}
+	if _, denied := denyList.DeniesIdentity(deniedIdentity); !denied {
+		t.Fatalf("Expected %s to be denied", deniedIdentity)
+	}
+
+	// invalid updates leave the deny list unchanged
+	cm.Data = map[string]string{identity.DenyListIdentitiesKey: "[invalid"}
+	if _, err := clientset.CoreV1().ConfigMaps("linkerd").Update(cm); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for invalid := false; !invalid; {
+		select {
+		case event := <-events:
+			invalid = event == eventTypeDenyListInvalid
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Expected a %s event, got none", eventTypeDenyListInvalid)
+		}
+	}
+	if _, denied := denyList.DeniesIdentity(deniedIdentity); !denied {
+		t.Fatalf("Expected %s to still be denied", deniedIdentity)
+	}
+
+	if err := clientset.CoreV1().ConfigMaps("linkerd").Delete(cm.Name, &metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return denyList.Empty(), nil
+	})
+	if err != nil {
+		t.Fatal("Expected the deny list to be cleared")
+	}
+}
@@ -34,3 +34,16 @@ func (d *TrustDomain) Identity(typ, nm, ns string) (string, error) {
 	id := fmt.Sprintf("%s.%s.%s.identity.%s.%s", nm, ns, typ, d.controlNS, d.domain)
 	return id, nil
 }
+
+// IdentitySuffix formats the suffix shared by the identities of all the K8s
+// users of the given type in a namespace.
+func (d *TrustDomain) IdentitySuffix(typ, ns string) (string, error) {
+	for _, l := range []string{typ, ns} {
+		if errs := validation.IsDNS1123Label(l); len(errs) > 0 {
+			return "", fmt.Errorf("invalid label '%s': %s", l, errs[0])
+		}
+	}
+
+	suffix := fmt.Sprintf(".%s.%s.identity.%s.%s", ns, typ, d.controlNS, d.domain)
+	return suffix, nil
+}
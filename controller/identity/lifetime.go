@@ -0,0 +1,97 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const lifetimeResync = 10 * time.Minute
+
+// K8sLifetimePolicy implements LifetimePolicy with the
+// linkerd.io/identity-issuance-lifetime annotation of the ServiceAccounts and
+// Namespaces, the ServiceAccount's taking precedence over its Namespace's.
+// The lifetimes are bounded by a cluster-wide maximum. The ServiceAccounts and
+// Namespaces are read from informer caches, so that issuing a certificate
+// doesn't query the Kubernetes API.
+type K8sLifetimePolicy struct {
+	saInformer  cache.SharedIndexInformer
+	nsInformer  cache.SharedIndexInformer
+	sa          corelisters.ServiceAccountLister
+	ns          corelisters.NamespaceLister
+	maxLifetime time.Duration
+}
+
+// NewK8sLifetimePolicy creates a K8sLifetimePolicy, bounding the annotated
+// lifetimes by maxLifetime.
+func NewK8sLifetimePolicy(k8sAPI kubernetes.Interface, maxLifetime time.Duration) *K8sLifetimePolicy {
+	factory := informers.NewSharedInformerFactory(k8sAPI, lifetimeResync)
+	sa := factory.Core().V1().ServiceAccounts()
+	ns := factory.Core().V1().Namespaces()
+
+	return &K8sLifetimePolicy{
+		saInformer:  sa.Informer(),
+		nsInformer:  ns.Informer(),
+		sa:          sa.Lister(),
+		ns:          ns.Lister(),
+		maxLifetime: maxLifetime,
+	}
+}
+
+// Run watches the ServiceAccounts and Namespaces until stop is closed, and
+// returns once their caches are synced, or false if stop is closed before.
+func (p *K8sLifetimePolicy) Run(stop <-chan struct{}) bool {
+	go p.saInformer.Run(stop)
+	go p.nsInformer.Run(stop)
+	return cache.WaitForCacheSync(stop, p.saInformer.HasSynced, p.nsInformer.HasSynced)
+}
+
+// Lifetime returns the lifetime annotated on the ServiceAccount of the given
+// identity or on its Namespace, bounded by the maximum lifetime. It returns 0
+// when none of them is annotated.
+func (p *K8sLifetimePolicy) Lifetime(ctx context.Context, identity string) (time.Duration, error) {
+	parts := strings.SplitN(identity, ".", 4)
+	if len(parts) < 4 || parts[2] != "serviceaccount" {
+		return 0, fmt.Errorf("not a service account identity: %s", identity)
+	}
+	name, namespace := parts[0], parts[1]
+
+	sa, err := p.sa.ServiceAccounts(namespace).Get(name)
+	if err != nil {
+		return 0, err
+	}
+	lifetime, err := p.annotatedLifetime("ServiceAccount", sa.ObjectMeta)
+	if err != nil || lifetime != 0 {
+		return lifetime, err
+	}
+
+	ns, err := p.ns.Get(namespace)
+	if err != nil {
+		return 0, err
+	}
+	return p.annotatedLifetime("Namespace", ns.ObjectMeta)
+}
+
+func (p *K8sLifetimePolicy) annotatedLifetime(kind string, meta metav1.ObjectMeta) (time.Duration, error) {
+	value, ok := meta.Annotations[k8s.IdentityIssuanceLifetimeAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	lifetime, err := time.ParseDuration(value)
+	if err != nil || lifetime <= 0 {
+		return 0, fmt.Errorf("invalid %s annotation on %s %s: %q", k8s.IdentityIssuanceLifetimeAnnotation, kind, meta.Name, value)
+	}
+	if lifetime > p.maxLifetime {
+		return p.maxLifetime, nil
+	}
+	return lifetime, nil
+}
@@ -0,0 +1,95 @@
+package identity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func annotated(lifetime string) map[string]string {
+	if lifetime == "" {
+		return nil
+	}
+	return map[string]string{k8s.IdentityIssuanceLifetimeAnnotation: lifetime}
+}
+
+func runPolicy(t *testing.T, k8sAPI kubernetes.Interface, stop <-chan struct{}) *K8sLifetimePolicy {
+	policy := NewK8sLifetimePolicy(k8sAPI, 24*time.Hour)
+	if !policy.Run(stop) {
+		t.Fatal("Failed to sync the lifetime policy")
+	}
+	return policy
+}
+
+func TestK8sLifetimePolicy(t *testing.T) {
+	const identity = "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+
+	testCases := []struct {
+		name             string
+		nsLifetime       string
+		saLifetime       string
+		expectedLifetime time.Duration
+		expectedError    bool
+	}{
+		{"no annotations", "", "", 0, false},
+		{"namespace annotation", "2h", "", 2 * time.Hour, false},
+		{"service account annotation", "2h", "30m", 30 * time.Minute, false},
+		{"bounded by the maximum", "", "48h", 24 * time.Hour, false},
+		{"invalid annotation", "", "soon", 0, true},
+		{"negative annotation", "-1h", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			objs := []runtime.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:        "emojivoto",
+					Annotations: annotated(tc.nsLifetime),
+				}},
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+					Name:        "web",
+					Namespace:   "emojivoto",
+					Annotations: annotated(tc.saLifetime),
+				}},
+			}
+			stop := make(chan struct{})
+			defer close(stop)
+			policy := runPolicy(t, fake.NewSimpleClientset(objs...), stop)
+
+			lifetime, err := policy.Lifetime(context.Background(), identity)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if lifetime != tc.expectedLifetime {
+				t.Fatalf("Expected lifetime %s, got %s", tc.expectedLifetime, lifetime)
+			}
+		})
+	}
+}
+
+func TestK8sLifetimePolicyWithoutServiceAccount(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	policy := runPolicy(t, fake.NewSimpleClientset(), stop)
+
+	if _, err := policy.Lifetime(context.Background(), "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"); err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+	if _, err := policy.Lifetime(context.Background(), "web.emojivoto.deployment.identity.linkerd.cluster.local"); err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+}
@@ -0,0 +1,165 @@
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// DefaultIssuerRenewalThreshold is the default remaining validity of the
+	// issuer certificate under which it gets renewed.
+	DefaultIssuerRenewalThreshold = 30 * 24 * time.Hour
+
+	// DefaultIssuerRenewalLifetime is the default lifetime of the renewed
+	// issuer certificates.
+	DefaultIssuerRenewalLifetime = 365 * 24 * time.Hour
+
+	// DefaultIssuerRenewalInterval is the default interval between two checks
+	// of the issuer certificate expiry.
+	DefaultIssuerRenewalInterval = time.Hour
+
+	eventTypeRenewed       = "IssuerRenewed"
+	eventTypeRenewalFailed = "IssuerRenewalFailed"
+
+	resultRenewed = "renewed"
+	resultFailed  = "failed"
+)
+
+var issuerRenewals = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "identity_issuer_renewals_total",
+	Help: "A counter for the number of renewals of the issuer certificate attempted by the identity controller.",
+}, []string{"result"})
+
+// IssuerRenewer renews the issuer certificate stored in the
+// linkerd-identity-issuer Secret before it expires, with the trust anchor
+// that issues it. The identity service then picks up the renewed issuer
+// through the regular hot-reload path, once the Secret mount is updated.
+type IssuerRenewer struct {
+	secrets      typedcorev1.SecretInterface
+	anchor       *tls.CA
+	trustAnchors *x509.CertPool
+	expectedName string
+	threshold    time.Duration
+	recordEvent  func(eventType, reason, message string)
+}
+
+// NewIssuerRenewer creates an IssuerRenewer for the issuer Secret of the
+// given namespace. The renewed issuers are issued by anchor, for lifetime,
+// as soon as the current one has less than threshold left. The anchor must
+// be one of trustAnchors, so that the proxies trust the renewed issuers.
+func NewIssuerRenewer(
+	k8sAPI kubernetes.Interface,
+	namespace string,
+	anchor *tls.Cred,
+	trustAnchors *x509.CertPool,
+	expectedName string,
+	lifetime, threshold time.Duration,
+	recordEvent func(eventType, reason, message string),
+) (*IssuerRenewer, error) {
+	if threshold <= 0 || lifetime <= threshold {
+		return nil, fmt.Errorf("the issuer lifetime (%s) must be greater than the renewal threshold (%s)", lifetime, threshold)
+	}
+	if err := anchor.Crt.Verify(trustAnchors, "", time.Time{}); err != nil {
+		return nil, fmt.Errorf("the renewal anchor isn't one of the trust anchors: %s", err)
+	}
+
+	return &IssuerRenewer{
+		secrets:      k8sAPI.CoreV1().Secrets(namespace),
+		anchor:       tls.NewCA(*anchor, tls.Validity{Lifetime: lifetime}),
+		trustAnchors: trustAnchors,
+		expectedName: expectedName,
+		threshold:    threshold,
+		recordEvent:  recordEvent,
+	}, nil
+}
+
+// Run checks the issuer certificate every interval, and renews it when it's
+// about to expire, until ctx is done.
+func (r *IssuerRenewer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RenewIfNeeded(time.Now()); err != nil {
+			issuerRenewals.With(prometheus.Labels{"result": resultFailed}).Inc()
+			message := fmt.Sprintf("Failed to renew the identity issuer: %s", err)
+			log.Warn(message)
+			r.recordEvent(v1.EventTypeWarning, eventTypeRenewalFailed, message)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RenewIfNeeded renews the issuer certificate stored in the Secret if it
+// expires within the renewal threshold at the given time. It returns the
+// renewed Secret, or nil if the issuer didn't need to be renewed.
+func (r *IssuerRenewer) RenewIfNeeded(now time.Time) (*v1.Secret, error) {
+	secret, err := r.secrets.Get(k8s.IdentityIssuerSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	crt, err := tls.DecodePEMCrt(string(secret.Data[k8s.IdentityIssuerCrtName]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the issuer certificate: %s", err)
+	}
+	remaining := crt.Certificate.NotAfter.Sub(now)
+	if remaining > r.threshold {
+		log.Debugf("Issuer certificate expires in %s, not renewing it yet", remaining)
+		return nil, nil
+	}
+
+	issuer, err := r.anchor.GenerateCAWithOptions(r.expectedName, 0, tls.CAOptions{DNSNames: []string{r.expectedName}})
+	if err != nil {
+		return nil, err
+	}
+	if err := issuer.Cred.Crt.Verify(r.trustAnchors, r.expectedName, time.Time{}); err != nil {
+		return nil, fmt.Errorf("failed to verify the renewed issuer for '%s' with trust anchors: %s", r.expectedName, err)
+	}
+
+	renewed := secret.DeepCopy()
+	if renewed.Annotations == nil {
+		renewed.Annotations = map[string]string{}
+	}
+	renewed.Annotations[k8s.IdentityIssuerExpiryAnnotation] = issuer.Cred.Crt.Certificate.NotAfter.Format(time.RFC3339)
+	renewed.Data = map[string][]byte{
+		k8s.IdentityIssuerCrtName: []byte(issuer.Cred.Crt.EncodeCertificatePEM()),
+		k8s.IdentityIssuerKeyName: []byte(issuer.Cred.EncodePrivateKeyPEM()),
+	}
+
+	renewed, err = r.secrets.Update(renewed)
+	if err != nil {
+		if kerrors.IsConflict(err) {
+			// Another replica of the identity controller got there first; the
+			// next check reads the issuer it stored.
+			log.Infof("Issuer Secret was updated concurrently, skipping renewal: %s", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	issuerRenewals.With(prometheus.Labels{"result": resultRenewed}).Inc()
+	message := fmt.Sprintf("Renewed identity issuer, expiring %s instead of %s",
+		issuer.Cred.Crt.Certificate.NotAfter.Format(time.RFC3339), crt.Certificate.NotAfter.Format(time.RFC3339))
+	log.Info(message)
+	r.recordEvent(v1.EventTypeNormal, eventTypeRenewed, message)
+	return renewed, nil
+}
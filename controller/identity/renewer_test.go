@@ -0,0 +1,139 @@
+package identity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const issuerName = "identity.linkerd.cluster.local"
+
+func issuerSecret(issuer *tls.Cred) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        k8s.IdentityIssuerSecretName,
+			Namespace:   "linkerd",
+			Labels:      map[string]string{k8s.ControllerComponentLabel: "identity"},
+			Annotations: map[string]string{k8s.IdentityIssuerExpiryAnnotation: issuer.Crt.Certificate.NotAfter.Format(time.RFC3339)},
+		},
+		Data: map[string][]byte{
+			k8s.IdentityIssuerCrtName: []byte(issuer.Crt.EncodeCertificatePEM()),
+			k8s.IdentityIssuerKeyName: []byte(issuer.EncodePrivateKeyPEM()),
+		},
+	}
+}
+
+func TestNewIssuerRenewer(t *testing.T) {
+	anchor, err := tls.GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	other, err := tls.GenerateRootCAWithDefaults("other.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name      string
+		anchor    *tls.CA
+		lifetime  time.Duration
+		threshold time.Duration
+		valid     bool
+	}{
+		{"valid", anchor, DefaultIssuerRenewalLifetime, DefaultIssuerRenewalThreshold, true},
+		{"untrusted anchor", other, DefaultIssuerRenewalLifetime, DefaultIssuerRenewalThreshold, false},
+		{"threshold beyond lifetime", anchor, time.Hour, DefaultIssuerRenewalThreshold, false},
+		{"no threshold", anchor, DefaultIssuerRenewalLifetime, 0, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewIssuerRenewer(fake.NewSimpleClientset(), "linkerd", &tc.anchor.Cred, anchor.Cred.Crt.CertPool(),
+				issuerName, tc.lifetime, tc.threshold, func(string, string, string) {})
+			if tc.valid && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatal("Expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestRenewIfNeeded(t *testing.T) {
+	anchor, err := tls.GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	issuer, err := tls.NewCA(anchor.Cred, tls.Validity{Lifetime: 24 * time.Hour}).GenerateCA(issuerName, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expiry := issuer.Cred.Crt.Certificate.NotAfter
+
+	testCases := []struct {
+		name    string
+		now     time.Time
+		renewed bool
+	}{
+		{"far from expiry", expiry.Add(-2 * DefaultIssuerRenewalThreshold), false},
+		{"within the threshold", expiry.Add(-DefaultIssuerRenewalThreshold / 2), true},
+		{"expired", expiry.Add(time.Hour), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			events := []string{}
+			renewer, err := NewIssuerRenewer(fake.NewSimpleClientset(issuerSecret(&issuer.Cred)), "linkerd", &anchor.Cred, anchor.Cred.Crt.CertPool(),
+				issuerName, DefaultIssuerRenewalLifetime, DefaultIssuerRenewalThreshold, func(_, reason, _ string) {
+					events = append(events, reason)
+				})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			secret, err := renewer.RenewIfNeeded(tc.now)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !tc.renewed {
+				if secret != nil || len(events) != 0 {
+					t.Fatalf("Expected no renewal, got %v and events %v", secret, events)
+				}
+				return
+			}
+
+			if secret == nil {
+				t.Fatal("Expected the issuer to be renewed")
+			}
+			if len(events) != 1 || events[0] != eventTypeRenewed {
+				t.Fatalf("Expected a %s event, got %v", eventTypeRenewed, events)
+			}
+			if secret.Labels[k8s.ControllerComponentLabel] != "identity" {
+				t.Fatalf("Expected the labels to be kept, got %v", secret.Labels)
+			}
+
+			cred, err := tls.ValidateAndCreateCreds(string(secret.Data[k8s.IdentityIssuerCrtName]), string(secret.Data[k8s.IdentityIssuerKeyName]))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := cred.Crt.Verify(anchor.Cred.Crt.CertPool(), issuerName, time.Time{}); err != nil {
+				t.Fatalf("Expected the renewed issuer to be issued by the anchor: %s", err)
+			}
+			if !cred.Crt.Certificate.NotAfter.After(expiry) {
+				t.Fatalf("Expected the renewed issuer to expire after %s, got %s", expiry, cred.Crt.Certificate.NotAfter)
+			}
+			expectedExpiry := cred.Crt.Certificate.NotAfter.Format(time.RFC3339)
+			if annotation := secret.Annotations[k8s.IdentityIssuerExpiryAnnotation]; annotation != expectedExpiry {
+				t.Fatalf("Expected expiry %s, got %s", expectedExpiry, annotation)
+			}
+		})
+	}
+}
@@ -16,12 +16,15 @@ import (
 
 // K8sTokenValidator implements Validator for Kubernetes bearer tokens.
 type K8sTokenValidator struct {
-	authn  kauthn.AuthenticationV1Interface
-	domain *TrustDomain
+	authn    kauthn.AuthenticationV1Interface
+	domain   *TrustDomain
+	audience string
 }
 
 // NewK8sTokenValidator takes a kubernetes client and trust domain to create a
-// K8sTokenValidator.
+// K8sTokenValidator. When audience is set, only the projected service account
+// tokens bound to that audience are accepted; otherwise, the tokens must be
+// valid for the Kubernetes API, like the legacy service account tokens.
 //
 // The kubernetes client is used immediately to validate that the client has
 // sufficient privileges to perform token reviews. An error is returned if this
@@ -29,19 +32,22 @@ type K8sTokenValidator struct {
 func NewK8sTokenValidator(
 	k8s k8s.Interface,
 	domain *TrustDomain,
+	audience string,
 ) (identity.Validator, error) {
 	if err := checkAccess(k8s.AuthorizationV1()); err != nil {
 		return nil, err
 	}
 
 	authn := k8s.AuthenticationV1()
-	return &K8sTokenValidator{authn, domain}, nil
+	return &K8sTokenValidator{authn, domain, audience}, nil
 }
 
 // Validate accepts kubernetes bearer tokens and returns a DNS-form linkerd ID.
 func (k *K8sTokenValidator) Validate(_ context.Context, tok []byte) (string, error) {
-	// TODO: Set/check `audience`
 	tr := kauthnApi.TokenReview{Spec: kauthnApi.TokenReviewSpec{Token: string(tok)}}
+	if k.audience != "" {
+		tr.Spec.Audiences = []string{k.audience}
+	}
 	rvw, err := k.authn.TokenReviews().Create(&tr)
 	if err != nil {
 		return "", err
@@ -53,6 +59,13 @@ func (k *K8sTokenValidator) Validate(_ context.Context, tok []byte) (string, err
 	if !rvw.Status.Authenticated {
 		return "", identity.NotAuthenticated{}
 	}
+	// The API server only authenticates the tokens valid for one of the
+	// requested audiences, but API servers that don't support audiences
+	// ignore them, so the audience is checked anyway.
+	if k.audience != "" && !hasAudience(rvw.Status.Audiences, k.audience) {
+		msg := fmt.Sprintf("Token must be bound to the %s audience", k.audience)
+		return "", identity.InvalidToken{Reason: msg}
+	}
 
 	// Determine the identity associated with the token's userinfo.
 	uns := strings.Split(rvw.Status.User.Username, ":")
@@ -70,6 +83,15 @@ func (k *K8sTokenValidator) Validate(_ context.Context, tok []byte) (string, err
 	return k.domain.Identity(uns[0], uns[2], uns[1])
 }
 
+func hasAudience(audiences []string, audience string) bool {
+	for _, a := range audiences {
+		if a == audience {
+			return true
+		}
+	}
+	return false
+}
+
 func checkAccess(authz kauthz.AuthorizationV1Interface) error {
 	r := &kauthzApi.SelfSubjectAccessReview{
 		Spec: kauthzApi.SelfSubjectAccessReviewSpec{
@@ -2,18 +2,22 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
-	tsclient "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
-	ts "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/informers/externalversions"
-	tsinformers "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/informers/externalversions/split/v1alpha1"
 	spv1alpha2 "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
 	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	sp "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions"
+	pcinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/proxyconfig/v1alpha1"
+	sazinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serverauthorization/v1alpha1"
 	spinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serviceprofile/v1alpha2"
+	tsclient "github.com/linkerd/linkerd2/controller/gen/client/split/clientset/versioned"
+	ts "github.com/linkerd/linkerd2/controller/gen/client/split/informers/externalversions"
+	tsinformers "github.com/linkerd/linkerd2/controller/gen/client/split/informers/externalversions/split/v1alpha1"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/prometheus"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,6 +25,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -32,7 +37,9 @@ import (
 	batchv1informers "k8s.io/client-go/informers/batch/v1"
 	batchv1beta1informers "k8s.io/client-go/informers/batch/v1beta1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1beta1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -58,11 +65,17 @@ const (
 	Svc
 	TS
 	Node
+	ES    // endpointslice
+	Authz // server authorization
+	PC    // proxy config
 )
 
 // API provides shared informers for all Kubernetes objects
 type API struct {
 	Client kubernetes.Interface
+	// TsClient is only usable when the API is initialized with the TS
+	// resource
+	TsClient tsclient.Interface
 
 	cj       batchv1beta1informers.CronJobInformer
 	cm       coreinformers.ConfigMapInformer
@@ -80,6 +93,9 @@ type API struct {
 	svc      coreinformers.ServiceInformer
 	ts       tsinformers.TrafficSplitInformer
 	node     coreinformers.NodeInformer
+	es       discoveryinformers.EndpointSliceInformer
+	authz    sazinformers.ServerAuthorizationInformer
+	pc       pcinformers.ProxyConfigInformer
 
 	syncChecks        []cache.InformerSynced
 	sharedInformers   informers.SharedInformerFactory
@@ -100,21 +116,29 @@ func InitializeAPI(kubeConfig string, resources ...APIResource) (*API, error) {
 		return nil, err
 	}
 
-	// check for need and access to ServiceProfiles
+	// check for need and access to ServiceProfiles, ServerAuthorizations and
+	// ProxyConfigs, which are served by the same clientset
 	var spClient *spclient.Clientset
 	for _, res := range resources {
-		if res == SP {
-			err := k8s.ServiceProfilesAccess(k8sClient)
-			if err != nil {
-				return nil, err
-			}
+		switch res {
+		case SP:
+			err = k8s.ServiceProfilesAccess(k8sClient)
+		case Authz:
+			err = k8s.ServerAuthorizationsAccess(k8sClient)
+		case PC:
+			err = k8s.ProxyConfigsAccess(k8sClient)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
 
+		if spClient == nil {
 			spClient, err = NewSpClientSet(kubeConfig)
 			if err != nil {
 				return nil, err
 			}
-
-			break
 		}
 	}
 
@@ -133,6 +157,28 @@ func InitializeAPI(kubeConfig string, resources ...APIResource) (*API, error) {
 	return NewAPI(k8sClient, spClient, tsClient, resources...), nil
 }
 
+// InitializeAPIForConfig creates a Kubernetes client out of the given
+// configuration and returns an initialized API wrapper. It only supports the
+// core Kubernetes resource types, and is used to access remote clusters
+// whose credentials are not available as a kubeconfig file.
+func InitializeAPIForConfig(kubeConfig *rest.Config, resources ...APIResource) (*API, error) {
+	wt := kubeConfig.WrapTransport
+	kubeConfig.WrapTransport = prometheus.ClientWithTelemetry("k8s", wt)
+
+	k8sClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resources {
+		if res == SP || res == TS || res == Authz || res == PC {
+			return nil, fmt.Errorf("resource type %d is not supported for this client", res)
+		}
+	}
+
+	return NewAPI(k8sClient, nil, nil, resources...), nil
+}
+
 // NewAPI takes a Kubernetes client and returns an initialized API.
 func NewAPI(
 	k8sClient kubernetes.Interface,
@@ -154,6 +200,7 @@ func NewAPI(
 
 	api := &API{
 		Client:            k8sClient,
+		TsClient:          tsClient,
 		syncChecks:        make([]cache.InformerSynced, 0),
 		sharedInformers:   sharedInformers,
 		spSharedInformers: spSharedInformers,
@@ -210,6 +257,15 @@ func NewAPI(
 		case Node:
 			api.node = sharedInformers.Core().V1().Nodes()
 			api.syncChecks = append(api.syncChecks, api.node.Informer().HasSynced)
+		case ES:
+			api.es = sharedInformers.Discovery().V1beta1().EndpointSlices()
+			api.syncChecks = append(api.syncChecks, api.es.Informer().HasSynced)
+		case Authz:
+			api.authz = spSharedInformers.Policy().V1alpha1().ServerAuthorizations()
+			api.syncChecks = append(api.syncChecks, api.authz.Informer().HasSynced)
+		case PC:
+			api.pc = spSharedInformers.Config().V1alpha1().ProxyConfigs()
+			api.syncChecks = append(api.syncChecks, api.pc.Informer().HasSynced)
 		}
 	}
 
@@ -218,18 +274,32 @@ func NewAPI(
 
 // Sync waits for all informers to be synced.
 func (api *API) Sync() {
-	api.sharedInformers.Start(nil)
-	api.spSharedInformers.Start(nil)
-	api.tsSharedInformers.Start(nil)
+	if err := api.SyncUntil(nil); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// SyncUntil starts all informers, which run until stopCh is closed, and waits
+// for them to be synced. Unlike Sync, it returns an error when the informers
+// fail to sync, so that callers can recover from it.
+func (api *API) SyncUntil(stopCh <-chan struct{}) error {
+	api.sharedInformers.Start(stopCh)
+	if api.spSharedInformers != nil {
+		api.spSharedInformers.Start(stopCh)
+	}
+	if api.tsSharedInformers != nil {
+		api.tsSharedInformers.Start(stopCh)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
 	log.Infof("waiting for caches to sync")
 	if !cache.WaitForCacheSync(ctx.Done(), api.syncChecks...) {
-		log.Fatal("failed to sync caches")
+		return errors.New("failed to sync caches")
 	}
 	log.Infof("caches synced")
+	return nil
 }
 
 // NS provides access to a shared informer and lister for Namespaces.
@@ -305,6 +375,21 @@ func (api *API) Endpoint() coreinformers.EndpointsInformer {
 	return api.endpoint
 }
 
+// ES provides access to a shared informer and lister for EndpointSlices.
+func (api *API) ES() discoveryinformers.EndpointSliceInformer {
+	if api.es == nil {
+		panic("ES informer not configured")
+	}
+	return api.es
+}
+
+// GetEndpointSlicesFor returns all the EndpointSlices that belong to the
+// given service, as indicated by their kubernetes.io/service-name label.
+func (api *API) GetEndpointSlicesFor(namespace, service string) ([]*discovery.EndpointSlice, error) {
+	selector := labels.Set{discovery.LabelServiceName: service}.AsSelector()
+	return api.ES().Lister().EndpointSlices(namespace).List(selector)
+}
+
 // CM provides access to a shared informer and lister for ConfigMaps.
 func (api *API) CM() coreinformers.ConfigMapInformer {
 	if api.cm == nil {
@@ -321,6 +406,23 @@ func (api *API) SP() spinformers.ServiceProfileInformer {
 	return api.sp
 }
 
+// Authz provides access to a shared informer and lister for
+// ServerAuthorizations.
+func (api *API) Authz() sazinformers.ServerAuthorizationInformer {
+	if api.authz == nil {
+		panic("Authz informer not configured")
+	}
+	return api.authz
+}
+
+// PC provides access to a shared informer and lister for ProxyConfigs.
+func (api *API) PC() pcinformers.ProxyConfigInformer {
+	if api.pc == nil {
+		panic("PC informer not configured")
+	}
+	return api.pc
+}
+
 // MWC provides access to a shared informer and lister for MutatingWebhookConfigurations.
 func (api *API) MWC() arinformers.MutatingWebhookConfigurationInformer {
 	if api.mwc == nil {
@@ -32,6 +32,9 @@ func NewFakeAPI(configs ...string) (*API, error) {
 		Svc,
 		TS,
 		Node,
+		ES,
+		Authz,
+		PC,
 	), nil
 }
 
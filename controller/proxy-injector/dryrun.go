@@ -0,0 +1,98 @@
+package injector
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/linkerd/linkerd2/pkg/inject"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	authnv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// DryRun responds with the patch the proxy-injector would apply to the posted
+// workload manifest, and the report of its injection, without admitting
+// anything. The workload is injected as the webhook does, with the live
+// configuration of the cluster; the user must be allowed to create it.
+func DryRun(api *k8s.API, user authnv1.UserInfo, w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("%s requests aren't supported", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	manifest, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var obj struct {
+		metav1.TypeMeta `json:",inline"`
+		Metadata        metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := yaml.Unmarshal(manifest, &obj); err != nil {
+		http.Error(w, fmt.Sprintf("invalid manifest: %s", err), http.StatusBadRequest)
+		return
+	}
+	gv, err := schema.ParseGroupVersion(obj.APIVersion)
+	if err != nil || obj.Kind == "" {
+		http.Error(w, "invalid manifest: the apiVersion and kind must be set", http.StatusBadRequest)
+		return
+	}
+	ns := obj.Metadata.Namespace
+	if ns == "" {
+		ns = corev1.NamespaceDefault
+	}
+
+	// the workloads kinds are all pluralized with a trailing "s"
+	resource := strings.ToLower(obj.Kind) + "s"
+	if err := pkgK8s.ResourceAuthzForUser(api.Client, ns, "create", gv.Group, gv.Version, resource, "", obj.Metadata.Name, user.Username, user.Groups); err != nil {
+		log.Infof("rejected dry-run by %s: %s", user.Username, err)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	resourceConfig, err := newResourceConfig(api, ns)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if kerrors.IsNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	report, err := resourceConfig.ParseMetaAndYAML(manifest)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	log.Infof("received dry-run of %s by %s", report.ResName(), user.Username)
+
+	rsp := &inject.DryRunResponse{}
+	if injectable, _ := report.Injectable(); injectable {
+		patchJSON, err := getPatch(resourceConfig)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(patchJSON) > 0 {
+			rsp.Patch = patchJSON
+		}
+		report.ConfigSources = resourceConfig.GetConfigSources()
+	}
+	rsp.Report = *report
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(rsp); err != nil {
+		log.Errorf("failed to write the dry-run response: %s", err)
+	}
+}
@@ -0,0 +1,63 @@
+package injector
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	authnv1 "k8s.io/api/authentication/v1"
+)
+
+func TestDryRunRejections(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	user := authnv1.UserInfo{Username: "jane", Groups: []string{"developers"}}
+
+	testCases := []struct {
+		name     string
+		method   string
+		manifest string
+		status   int
+	}{
+		{
+			name:   "unsupported method",
+			method: http.MethodGet,
+			status: http.StatusMethodNotAllowed,
+		},
+		{
+			name:     "invalid manifest",
+			method:   http.MethodPost,
+			manifest: "kind: [",
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "manifest without kind",
+			method:   http.MethodPost,
+			manifest: "metadata:\n  name: web",
+			status:   http.StatusBadRequest,
+		},
+		{
+			// the fake API server doesn't allow anything
+			name:     "user not allowed to create the workload",
+			method:   http.MethodPost,
+			manifest: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n  namespace: emojivoto",
+			status:   http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/dry-run", strings.NewReader(tc.manifest))
+			recorder := httptest.NewRecorder()
+			DryRun(k8sAPI, user, recorder, req)
+			if recorder.Code != tc.status {
+				t.Fatalf("Expected status %d, got %d: %s", tc.status, recorder.Code, recorder.Body)
+			}
+		})
+	}
+}
@@ -3,8 +3,6 @@ package injector
 import (
 	"strings"
 
-	"github.com/linkerd/linkerd2/pkg/k8s"
-
 	"github.com/linkerd/linkerd2/pkg/inject"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -69,6 +67,8 @@ func validLabelNames(labels []string) []string {
 	return validLabels
 }
 
+// validProxyConfigurationLabel returns the name of the annotation without its
+// config.linkerd.io or config.alpha.linkerd.io prefix
 func validProxyConfigurationLabel(label string) string {
-	return strings.Replace(label[len(k8s.ProxyConfigAnnotationsPrefix)+1:], "-", "_", -1)
+	return strings.Replace(label[strings.Index(label, "/")+1:], "-", "_", -1)
 }
@@ -23,6 +23,8 @@ const (
 	eventTypeSkipped  = "InjectionSkipped"
 	eventTypeInjected = "Injected"
 	eventTypeTracing  = "Tracing"
+
+	eventTypeShutdownCommandMissing = "ShutdownCommandMissing"
 )
 
 // Inject returns an AdmissionResponse containing the patch, if any, to apply
@@ -33,27 +35,11 @@ func Inject(api *k8s.API,
 ) (*admissionv1beta1.AdmissionResponse, error) {
 	log.Debugf("request object bytes: %s", request.Object.Raw)
 
-	globalConfig, err := config.Global(pkgK8s.MountPathGlobalConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	proxyConfig, err := config.Proxy(pkgK8s.MountPathProxyConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	namespace, err := api.NS().Lister().Get(request.Namespace)
+	resourceConfig, err := newResourceConfig(api, request.Namespace)
 	if err != nil {
 		return nil, err
 	}
-	nsAnnotations := namespace.GetAnnotations()
-
-	configs := &pb.All{Global: globalConfig, Proxy: proxyConfig}
-	resourceConfig := inject.NewResourceConfig(configs, inject.OriginWebhook).
-		WithOwnerRetriever(ownerRetriever(api, request.Namespace)).
-		WithNsAnnotations(nsAnnotations).
-		WithKind(request.Kind.Kind)
+	resourceConfig.WithKind(request.Kind.Kind)
 	report, err := resourceConfig.ParseMetaAndYAML(request.Object.Raw)
 	if err != nil {
 		return nil, err
@@ -97,10 +83,7 @@ func Inject(api *k8s.API,
 		return admissionResponse, nil
 	}
 
-	resourceConfig.AppendPodAnnotations(map[string]string{
-		pkgK8s.CreatedByAnnotation: fmt.Sprintf("linkerd/proxy-injector %s", version.Version),
-	})
-	patchJSON, err := resourceConfig.GetPatch(true)
+	patchJSON, err := getPatch(resourceConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -109,13 +92,27 @@ func Inject(api *k8s.API,
 		return admissionResponse, nil
 	}
 
+	mode := ""
+	if report.OutboundOnly {
+		mode = " in outbound-only mode"
+	}
 	if parent != nil {
-		recorder.Event(*parent, v1.EventTypeNormal, eventTypeInjected, "Linkerd sidecar proxy injected")
+		recorder.Event(*parent, v1.EventTypeNormal, eventTypeInjected, "Linkerd sidecar proxy injected"+mode)
 		if report.TracingEnabled {
 			recorder.Event(*parent, v1.EventTypeNormal, eventTypeTracing, "Tracing Enabled")
 		}
 	}
-	log.Infof("patch generated for: %s", report.ResName())
+	if len(report.ShutdownCommandMissing) > 0 {
+		message := fmt.Sprintf("Shutdown coordination skipped, as some containers don't set a command: %s", strings.Join(report.ShutdownCommandMissing, ", "))
+		log.Warnf("%s: %s", report.ResName(), message)
+		if parent != nil {
+			recorder.Event(*parent, v1.EventTypeWarning, eventTypeShutdownCommandMissing, message)
+		}
+	}
+	log.Infof("patch generated for: %s%s", report.ResName(), mode)
+	for annotation, source := range resourceConfig.GetConfigSources() {
+		log.Debugf("%s: %s=%s from %s", report.ResName(), annotation, source.Value, source.Source)
+	}
 	log.Debugf("patch: %s", patchJSON)
 	proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "false", "", report.InjectAnnotationAt, configLabels)).Inc()
 	patchType := admissionv1beta1.PatchTypeJSONPatch
@@ -125,6 +122,45 @@ func Inject(api *k8s.API,
 	return admissionResponse, nil
 }
 
+// newResourceConfig returns the ResourceConfig injecting the workloads of the
+// given namespace, with the live configuration of the cluster
+func newResourceConfig(api *k8s.API, ns string) (*inject.ResourceConfig, error) {
+	globalConfig, err := config.Global(pkgK8s.MountPathGlobalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyConfig, err := config.Proxy(pkgK8s.MountPathProxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := api.NS().Lister().Get(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyConfigs, err := api.PC().Lister().ProxyConfigs(ns).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	configs := &pb.All{Global: globalConfig, Proxy: proxyConfig}
+	return inject.NewResourceConfig(configs, inject.OriginWebhook).
+		WithOwnerRetriever(ownerRetriever(api, ns)).
+		WithNsAnnotations(namespace.GetAnnotations()).
+		WithNsLabels(namespace.GetLabels()).
+		WithProxyConfigs(proxyConfigs), nil
+}
+
+// getPatch returns the patch injecting the proxy into the parsed workload
+func getPatch(resourceConfig *inject.ResourceConfig) ([]byte, error) {
+	resourceConfig.AppendPodAnnotations(map[string]string{
+		pkgK8s.CreatedByAnnotation: fmt.Sprintf("linkerd/proxy-injector %s", version.Version),
+	})
+	return resourceConfig.GetPatch(true)
+}
+
 func ownerRetriever(api *k8s.API, ns string) inject.OwnerRetrieverFunc {
 	return func(p *v1.Pod) (string, string) {
 		p.SetNamespace(ns)
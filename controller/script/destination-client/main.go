@@ -60,7 +60,7 @@ func get(client pb.DestinationClient, req *pb.GetDestination) {
 			log.Println("Add:")
 			log.Printf("labels: %v", updateType.Add.MetricLabels)
 			for _, addr := range updateType.Add.Addrs {
-				log.Printf("- %s:%d", addrUtil.ProxyIPToString(addr.Addr.GetIp()), addr.Addr.Port)
+				log.Printf("- %s", addrUtil.ProxyAddressToString(addr.Addr))
 				log.Printf("  - labels: %v", addr.MetricLabels)
 				switch addr.GetProtocolHint().GetProtocol().(type) {
 				case *pb.ProtocolHint_H2_:
@@ -73,7 +73,7 @@ func get(client pb.DestinationClient, req *pb.GetDestination) {
 		case *pb.Update_Remove:
 			log.Println("Remove:")
 			for _, addr := range updateType.Remove.Addrs {
-				log.Printf("- %s:%d", addrUtil.ProxyIPToString(addr.GetIp()), addr.Port)
+				log.Printf("- %s", addrUtil.ProxyAddressToString(addr))
 			}
 			log.Println()
 		case *pb.Update_NoEndpoints:
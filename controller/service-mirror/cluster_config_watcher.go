@@ -0,0 +1,151 @@
+package servicemirror
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	consts "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/multicluster"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RemoteClusterConfigWatcher watches the Secrets holding the credentials of
+// remote clusters, and starts or stops a RemoteClusterServiceWatcher for each
+// of them.
+type RemoteClusterConfigWatcher struct {
+	k8sAPI          *k8s.API
+	clusterWatchers map[string]*RemoteClusterServiceWatcher
+	requeueLimit    int
+	sync.Mutex
+}
+
+// NewRemoteClusterConfigWatcher creates a new config watcher, registering its
+// handlers on the given Secrets informer.
+func NewRemoteClusterConfigWatcher(k8sAPI *k8s.API, secrets coreinformers.SecretInformer, requeueLimit int) *RemoteClusterConfigWatcher {
+	rcw := &RemoteClusterConfigWatcher{
+		k8sAPI:          k8sAPI,
+		clusterWatchers: map[string]*RemoteClusterServiceWatcher{},
+		requeueLimit:    requeueLimit,
+	}
+
+	secrets.Informer().AddEventHandler(
+		cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj interface{}) bool {
+				switch object := obj.(type) {
+				case *corev1.Secret:
+					return object.Type == consts.MirrorSecretType
+				case cache.DeletedFinalStateUnknown:
+					if secret, ok := object.Obj.(*corev1.Secret); ok {
+						return secret.Type == consts.MirrorSecretType
+					}
+					return false
+				default:
+					return false
+				}
+			},
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					secret := obj.(*corev1.Secret)
+					if err := rcw.registerRemoteCluster(secret); err != nil {
+						log.Errorf("Cannot register remote cluster from secret %s/%s: %s", secret.Namespace, secret.Name, err)
+					}
+				},
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					oldSecret := oldObj.(*corev1.Secret)
+					newSecret := newObj.(*corev1.Secret)
+					if oldSecret.ResourceVersion == newSecret.ResourceVersion {
+						return
+					}
+
+					// re-register the cluster so that the new credentials are
+					// used, keeping the mirrored resources in place
+					if err := rcw.unregisterRemoteCluster(oldSecret, false); err != nil {
+						log.Errorf("Cannot unregister remote cluster from secret %s/%s: %s", oldSecret.Namespace, oldSecret.Name, err)
+					}
+					if err := rcw.registerRemoteCluster(newSecret); err != nil {
+						log.Errorf("Cannot register remote cluster from secret %s/%s: %s", newSecret.Namespace, newSecret.Name, err)
+					}
+				},
+				DeleteFunc: func(obj interface{}) {
+					secret, ok := obj.(*corev1.Secret)
+					if !ok {
+						tombstone := obj.(cache.DeletedFinalStateUnknown)
+						secret = tombstone.Obj.(*corev1.Secret)
+					}
+					if err := rcw.unregisterRemoteCluster(secret, true); err != nil {
+						log.Errorf("Cannot unregister remote cluster from secret %s/%s: %s", secret.Namespace, secret.Name, err)
+					}
+				},
+			},
+		},
+	)
+
+	return rcw
+}
+
+// Stop stops all the remote cluster watchers, leaving the mirrored resources
+// in place.
+func (rcw *RemoteClusterConfigWatcher) Stop() {
+	rcw.Lock()
+	defer rcw.Unlock()
+	for name, watcher := range rcw.clusterWatchers {
+		watcher.Stop(false)
+		delete(rcw.clusterWatchers, name)
+	}
+}
+
+func (rcw *RemoteClusterConfigWatcher) registerRemoteCluster(secret *corev1.Secret) error {
+	config, err := multicluster.ParseRemoteClusterSecret(secret)
+	if err != nil {
+		return err
+	}
+
+	clientConfig, err := config.RESTConfig()
+	if err != nil {
+		return fmt.Errorf("cannot parse kubeconfig: %s", err)
+	}
+
+	rcw.Lock()
+	defer rcw.Unlock()
+
+	if _, ok := rcw.clusterWatchers[config.ClusterName]; ok {
+		return fmt.Errorf("there is already a cluster with name %s being watched", config.ClusterName)
+	}
+
+	watcher, err := NewRemoteClusterServiceWatcher(rcw.k8sAPI, clientConfig, config.ClusterName, config.ClusterDomain, rcw.requeueLimit)
+	if err != nil {
+		return err
+	}
+	rcw.clusterWatchers[config.ClusterName] = watcher
+
+	go func() {
+		if err := watcher.Start(); err != nil {
+			log.Errorf("Cannot start watching cluster %s: %s", config.ClusterName, err)
+		}
+	}()
+	log.Infof("Started watching remote cluster %s", config.ClusterName)
+	return nil
+}
+
+func (rcw *RemoteClusterConfigWatcher) unregisterRemoteCluster(secret *corev1.Secret, cleanupState bool) error {
+	config, err := multicluster.ParseRemoteClusterSecret(secret)
+	if err != nil {
+		return err
+	}
+
+	rcw.Lock()
+	defer rcw.Unlock()
+
+	watcher, ok := rcw.clusterWatchers[config.ClusterName]
+	if !ok {
+		return fmt.Errorf("cannot find watcher for cluster %s", config.ClusterName)
+	}
+	watcher.Stop(cleanupState)
+	delete(rcw.clusterWatchers, config.ClusterName)
+	log.Infof("Stopped watching remote cluster %s", config.ClusterName)
+	return nil
+}
@@ -0,0 +1,497 @@
+package servicemirror
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	consts "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/multicluster"
+	logging "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type (
+	// RemoteClusterServiceWatcher watches the Services of a remote cluster.
+	// Services that are exported, by means of the gateway annotations, are
+	// mirrored into the local cluster as `<svc>-<cluster>` Services whose
+	// Endpoints point at the remote gateway.
+	RemoteClusterServiceWatcher struct {
+		clusterName     string
+		clusterDomain   string
+		remoteAPIClient *k8s.API
+		localAPIClient  *k8s.API
+		stopper         chan struct{}
+		log             *logging.Entry
+		eventsQueue     workqueue.RateLimitingInterface
+		requeueLimit    int
+	}
+
+	// remoteServiceUpdated is issued when a Service of the remote cluster is
+	// created or updated.
+	remoteServiceUpdated struct {
+		service *corev1.Service
+	}
+
+	// remoteServiceDeleted is issued when a Service of the remote cluster is
+	// deleted.
+	remoteServiceDeleted struct {
+		name      string
+		namespace string
+	}
+
+	// clusterUnregistered is issued when the credentials of the remote
+	// cluster are removed, and all its mirrored resources need to go away.
+	clusterUnregistered struct{}
+
+	// orphanedServicesGcTriggered is issued once the remote informers are
+	// synced, to delete the mirrored Services whose remote counterpart went
+	// away while this watcher wasn't running.
+	orphanedServicesGcTriggered struct{}
+)
+
+// NewRemoteClusterServiceWatcher returns a watcher for the remote cluster
+// accessible with the given configuration.
+func NewRemoteClusterServiceWatcher(
+	localAPI *k8s.API,
+	cfg *rest.Config,
+	clusterName string,
+	clusterDomain string,
+	requeueLimit int,
+) (*RemoteClusterServiceWatcher, error) {
+	remoteAPI, err := k8s.InitializeAPIForConfig(cfg, k8s.Svc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize API for cluster %s: %s", clusterName, err)
+	}
+
+	rcsw := newRemoteClusterServiceWatcher(localAPI, remoteAPI, clusterName, clusterDomain, requeueLimit)
+	rcsw.log = rcsw.log.WithField("apiAddress", cfg.Host)
+	return rcsw, nil
+}
+
+func newRemoteClusterServiceWatcher(
+	localAPI *k8s.API,
+	remoteAPI *k8s.API,
+	clusterName string,
+	clusterDomain string,
+	requeueLimit int,
+) *RemoteClusterServiceWatcher {
+	return &RemoteClusterServiceWatcher{
+		clusterName:     clusterName,
+		clusterDomain:   clusterDomain,
+		remoteAPIClient: remoteAPI,
+		localAPIClient:  localAPI,
+		stopper:         make(chan struct{}),
+		log: logging.WithFields(logging.Fields{
+			"component": "service-mirror",
+			"cluster":   clusterName,
+		}),
+		eventsQueue:  workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		requeueLimit: requeueLimit,
+	}
+}
+
+// Start registers the handlers on the remote Services informer, waits for it
+// to be synced and starts processing events.
+func (rcsw *RemoteClusterServiceWatcher) Start() error {
+	rcsw.remoteAPIClient.Svc().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				rcsw.eventsQueue.Add(&remoteServiceUpdated{service: obj.(*corev1.Service)})
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldSvc := oldObj.(*corev1.Service)
+				newSvc := newObj.(*corev1.Service)
+				if oldSvc.ResourceVersion == newSvc.ResourceVersion {
+					return
+				}
+				rcsw.eventsQueue.Add(&remoteServiceUpdated{service: newSvc})
+			},
+			DeleteFunc: func(obj interface{}) {
+				svc, ok := obj.(*corev1.Service)
+				if !ok {
+					tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						rcsw.log.Errorf("couldn't get object from DeletedFinalStateUnknown %#v", obj)
+						return
+					}
+					svc, ok = tombstone.Obj.(*corev1.Service)
+					if !ok {
+						rcsw.log.Errorf("DeletedFinalStateUnknown contained object that is not a Service %#v", obj)
+						return
+					}
+				}
+				rcsw.eventsQueue.Add(&remoteServiceDeleted{name: svc.Name, namespace: svc.Namespace})
+			},
+		},
+	)
+
+	go rcsw.processEvents()
+
+	if err := rcsw.remoteAPIClient.SyncUntil(rcsw.stopper); err != nil {
+		return fmt.Errorf("cannot sync Services of cluster %s: %s", rcsw.clusterName, err)
+	}
+	rcsw.eventsQueue.Add(&orphanedServicesGcTriggered{})
+	return nil
+}
+
+// Stop stops watching the remote cluster. If cleanupState is true, all the
+// resources mirrored from the remote cluster are deleted.
+func (rcsw *RemoteClusterServiceWatcher) Stop(cleanupState bool) {
+	close(rcsw.stopper)
+	if cleanupState {
+		rcsw.eventsQueue.Add(&clusterUnregistered{})
+	}
+	rcsw.eventsQueue.ShutDown()
+}
+
+func (rcsw *RemoteClusterServiceWatcher) processEvents() {
+	for {
+		event, done := rcsw.eventsQueue.Get()
+		if done {
+			rcsw.log.Info("Shutting down events processor")
+			return
+		}
+
+		err := rcsw.processEvent(event)
+		switch {
+		case err == nil:
+			rcsw.eventsQueue.Forget(event)
+		case rcsw.eventsQueue.NumRequeues(event) < rcsw.requeueLimit:
+			rcsw.log.Errorf("Error processing %T (will retry): %s", event, err)
+			rcsw.eventsQueue.AddRateLimited(event)
+		default:
+			rcsw.log.Errorf("Error processing %T (giving up): %s", event, err)
+			rcsw.eventsQueue.Forget(event)
+		}
+		rcsw.eventsQueue.Done(event)
+	}
+}
+
+func (rcsw *RemoteClusterServiceWatcher) processEvent(event interface{}) error {
+	switch ev := event.(type) {
+	case *remoteServiceUpdated:
+		return rcsw.handleRemoteServiceUpdated(ev.service)
+	case *remoteServiceDeleted:
+		return rcsw.handleRemoteServiceDeleted(ev.name, ev.namespace)
+	case *clusterUnregistered:
+		return rcsw.cleanupMirroredResources()
+	case *orphanedServicesGcTriggered:
+		return rcsw.cleanupOrphanedServices()
+	default:
+		return fmt.Errorf("unknown event type %T", event)
+	}
+}
+
+func (rcsw *RemoteClusterServiceWatcher) handleRemoteServiceUpdated(remoteService *corev1.Service) error {
+	localName := multicluster.MirroredServiceName(remoteService.Name, rcsw.clusterName)
+	localService, err := rcsw.localAPIClient.Client.CoreV1().Services(remoteService.Namespace).Get(localName, metav1.GetOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil && rcsw.isMirroredResource(localService.Labels)
+
+	switch {
+	case isExported(remoteService) && exists:
+		if localService.Annotations[consts.RemoteResourceVersionAnnotation] != remoteService.ResourceVersion {
+			if err := rcsw.updateMirroredService(remoteService, localService); err != nil {
+				return err
+			}
+		}
+	case isExported(remoteService):
+		if err := rcsw.createMirroredService(remoteService); err != nil {
+			return err
+		}
+	case exists:
+		// the remote service is not exported anymore
+		if err := rcsw.deleteMirroredService(localService.Name, localService.Namespace); err != nil {
+			return err
+		}
+	}
+
+	// the updated service might be the gateway of some mirrored services
+	return rcsw.updateGatewayEndpoints(remoteService.Name, remoteService.Namespace)
+}
+
+func (rcsw *RemoteClusterServiceWatcher) handleRemoteServiceDeleted(name, namespace string) error {
+	localName := multicluster.MirroredServiceName(name, rcsw.clusterName)
+	localService, err := rcsw.localAPIClient.Client.CoreV1().Services(namespace).Get(localName, metav1.GetOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && rcsw.isMirroredResource(localService.Labels) {
+		if err := rcsw.deleteMirroredService(localName, namespace); err != nil {
+			return err
+		}
+	}
+
+	// the deleted service might be the gateway of some mirrored services
+	return rcsw.updateGatewayEndpoints(name, namespace)
+}
+
+func (rcsw *RemoteClusterServiceWatcher) createMirroredService(remoteService *corev1.Service) error {
+	if err := rcsw.ensureNamespace(remoteService.Namespace); err != nil {
+		return err
+	}
+
+	gatewayName := remoteService.Annotations[consts.GatewayNameAnnotation]
+	gatewayNs := remoteService.Annotations[consts.GatewayNsAnnotation]
+	localName := multicluster.MirroredServiceName(remoteService.Name, rcsw.clusterName)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        localName,
+			Namespace:   remoteService.Namespace,
+			Labels:      rcsw.mirroredResourceLabels(gatewayName, gatewayNs),
+			Annotations: rcsw.mirroredResourceAnnotations(remoteService),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: remapPorts(remoteService.Spec.Ports),
+		},
+	}
+
+	rcsw.log.Infof("Creating mirrored service %s/%s", service.Namespace, service.Name)
+	if _, err := rcsw.localAPIClient.Client.CoreV1().Services(service.Namespace).Create(service); err != nil {
+		return err
+	}
+
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      localName,
+			Namespace: remoteService.Namespace,
+			Labels:    rcsw.mirroredResourceLabels(gatewayName, gatewayNs),
+		},
+		Subsets: rcsw.gatewaySubsets(gatewayName, gatewayNs, service.Spec.Ports),
+	}
+
+	_, err := rcsw.localAPIClient.Client.CoreV1().Endpoints(endpoints.Namespace).Create(endpoints)
+	return err
+}
+
+func (rcsw *RemoteClusterServiceWatcher) updateMirroredService(remoteService *corev1.Service, localService *corev1.Service) error {
+	gatewayName := remoteService.Annotations[consts.GatewayNameAnnotation]
+	gatewayNs := remoteService.Annotations[consts.GatewayNsAnnotation]
+
+	service := localService.DeepCopy()
+	service.Labels = rcsw.mirroredResourceLabels(gatewayName, gatewayNs)
+	service.Annotations = rcsw.mirroredResourceAnnotations(remoteService)
+	service.Spec.Ports = remapPorts(remoteService.Spec.Ports)
+
+	rcsw.log.Infof("Updating mirrored service %s/%s", service.Namespace, service.Name)
+	if _, err := rcsw.localAPIClient.Client.CoreV1().Services(service.Namespace).Update(service); err != nil {
+		return err
+	}
+
+	return rcsw.updateMirroredEndpoints(service, gatewayName, gatewayNs)
+}
+
+func (rcsw *RemoteClusterServiceWatcher) updateMirroredEndpoints(service *corev1.Service, gatewayName, gatewayNs string) error {
+	client := rcsw.localAPIClient.Client.CoreV1().Endpoints(service.Namespace)
+	subsets := rcsw.gatewaySubsets(gatewayName, gatewayNs, service.Spec.Ports)
+
+	endpoints, err := client.Get(service.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		_, err = client.Create(&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      service.Name,
+				Namespace: service.Namespace,
+				Labels:    rcsw.mirroredResourceLabels(gatewayName, gatewayNs),
+			},
+			Subsets: subsets,
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	updated := endpoints.DeepCopy()
+	updated.Labels = rcsw.mirroredResourceLabels(gatewayName, gatewayNs)
+	updated.Subsets = subsets
+	_, err = client.Update(updated)
+	return err
+}
+
+func (rcsw *RemoteClusterServiceWatcher) deleteMirroredService(name, namespace string) error {
+	rcsw.log.Infof("Deleting mirrored service %s/%s", namespace, name)
+	err := rcsw.localAPIClient.Client.CoreV1().Services(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	err = rcsw.localAPIClient.Client.CoreV1().Endpoints(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// updateGatewayEndpoints refreshes the Endpoints of all the mirrored
+// Services that route through the given remote gateway.
+func (rcsw *RemoteClusterServiceWatcher) updateGatewayEndpoints(gatewayName, gatewayNs string) error {
+	selector := labels.Set(rcsw.mirroredResourceLabels(gatewayName, gatewayNs)).AsSelector()
+	services, err := rcsw.localAPIClient.Client.CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+
+	for i := range services.Items {
+		service := &services.Items[i]
+		rcsw.log.Debugf("Updating endpoints of %s/%s after change of gateway %s/%s", service.Namespace, service.Name, gatewayNs, gatewayName)
+		if err := rcsw.updateMirroredEndpoints(service, gatewayName, gatewayNs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupMirroredResources deletes all the Services mirrored from the remote
+// cluster, along with their Endpoints.
+func (rcsw *RemoteClusterServiceWatcher) cleanupMirroredResources() error {
+	services, err := rcsw.listMirroredServices()
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if err := rcsw.deleteMirroredService(service.Name, service.Namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupOrphanedServices deletes the mirrored Services whose remote
+// counterpart doesn't exist or isn't exported anymore.
+func (rcsw *RemoteClusterServiceWatcher) cleanupOrphanedServices() error {
+	services, err := rcsw.listMirroredServices()
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		remoteName := strings.TrimSuffix(service.Name, "-"+rcsw.clusterName)
+		remoteService, err := rcsw.remoteAPIClient.Svc().Lister().Services(service.Namespace).Get(remoteName)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil && isExported(remoteService) {
+			continue
+		}
+		if err := rcsw.deleteMirroredService(service.Name, service.Namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rcsw *RemoteClusterServiceWatcher) listMirroredServices() ([]corev1.Service, error) {
+	selector := labels.Set{
+		consts.MirroredResourceLabel:  "true",
+		consts.RemoteClusterNameLabel: rcsw.clusterName,
+	}.AsSelector()
+	services, err := rcsw.localAPIClient.Client.CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	return services.Items, nil
+}
+
+// gatewaySubsets returns the Endpoints subsets pointing at the given remote
+// gateway, for the given ports. If the gateway cannot be resolved, no subsets
+// are returned so that the mirrored Service has no endpoints until the
+// gateway becomes available.
+func (rcsw *RemoteClusterServiceWatcher) gatewaySubsets(gatewayName, gatewayNs string, ports []corev1.ServicePort) []corev1.EndpointSubset {
+	gateway, err := rcsw.remoteAPIClient.Svc().Lister().Services(gatewayNs).Get(gatewayName)
+	if err != nil {
+		rcsw.log.Warnf("Cannot resolve gateway %s/%s: %s", gatewayNs, gatewayName, err)
+		return nil
+	}
+
+	ips, port, err := multicluster.GatewayAddresses(gateway)
+	if err != nil {
+		rcsw.log.Warnf("Cannot resolve gateway addresses: %s", err)
+		return nil
+	}
+
+	subset := corev1.EndpointSubset{}
+	for _, ip := range ips {
+		subset.Addresses = append(subset.Addresses, corev1.EndpointAddress{IP: ip})
+	}
+	for _, p := range ports {
+		subset.Ports = append(subset.Ports, corev1.EndpointPort{
+			Name:     p.Name,
+			Protocol: p.Protocol,
+			Port:     port,
+		})
+	}
+	return []corev1.EndpointSubset{subset}
+}
+
+func (rcsw *RemoteClusterServiceWatcher) ensureNamespace(name string) error {
+	_, err := rcsw.localAPIClient.Client.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	rcsw.log.Infof("Creating namespace %s for mirrored services", name)
+	_, err = rcsw.localAPIClient.Client.CoreV1().Namespaces().Create(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				consts.MirroredResourceLabel: "true",
+			},
+		},
+	})
+	if kerrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func (rcsw *RemoteClusterServiceWatcher) isMirroredResource(resourceLabels map[string]string) bool {
+	return resourceLabels[consts.MirroredResourceLabel] == "true" &&
+		resourceLabels[consts.RemoteClusterNameLabel] == rcsw.clusterName
+}
+
+func (rcsw *RemoteClusterServiceWatcher) mirroredResourceLabels(gatewayName, gatewayNs string) map[string]string {
+	return map[string]string{
+		consts.MirroredResourceLabel:  "true",
+		consts.RemoteClusterNameLabel: rcsw.clusterName,
+		consts.RemoteGatewayNameLabel: gatewayName,
+		consts.RemoteGatewayNsLabel:   gatewayNs,
+	}
+}
+
+func (rcsw *RemoteClusterServiceWatcher) mirroredResourceAnnotations(remoteService *corev1.Service) map[string]string {
+	return map[string]string{
+		consts.RemoteResourceVersionAnnotation: remoteService.ResourceVersion,
+		consts.RemoteServiceFqName:             fmt.Sprintf("%s.%s.svc.%s", remoteService.Name, remoteService.Namespace, rcsw.clusterDomain),
+	}
+}
+
+// isExported returns true if the remote Service carries the annotations that
+// designate the gateway routing traffic to it.
+func isExported(service *corev1.Service) bool {
+	return service.Annotations[consts.GatewayNameAnnotation] != "" &&
+		service.Annotations[consts.GatewayNsAnnotation] != ""
+}
+
+// remapPorts copies the given ports, leaving out node ports and target ports,
+// which don't apply to mirrored Services.
+func remapPorts(ports []corev1.ServicePort) []corev1.ServicePort {
+	remapped := make([]corev1.ServicePort, len(ports))
+	for i, p := range ports {
+		remapped[i] = corev1.ServicePort{
+			Name:     p.Name,
+			Protocol: p.Protocol,
+			Port:     p.Port,
+		}
+	}
+	return remapped
+}
@@ -0,0 +1,315 @@
+package servicemirror
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	consts "github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	gatewayService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: gateway
+  namespace: gateway-ns
+spec:
+  type: LoadBalancer
+  ports:
+  - name: incoming-port
+    port: 4180
+status:
+  loadBalancer:
+    ingress:
+    - ip: 192.0.2.10`
+
+	exportedService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: books
+  namespace: bookapp
+  resourceVersion: "1"
+  annotations:
+    mirror.linkerd.io/gateway-name: gateway
+    mirror.linkerd.io/gateway-ns: gateway-ns
+spec:
+  ports:
+  - name: http
+    port: 8080
+    protocol: TCP
+  - name: admin
+    port: 9990
+    protocol: TCP`
+
+	notExportedService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: authors
+  namespace: bookapp
+  resourceVersion: "1"
+spec:
+  ports:
+  - name: http
+    port: 7001`
+)
+
+func newTestWatcher(t *testing.T, localConfigs []string, remoteConfigs []string) *RemoteClusterServiceWatcher {
+	localAPI, err := k8s.NewFakeAPI(localConfigs...)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	remoteAPI, err := k8s.NewFakeAPI(remoteConfigs...)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	remoteAPI.Sync()
+
+	return newRemoteClusterServiceWatcher(localAPI, remoteAPI, "remote", "cluster.local", 3)
+}
+
+func getRemoteService(t *testing.T, rcsw *RemoteClusterServiceWatcher, name, namespace string) *corev1.Service {
+	svc, err := rcsw.remoteAPIClient.Client.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error getting remote service: %s", err)
+	}
+	return svc
+}
+
+func TestRemoteServiceUpdated(t *testing.T) {
+	t.Run("Mirrors exported services, pointing their endpoints at the gateway", func(t *testing.T) {
+		rcsw := newTestWatcher(t, nil, []string{gatewayService, exportedService})
+
+		err := rcsw.processEvent(&remoteServiceUpdated{service: getRemoteService(t, rcsw, "books", "bookapp")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		svc, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("books-remote", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Expected mirrored service to be created, got: %s", err)
+		}
+		expectedLabels := map[string]string{
+			consts.MirroredResourceLabel:  "true",
+			consts.RemoteClusterNameLabel: "remote",
+			consts.RemoteGatewayNameLabel: "gateway",
+			consts.RemoteGatewayNsLabel:   "gateway-ns",
+		}
+		if !reflect.DeepEqual(svc.Labels, expectedLabels) {
+			t.Fatalf("Expected labels %v, got %v", expectedLabels, svc.Labels)
+		}
+		if fqName := svc.Annotations[consts.RemoteServiceFqName]; fqName != "books.bookapp.svc.cluster.local" {
+			t.Fatalf("Expected remote name books.bookapp.svc.cluster.local, got %s", fqName)
+		}
+		if len(svc.Spec.Ports) != 2 {
+			t.Fatalf("Expected 2 ports, got %d", len(svc.Spec.Ports))
+		}
+
+		ns, err := rcsw.localAPIClient.Client.CoreV1().Namespaces().Get("bookapp", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Expected namespace to be created, got: %s", err)
+		}
+		if ns.Labels[consts.MirroredResourceLabel] != "true" {
+			t.Fatalf("Expected namespace to be labeled as mirrored, got %v", ns.Labels)
+		}
+
+		endpoints, err := rcsw.localAPIClient.Client.CoreV1().Endpoints("bookapp").Get("books-remote", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Expected mirrored endpoints to be created, got: %s", err)
+		}
+		expectedSubsets := []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "192.0.2.10"}},
+				Ports: []corev1.EndpointPort{
+					{Name: "http", Protocol: corev1.ProtocolTCP, Port: 4180},
+					{Name: "admin", Protocol: corev1.ProtocolTCP, Port: 4180},
+				},
+			},
+		}
+		if !reflect.DeepEqual(endpoints.Subsets, expectedSubsets) {
+			t.Fatalf("Expected subsets %+v, got %+v", expectedSubsets, endpoints.Subsets)
+		}
+	})
+
+	t.Run("Ignores services that are not exported", func(t *testing.T) {
+		rcsw := newTestWatcher(t, nil, []string{gatewayService, notExportedService})
+
+		err := rcsw.processEvent(&remoteServiceUpdated{service: getRemoteService(t, rcsw, "authors", "bookapp")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		_, err = rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("authors-remote", metav1.GetOptions{})
+		if !kerrors.IsNotFound(err) {
+			t.Fatalf("Expected mirrored service not to exist, got: %v", err)
+		}
+	})
+
+	t.Run("Updates mirrored services when the remote service changes", func(t *testing.T) {
+		rcsw := newTestWatcher(t, nil, []string{gatewayService, exportedService})
+		remote := getRemoteService(t, rcsw, "books", "bookapp")
+		if err := rcsw.processEvent(&remoteServiceUpdated{service: remote}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		updated := remote.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Spec.Ports = updated.Spec.Ports[:1]
+		if err := rcsw.processEvent(&remoteServiceUpdated{service: updated}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		svc, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("books-remote", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if svc.Annotations[consts.RemoteResourceVersionAnnotation] != "2" {
+			t.Fatalf("Expected resource version 2, got %s", svc.Annotations[consts.RemoteResourceVersionAnnotation])
+		}
+		if len(svc.Spec.Ports) != 1 {
+			t.Fatalf("Expected 1 port, got %d", len(svc.Spec.Ports))
+		}
+	})
+
+	t.Run("Deletes mirrored services when the remote service is not exported anymore", func(t *testing.T) {
+		rcsw := newTestWatcher(t, nil, []string{gatewayService, exportedService})
+		remote := getRemoteService(t, rcsw, "books", "bookapp")
+		if err := rcsw.processEvent(&remoteServiceUpdated{service: remote}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		updated := remote.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Annotations = nil
+		if err := rcsw.processEvent(&remoteServiceUpdated{service: updated}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		_, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("books-remote", metav1.GetOptions{})
+		if !kerrors.IsNotFound(err) {
+			t.Fatalf("Expected mirrored service to be deleted, got: %v", err)
+		}
+	})
+}
+
+func TestRemoteServiceDeleted(t *testing.T) {
+	rcsw := newTestWatcher(t, nil, []string{gatewayService, exportedService})
+	if err := rcsw.processEvent(&remoteServiceUpdated{service: getRemoteService(t, rcsw, "books", "bookapp")}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := rcsw.processEvent(&remoteServiceDeleted{name: "books", namespace: "bookapp"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	_, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("books-remote", metav1.GetOptions{})
+	if !kerrors.IsNotFound(err) {
+		t.Fatalf("Expected mirrored service to be deleted, got: %v", err)
+	}
+	_, err = rcsw.localAPIClient.Client.CoreV1().Endpoints("bookapp").Get("books-remote", metav1.GetOptions{})
+	if !kerrors.IsNotFound(err) {
+		t.Fatalf("Expected mirrored endpoints to be deleted, got: %v", err)
+	}
+}
+
+func TestGatewayDeleted(t *testing.T) {
+	rcsw := newTestWatcher(t, nil, []string{gatewayService, exportedService})
+	if err := rcsw.processEvent(&remoteServiceUpdated{service: getRemoteService(t, rcsw, "books", "bookapp")}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// simulate the gateway going away in the remote cluster
+	remoteAPI, err := k8s.NewFakeAPI(exportedService)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	remoteAPI.Sync()
+	rcsw.remoteAPIClient = remoteAPI
+
+	if err := rcsw.processEvent(&remoteServiceDeleted{name: "gateway", namespace: "gateway-ns"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	endpoints, err := rcsw.localAPIClient.Client.CoreV1().Endpoints("bookapp").Get("books-remote", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(endpoints.Subsets) != 0 {
+		t.Fatalf("Expected no subsets, got %+v", endpoints.Subsets)
+	}
+}
+
+func TestCleanupOrphanedServices(t *testing.T) {
+	orphaned := `
+apiVersion: v1
+kind: Service
+metadata:
+  name: orphaned-remote
+  namespace: bookapp
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote
+spec:
+  ports:
+  - port: 80`
+	otherCluster := `
+apiVersion: v1
+kind: Service
+metadata:
+  name: orphaned-other
+  namespace: bookapp
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: other
+spec:
+  ports:
+  - port: 80`
+	mirrored := `
+apiVersion: v1
+kind: Service
+metadata:
+  name: books-remote
+  namespace: bookapp
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: remote
+spec:
+  ports:
+  - port: 8080`
+
+	rcsw := newTestWatcher(t, []string{orphaned, otherCluster, mirrored}, []string{gatewayService, exportedService})
+	if err := rcsw.processEvent(&orphanedServicesGcTriggered{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for name, shouldExist := range map[string]bool{
+		"orphaned-remote": false,
+		"orphaned-other":  true,
+		"books-remote":    true,
+	} {
+		_, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get(name, metav1.GetOptions{})
+		if shouldExist && err != nil {
+			t.Fatalf("Expected service %s to exist, got: %s", name, err)
+		}
+		if !shouldExist && !kerrors.IsNotFound(err) {
+			t.Fatalf("Expected service %s to be deleted, got: %v", name, err)
+		}
+	}
+
+	if err := rcsw.processEvent(&clusterUnregistered{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("books-remote", metav1.GetOptions{})
+	if !kerrors.IsNotFound(err) {
+		t.Fatalf("Expected service books-remote to be deleted, got: %v", err)
+	}
+	if _, err := rcsw.localAPIClient.Client.CoreV1().Services("bookapp").Get("orphaned-other", metav1.GetOptions{}); err != nil {
+		t.Fatalf("Expected service orphaned-other to exist, got: %s", err)
+	}
+}
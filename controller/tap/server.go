@@ -16,12 +16,14 @@ import (
 	"github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/addr"
+	"github.com/linkerd/linkerd2/pkg/identity"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/util"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -39,6 +41,7 @@ type GRPCTapServer struct {
 	k8sAPI              *k8s.API
 	controllerNamespace string
 	trustDomain         string
+	creds               *identity.ComponentCredentials
 }
 
 var (
@@ -138,7 +141,7 @@ func (s *GRPCTapServer) TapByResource(req *public.TapByResourceRequest, stream p
 		ctx = metadata.AppendToOutgoingContext(ctx, requireIDHeader, name)
 
 		// initiate a tap on the pod
-		go s.tapProxy(ctx, rpsPerPod, match, extract, pod.Status.PodIP, events)
+		go s.tapProxy(ctx, rpsPerPod, match, extract, pod.Status.PodIP, name, events)
 	}
 
 	// read events from the taps and send them back
@@ -276,10 +279,19 @@ func buildExtractHTTP(extract *public.TapByResourceRequest_Extract_Http) *proxy.
 // of maxRps * 1s at most once per 1s window.  If this limit is reached in
 // less than 1s, we sleep until the end of the window before calling Observe
 // again.
-func (s *GRPCTapServer) tapProxy(ctx context.Context, maxRps float32, match *proxy.ObserveRequest_Match, extract *proxy.ObserveRequest_Extract, addr string, events chan *public.TapEvent) {
+func (s *GRPCTapServer) tapProxy(ctx context.Context, maxRps float32, match *proxy.ObserveRequest_Match, extract *proxy.ObserveRequest_Extract, addr, name string, events chan *public.TapEvent) {
 	tapAddr := fmt.Sprintf("%s:%d", addr, s.tapPort)
 	log.Infof("Establishing tap on %s", tapAddr)
-	conn, err := grpc.DialContext(ctx, tapAddr, grpc.WithInsecure())
+
+	// without credentials, the proxy of the tap server establishes mTLS with
+	// the identity required by the requireIDHeader
+	transport := grpc.WithInsecure()
+	if s.creds != nil {
+		tlsConfig := s.creds.ClientTLSConfig(name)
+		tlsConfig.ServerName = name
+		transport = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	conn, err := grpc.DialContext(ctx, tapAddr, transport)
 	if err != nil {
 		log.Error(err)
 		return
@@ -512,11 +524,12 @@ func NewGrpcTapServer(
 	controllerNamespace string,
 	trustDomain string,
 	k8sAPI *k8s.API,
+	creds *identity.ComponentCredentials,
 ) *GRPCTapServer {
 	k8sAPI.Pod().Informer().AddIndexers(cache.Indexers{ipIndex: indexByIP})
 	k8sAPI.Node().Informer().AddIndexers(cache.Indexers{ipIndex: indexByIP})
 
-	return newGRPCTapServer(tapPort, controllerNamespace, trustDomain, k8sAPI)
+	return newGRPCTapServer(tapPort, controllerNamespace, trustDomain, k8sAPI, creds)
 }
 
 func newGRPCTapServer(
@@ -524,12 +537,14 @@ func newGRPCTapServer(
 	controllerNamespace string,
 	trustDomain string,
 	k8sAPI *k8s.API,
+	creds *identity.ComponentCredentials,
 ) *GRPCTapServer {
 	srv := &GRPCTapServer{
 		tapPort:             tapPort,
 		k8sAPI:              k8sAPI,
 		controllerNamespace: controllerNamespace,
 		trustDomain:         trustDomain,
+		creds:               creds,
 	}
 
 	s := prometheus.NewGrpcServer()
@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	log "github.com/sirupsen/logrus"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AuthenticatedHandlerFunc handles the requests of a user of the Kubernetes
+// API, served by the webhook server besides the admission reviews
+type AuthenticatedHandlerFunc func(api *k8s.API, user authnv1.UserInfo, w http.ResponseWriter, req *http.Request)
+
+// authenticator authenticates the users of the Kubernetes API the same way the
+// API server does: by a client certificate issued by the cluster's client CA,
+// or by a bearer token reviewed by the API server.
+type authenticator struct {
+	client    kubernetes.Interface
+	clientCAs *x509.CertPool
+}
+
+// newAuthenticator reads the cluster's client CA from the
+// extension-apiserver-authentication ConfigMap. When it can't be read, only
+// the bearer tokens are accepted.
+func newAuthenticator(client kubernetes.Interface) *authenticator {
+	a := &authenticator{client: client}
+
+	cm, err := client.CoreV1().ConfigMaps("kube-system").Get("extension-apiserver-authentication", metav1.GetOptions{})
+	if err != nil {
+		log.Warnf("client certificates won't be accepted, failed to read the cluster's client CA: %s", err)
+		return a
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM([]byte(cm.Data["client-ca-file"])) {
+		log.Warn("client certificates won't be accepted, the cluster's client CA is missing")
+		return a
+	}
+	a.clientCAs = clientCAs
+	return a
+}
+
+// authenticate returns the user presenting the request
+func (a *authenticator) authenticate(req *http.Request) (authnv1.UserInfo, error) {
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		return a.authenticateCertificate(req.TLS.PeerCertificates)
+	}
+
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return authnv1.UserInfo{}, errors.New("no client certificate or bearer token presented")
+	}
+	return a.authenticateToken(strings.TrimPrefix(auth, "Bearer "))
+}
+
+func (a *authenticator) authenticateCertificate(certs []*x509.Certificate) (authnv1.UserInfo, error) {
+	if a.clientCAs == nil {
+		return authnv1.UserInfo{}, errors.New("client certificates aren't accepted")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, crt := range certs[1:] {
+		intermediates.AddCert(crt)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         a.clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return authnv1.UserInfo{}, fmt.Errorf("invalid client certificate: %s", err)
+	}
+
+	// as done by the API server, the user is the common name and its groups
+	// are the organizations
+	return authnv1.UserInfo{
+		Username: certs[0].Subject.CommonName,
+		Groups:   certs[0].Subject.Organization,
+	}, nil
+}
+
+func (a *authenticator) authenticateToken(token string) (authnv1.UserInfo, error) {
+	tr := &authnv1.TokenReview{Spec: authnv1.TokenReviewSpec{Token: token}}
+	rvw, err := a.client.AuthenticationV1().TokenReviews().Create(tr)
+	if err != nil {
+		return authnv1.UserInfo{}, err
+	}
+	if rvw.Status.Error != "" {
+		return authnv1.UserInfo{}, fmt.Errorf("invalid bearer token: %s", rvw.Status.Error)
+	}
+	if !rvw.Status.Authenticated {
+		return authnv1.UserInfo{}, errors.New("invalid bearer token")
+	}
+	return rvw.Status.User, nil
+}
+
+// authenticated returns a handler calling h with the authenticated user, or
+// responding with an Unauthorized error
+func (a *authenticator) authenticated(api *k8s.API, h AuthenticatedHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		user, err := a.authenticate(req)
+		if err != nil {
+			log.Infof("rejected request to %s: %s", req.URL.Path, err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		h(api, user, w, req)
+	}
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgTls "github.com/linkerd/linkerd2/pkg/tls"
+	authnv1 "k8s.io/api/authentication/v1"
+)
+
+func issueClientCrt(t *testing.T, ca *pkgTls.CA, user string, groups ...string) *x509.Certificate {
+	key, err := pkgTls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	crt, err := ca.IssueEndEntityCrt(&x509.CertificateRequest{
+		Subject:   pkix.Name{CommonName: user, Organization: groups},
+		PublicKey: &key.PublicKey,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return crt.Certificate
+}
+
+func TestAuthenticate(t *testing.T) {
+	clientCA, err := pkgTls.GenerateRootCAWithDefaults("kubernetes")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	otherCA, err := pkgTls.GenerateRootCAWithDefaults("other")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	clientCAPEM := strings.Replace(strings.TrimSpace(clientCA.Cred.Crt.EncodePEM()), "\n", "\n    ", -1)
+	k8sAPI, err := k8s.NewFakeAPI(fmt.Sprintf(`
+kind: ConfigMap
+apiVersion: v1
+metadata:
+  name: extension-apiserver-authentication
+  namespace: kube-system
+data:
+  client-ca-file: |
+    %s
+`, clientCAPEM))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	authn := newAuthenticator(k8sAPI.Client)
+
+	testCases := []struct {
+		name     string
+		crt      *x509.Certificate
+		token    string
+		expected *authnv1.UserInfo
+	}{
+		{
+			name:     "client certificate issued by the client CA",
+			crt:      issueClientCrt(t, clientCA, "jane", "developers"),
+			expected: &authnv1.UserInfo{Username: "jane", Groups: []string{"developers"}},
+		},
+		{
+			name: "client certificate issued by another CA",
+			crt:  issueClientCrt(t, otherCA, "jane", "developers"),
+		},
+		{
+			// the fake API server doesn't authenticate any token
+			name:  "unauthenticated bearer token",
+			token: "token",
+		},
+		{
+			name: "no credentials",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/dry-run", nil)
+			if tc.crt != nil {
+				req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tc.crt}}
+			}
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			user, err := authn.authenticate(req)
+			if tc.expected == nil {
+				if err == nil {
+					t.Fatalf("Expected an error, got user %+v", user)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(user, *tc.expected) {
+				t.Fatalf("Expected user %+v, got %+v", *tc.expected, user)
+			}
+		})
+	}
+}
@@ -18,7 +18,7 @@ import (
 )
 
 // Launch sets up and starts the webhook and metrics servers
-func Launch(APIResources []k8s.APIResource, metricsPort uint32, handler handlerFunc, component, subcommand string, args []string) {
+func Launch(APIResources []k8s.APIResource, metricsPort uint32, handler handlerFunc, authenticatedHandlers map[string]AuthenticatedHandlerFunc, component, subcommand string, args []string) {
 	cmd := flag.NewFlagSet(subcommand, flag.ExitOnError)
 
 	metricsAddr := cmd.String("metrics-addr", fmt.Sprintf(":%d", metricsPort), "address to serve scrapable metrics on")
@@ -41,7 +41,7 @@ func Launch(APIResources []k8s.APIResource, metricsPort uint32, handler handlerF
 		log.Fatalf("failed to read TLS secrets: %s", err)
 	}
 
-	s, err := NewServer(k8sAPI, *addr, cred, handler, component)
+	s, err := NewServer(k8sAPI, *addr, cred, handler, authenticatedHandlers, component)
 	if err != nil {
 		log.Fatalf("failed to initialize the webhook server: %s", err)
 	}
@@ -29,8 +29,10 @@ type Server struct {
 	recorder record.EventRecorder
 }
 
-// NewServer returns a new instance of Server
-func NewServer(api *k8s.API, addr string, cred *pkgTls.Cred, handler handlerFunc, component string) (*Server, error) {
+// NewServer returns a new instance of Server. Besides the admission reviews,
+// it serves the authenticatedHandlers at their path, to the authenticated
+// users of the Kubernetes API.
+func NewServer(api *k8s.API, addr string, cred *pkgTls.Cred, handler handlerFunc, authenticatedHandlers map[string]AuthenticatedHandlerFunc, component string) (*Server, error) {
 	var (
 		certPEM = cred.EncodePEM()
 		keyPEM  = cred.EncodePrivateKeyPEM()
@@ -45,6 +47,10 @@ func NewServer(api *k8s.API, addr string, cred *pkgTls.Cred, handler handlerFunc
 		Addr: addr,
 		TLSConfig: &tls.Config{
 			Certificates: []tls.Certificate{cert},
+			// the client certificates are verified by the authenticator, so
+			// that the admission reviews are accepted regardless of the
+			// certificate presented by the API server
+			ClientAuth: tls.RequestClientCert,
 		},
 	}
 
@@ -57,7 +63,15 @@ func NewServer(api *k8s.API, addr string, cred *pkgTls.Cred, handler handlerFunc
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: component})
 
 	s := &Server{server, api, handler, recorder}
-	s.Handler = http.HandlerFunc(s.serve)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.serve)
+	if len(authenticatedHandlers) > 0 {
+		authn := newAuthenticator(api.Client)
+		for path, h := range authenticatedHandlers {
+			mux.Handle(path, authn.authenticated(api, h))
+		}
+	}
+	s.Handler = mux
 	return s, nil
 }
 
@@ -45,9 +45,17 @@ func PublicIPToString(ip *public.IPAddress) string {
 }
 
 // ProxyAddressToString formats a Proxy API TCPAddress as a string.
+//
+// If Ipv6, the IP address is enclosed in square brackets followed by the
+// port.
 func ProxyAddressToString(addr *pb.TcpAddress) string {
-	octects := decodeIPToOctets(addr.GetIp().GetIpv4())
-	return fmt.Sprintf("%d.%d.%d.%d:%d", octects[0], octects[1], octects[2], octects[3], addr.GetPort())
+	var s string
+	if addr.GetIp().GetIpv6() != nil {
+		s = "[%s]:%d"
+	} else {
+		s = "%s:%d"
+	}
+	return fmt.Sprintf(s, ProxyIPToString(addr.GetIp()), addr.GetPort())
 }
 
 // ProxyAddressesToString formats a list of Proxy API TCPAddresses as a string.
@@ -61,6 +69,12 @@ func ProxyAddressesToString(addrs []pb.TcpAddress) string {
 
 // ProxyIPToString formats a Proxy API IPAddress as a string.
 func ProxyIPToString(ip *pb.IPAddress) string {
+	if ip.GetIpv6() != nil {
+		b := make([]byte, 16)
+		binary.BigEndian.PutUint64(b[:8], ip.GetIpv6().GetFirst())
+		binary.BigEndian.PutUint64(b[8:], ip.GetIpv6().GetLast())
+		return net.IP(b).String()
+	}
 	octets := decodeIPToOctets(ip.GetIpv4())
 	return fmt.Sprintf("%d.%d.%d.%d", octets[0], octets[1], octets[2], octets[3])
 }
@@ -75,6 +89,31 @@ func ProxyIPV4(a1, a2, a3, a4 uint8) *pb.IPAddress {
 	}
 }
 
+// ProxyIPV6 encodes 16 big-endian bytes as a Proxy API IPAddress.
+func ProxyIPV6(ip net.IP) *pb.IPAddress {
+	return &pb.IPAddress{
+		Ip: &pb.IPAddress_Ipv6{
+			Ipv6: &pb.IPv6{
+				First: binary.BigEndian.Uint64(ip[:8]),
+				Last:  binary.BigEndian.Uint64(ip[8:]),
+			},
+		},
+	}
+}
+
+// ParseProxyIP parses an IPv4 or IPv6 address string into a Proxy API
+// IPAddress. IPv4-mapped IPv6 addresses are encoded as IPv4.
+func ParseProxyIP(ip string) (*pb.IPAddress, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil, fmt.Errorf("Invalid IP address: %s", ip)
+	}
+	if ip4 := parsed.To4(); ip4 != nil {
+		return ProxyIPV4(ip4[0], ip4[1], ip4[2], ip4[3]), nil
+	}
+	return ProxyIPV6(parsed.To16()), nil
+}
+
 // ParseProxyIPV4 parses an IP Address string into a Proxy API IPAddress.
 func ParseProxyIPV4(ip string) (*pb.IPAddress, error) {
 	segments := strings.Split(ip, ".")
@@ -102,6 +141,31 @@ func PublicIPV4(a1, a2, a3, a4 uint8) *public.IPAddress {
 	}
 }
 
+// PublicIPV6 encodes 16 big-endian bytes as a Public API IPAddress.
+func PublicIPV6(ip net.IP) *public.IPAddress {
+	return &public.IPAddress{
+		Ip: &public.IPAddress_Ipv6{
+			Ipv6: &public.IPv6{
+				First: binary.BigEndian.Uint64(ip[:8]),
+				Last:  binary.BigEndian.Uint64(ip[8:]),
+			},
+		},
+	}
+}
+
+// ParsePublicIP parses an IPv4 or IPv6 address string into a Public API
+// IPAddress. IPv4-mapped IPv6 addresses are encoded as IPv4.
+func ParsePublicIP(ip string) (*public.IPAddress, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil, fmt.Errorf("Invalid IP address: %s", ip)
+	}
+	if ip4 := parsed.To4(); ip4 != nil {
+		return PublicIPV4(ip4[0], ip4[1], ip4[2], ip4[3]), nil
+	}
+	return PublicIPV6(parsed.To16()), nil
+}
+
 // ParsePublicIPV4 parses an IP Address string into a Public API IPAddress.
 func ParsePublicIPV4(ip string) (*public.IPAddress, error) {
 	segments := strings.Split(ip, ".")
@@ -67,3 +67,90 @@ func TestNetToPublic(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProxyIP(t *testing.T) {
+	var testCases = []struct {
+		ip       string
+		expected *proxy.IPAddress
+		str      string
+	}{
+		{
+			ip:       "10.1.2.3",
+			expected: ProxyIPV4(10, 1, 2, 3),
+			str:      "10.1.2.3",
+		},
+		{
+			ip: "fd00::1",
+			expected: &proxy.IPAddress{
+				Ip: &proxy.IPAddress_Ipv6{
+					Ipv6: &proxy.IPv6{
+						First: 0xfd00000000000000,
+						Last:  1,
+					},
+				},
+			},
+			str: "fd00::1",
+		},
+		{
+			ip:       "::ffff:10.1.2.3",
+			expected: ProxyIPV4(10, 1, 2, 3),
+			str:      "10.1.2.3",
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("%d: %s", i, tc.ip), func(t *testing.T) {
+			ip, err := ParseProxyIP(tc.ip)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !proto.Equal(ip, tc.expected) {
+				t.Fatalf("Unexpected IP Address: [%+v] expected: [%+v]", ip, tc.expected)
+			}
+			if str := ProxyIPToString(ip); str != tc.str {
+				t.Fatalf("Expected [%s], got [%s]", tc.str, str)
+			}
+		})
+	}
+
+	t.Run("Returns an error for invalid addresses", func(t *testing.T) {
+		if _, err := ParseProxyIP("10.1.2"); err == nil {
+			t.Fatalf("Expected error, got nothing")
+		}
+	})
+}
+
+func TestProxyAddressToString(t *testing.T) {
+	var testCases = []struct {
+		ip       string
+		port     uint32
+		expected string
+	}{
+		{ip: "10.1.2.3", port: 8080, expected: "10.1.2.3:8080"},
+		{ip: "fd00:10:244::5", port: 8080, expected: "[fd00:10:244::5]:8080"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.expected, func(t *testing.T) {
+			ip, err := ParseProxyIP(tc.ip)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			str := ProxyAddressToString(&proxy.TcpAddress{Ip: ip, Port: tc.port})
+			if str != tc.expected {
+				t.Fatalf("Expected [%s], got [%s]", tc.expected, str)
+			}
+
+			publicIP, err := ParsePublicIP(tc.ip)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			str = PublicAddressToString(&public.TcpAddress{Ip: publicIP, Port: tc.port})
+			if str != tc.expected {
+				t.Fatalf("Expected [%s], got [%s]", tc.expected, str)
+			}
+		})
+	}
+}
@@ -31,6 +31,8 @@ type (
 		PrometheusLogLevel          string            `json:"prometheusLogLevel"`
 		ControllerUID               int64             `json:"controllerUID"`
 		EnableH2Upgrade             bool              `json:"enableH2Upgrade"`
+		ControlPlaneMTLS            bool              `json:"controlPlaneMTLS"`
+		EnableEndpointSlices        bool              `json:"enableEndpointSlices"`
 		EnablePodAntiAffinity       bool              `json:"enablePodAntiAffinity"`
 		WebhookFailurePolicy        string            `json:"webhookFailurePolicy"`
 		OmitWebhookSideEffects      bool              `json:"omitWebhookSideEffects"`
@@ -48,6 +50,7 @@ type (
 		Tap                         *Tap              `json:"tap"`
 		NodeSelector                map[string]string `json:"nodeSelector"`
 
+		CanaryResources        *Resources `json:"canaryResources"`
 		DestinationResources   *Resources `json:"destinationResources"`
 		GrafanaResources       *Resources `json:"grafanaResources"`
 		HeartbeatResources     *Resources `json:"heartbeatResources"`
@@ -78,6 +81,7 @@ type (
 		ControlPlaneTracing      bool   `json:"controlPlaneTracing"`
 		IdentityTrustAnchorsPEM  string `json:"identityTrustAnchorsPEM"`
 		IdentityTrustDomain      string `json:"identityTrustDomain"`
+		IdentityTokenAudience    string `json:"identityTokenAudience"`
 
 		Proxy     *Proxy     `json:"proxy"`
 		ProxyInit *ProxyInit `json:"proxyInit"`
@@ -105,6 +109,7 @@ type (
 		Trace                  *Trace        `json:"trace"`
 		UID                    int64         `json:"uid"`
 		WaitBeforeExitSeconds  uint64        `json:"waitBeforeExitSeconds"`
+		ShutdownContainers     []string      `json:"shutdownContainers"`
 	}
 
 	// ProxyInit contains the fields to set the proxy-init container
@@ -115,6 +120,7 @@ type (
 		Image               *Image        `json:"image"`
 		SAMountPath         *SAMountPath  `json:"saMountPath"`
 		Resources           *Resources    `json:"resources"`
+		OutboundOnly        bool          `json:"outboundOnly,omitempty"`
 	}
 
 	// DebugContainer contains the fields to set the debugging sidecar
@@ -122,6 +128,22 @@ type (
 		Image *Image `json:"image"`
 	}
 
+	// ShutdownHelper contains the fields to set the helper signaling the proxy
+	// to shut down once the wrapped containers complete
+	ShutdownHelper struct {
+		Image      *Image             `json:"image"`
+		UID        int64              `json:"uid"`
+		Containers []WrappedContainer `json:"containers"`
+	}
+
+	// WrappedContainer contains the fields to wrap the command of a container
+	// with the shutdown helper
+	WrappedContainer struct {
+		Index               int      `json:"index"`
+		Command             []string `json:"command"`
+		AddRootVolumeMounts bool     `json:"addRootVolumeMounts"`
+	}
+
 	// Image contains the details to define a container image
 	Image struct {
 		Name       string `json:"name"`
@@ -171,22 +193,50 @@ type (
 	// Identity contains the fields to set the identity variables in the proxy
 	// sidecar container
 	Identity struct {
-		Issuer *Issuer `json:"issuer"`
+		Issuer           *Issuer `json:"issuer"`
+		SPIFFEIdentities bool    `json:"spiffeIdentities"`
 	}
 
 	// Issuer has the Helm variables of the identity issuer
 	Issuer struct {
-		Scheme              string    `json:"scheme"`
-		ClockSkewAllowance  string    `json:"clockSkewAllowance"`
-		IssuanceLifetime    string    `json:"issuanceLifetime"`
-		CrtExpiryAnnotation string    `json:"crtExpiryAnnotation"`
-		CrtExpiry           time.Time `json:"crtExpiry"`
-		TLS                 *TLS      `json:"tls"`
+		Scheme              string         `json:"scheme"`
+		ClockSkewAllowance  string         `json:"clockSkewAllowance"`
+		IssuanceLifetime    string         `json:"issuanceLifetime"`
+		MaxIssuanceLifetime string         `json:"maxIssuanceLifetime"`
+		CrtExpiryAnnotation string         `json:"crtExpiryAnnotation"`
+		CrtExpiry           time.Time      `json:"crtExpiry"`
+		Renewal             *IssuerRenewal `json:"renewal"`
+		TLS                 *TLS           `json:"tls"`
+	}
+
+	// IssuerRenewal has the Helm variables of the automatic renewal of the
+	// identity issuer
+	IssuerRenewal struct {
+		Enabled      bool   `json:"enabled"`
+		AnchorSecret string `json:"anchorSecret"`
+		Threshold    string `json:"threshold"`
+		Lifetime     string `json:"lifetime"`
 	}
 
 	// ProxyInjector has all the proxy injector's Helm variables
 	ProxyInjector struct {
 		*TLS
+		InjectionPolicy *InjectionPolicy `json:"injectionPolicy,omitempty"`
+	}
+
+	// InjectionPolicy has the rules selecting the workloads the proxy injector
+	// injects without the inject annotation, or never injects
+	InjectionPolicy struct {
+		Include []*InjectionRule `json:"include,omitempty"`
+		Exclude []*InjectionRule `json:"exclude,omitempty"`
+	}
+
+	// InjectionRule matches the workloads matching all its criteria
+	InjectionRule struct {
+		NamespaceSelector string   `json:"namespaceSelector,omitempty"`
+		Kinds             []string `json:"kinds,omitempty"`
+		OwnerNames        []string `json:"ownerNames,omitempty"`
+		Images            []string `json:"images,omitempty"`
 	}
 
 	// ProfileValidator has all the profile validator's Helm variables
@@ -25,6 +25,8 @@ func TestNewValues(t *testing.T) {
 		PrometheusLogLevel:          "info",
 		ControllerUID:               2103,
 		EnableH2Upgrade:             true,
+		ControlPlaneMTLS:            false,
+		EnableEndpointSlices:        false,
 		EnablePodAntiAffinity:       false,
 		WebhookFailurePolicy:        "Ignore",
 		OmitWebhookSideEffects:      false,
@@ -102,8 +104,13 @@ func TestNewValues(t *testing.T) {
 				ClockSkewAllowance:  "20s",
 				IssuanceLifetime:    "86400s",
 				CrtExpiryAnnotation: "linkerd.io/identity-issuer-expiry",
-				TLS:                 &TLS{},
-				Scheme:              "linkerd.io/tls",
+				Renewal: &IssuerRenewal{
+					AnchorSecret: "linkerd-identity-trust-anchor",
+					Threshold:    "720h",
+					Lifetime:     "8760h",
+				},
+				TLS:    &TLS{},
+				Scheme: "linkerd.io/tls",
 			},
 		},
 		NodeSelector: map[string]string{
@@ -158,6 +165,7 @@ func TestNewValues(t *testing.T) {
 				Request: "50Mi",
 			},
 		}
+		expected.CanaryResources = controllerResources
 		expected.DestinationResources = controllerResources
 		expected.PublicAPIResources = controllerResources
 		expected.ProxyInjectorResources = controllerResources
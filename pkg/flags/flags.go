@@ -41,6 +41,18 @@ func AddTraceFlags(cmd *flag.FlagSet) *string {
 	return traceCollector
 }
 
+// AddComponentIdentityFlags adds the identity-service-account and
+// identity-token-path flags to the flagSet and returns their pointers for
+// usage. They configure the identity used by a control plane component for
+// mTLS with the other components, which is disabled when no service account
+// is set.
+func AddComponentIdentityFlags(cmd *flag.FlagSet) (*string, *string) {
+	serviceAccount := cmd.String("identity-service-account", "", "service account of the component, whose identity secures the calls to the other control plane components with mTLS; disabled when empty")
+	tokenPath := cmd.String("identity-token-path", "/var/run/secrets/kubernetes.io/serviceaccount/token", "path to the service account token authenticating the component to the identity service")
+
+	return serviceAccount, tokenPath
+}
+
 func setLogLevel(logLevel string) {
 	level, err := log.ParseLevel(logLevel)
 	if err != nil {
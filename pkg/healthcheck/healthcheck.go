@@ -7,19 +7,23 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/linkerd/linkerd2/pkg/issuercerts"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	saz "github.com/linkerd/linkerd2/controller/gen/apis/serverauthorization/v1alpha1"
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 	configPb "github.com/linkerd/linkerd2/controller/gen/config"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/identity"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/multicluster"
 	"github.com/linkerd/linkerd2/pkg/tls"
 	"github.com/linkerd/linkerd2/pkg/version"
 	log "github.com/sirupsen/logrus"
@@ -29,8 +33,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
 	k8sVersion "k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/kubernetes"
@@ -97,6 +104,11 @@ const (
 	// is compatible with the one of the control plane
 	LinkerdIdentityDataPlane CategoryID = "linkerd-identity-data-plane"
 
+	// LinkerdIdentityDataPlaneCertificates checks the certificates presented
+	// by the proxies. As it port-forwards to every meshed pod, it's only run
+	// when requested explicitly, e.g. when rotating the trust anchors
+	LinkerdIdentityDataPlaneCertificates CategoryID = "linkerd-identity-data-plane-certificates"
+
 	// LinkerdControlPlaneExistenceChecks adds a series of checks to validate that
 	// the control plane namespace and controller pod exist.
 	// These checks are dependent on the output of KubernetesAPIChecks, so those
@@ -137,6 +149,23 @@ const (
 	/// plugin is installed and ready
 	LinkerdCNIPluginChecks CategoryID = "linkerd-cni-plugin"
 
+	// LinkerdMulticlusterChecks adds checks to validate that the credentials
+	// of the linked remote clusters are valid, and that the remote gateways
+	// that mirrored services point to are reachable. These checks are no ops
+	// if no remote cluster is linked.
+	LinkerdMulticlusterChecks CategoryID = "linkerd-multicluster"
+
+	// LinkerdPolicyChecks adds checks to validate that the ServerAuthorization
+	// CRD is installed, and that the ServerAuthorizations in the cluster are
+	// valid and apply to pod ports.
+	LinkerdPolicyChecks CategoryID = "linkerd-policy"
+
+	// LinkerdControlPlaneMTLSChecks adds checks to validate that the control
+	// plane components calling each other over mTLS all obtain their
+	// certificate from the identity service. These checks are no ops if
+	// control plane mTLS is not enabled.
+	LinkerdControlPlaneMTLSChecks CategoryID = "linkerd-control-plane-mtls"
+
 	// LinkerdCNIResourceLabel is the label key that is used to identify
 	// whether a Kubernetes resource is related to the install-cni command
 	// The value is expected to be "true", "false" or "", where "false" and
@@ -151,6 +180,11 @@ const (
 	// This key is passed to checkApiSercice method to check whether
 	// the api service is available or not
 	linkerdTapAPIServiceName = "v1alpha1.tap.linkerd.io"
+
+	linkerdMulticlusterNoLinksSkipReason = "skipping check because no remote cluster is linked"
+
+	linkerdPolicyNoAuthorizationsSkipReason = "skipping check because no server authorization exists"
+	serverAuthorizationCRDName              = "serverauthorizations.policy.linkerd.io"
 )
 
 // HintBaseURL is the base URL on the linkerd.io website that all check hints
@@ -176,8 +210,9 @@ var linkerdHAControlPlaneComponents = []string{
 }
 
 var (
-	retryWindow    = 5 * time.Second
-	requestTimeout = 30 * time.Second
+	retryWindow        = 5 * time.Second
+	requestTimeout     = 30 * time.Second
+	gatewayDialTimeout = 5 * time.Second
 
 	expectedServiceAccountNames = []string{
 		"linkerd-controller",
@@ -342,9 +377,15 @@ type HealthChecker struct {
 	serverVersion    string
 	linkerdConfig    *configPb.All
 	uuid             string
-	issuerCert       *tls.Cred
+	issuerCert       *tls.Crt
 	trustAnchors     []*x509.Certificate
+	proxyCerts       []k8s.ProxyCertificates
 	cniDaemonSet     *appsv1.DaemonSet
+	remoteClusters   map[string]kubernetes.Interface
+
+	// fetchProxyCertificates is overridden in tests, where proxies can't be
+	// port-forwarded to
+	fetchProxyCertificates func(pod corev1.Pod, identity string) ([]*x509.Certificate, error)
 }
 
 // NewHealthChecker returns an initialized HealthChecker
@@ -988,6 +1029,35 @@ func (hc *HealthChecker) allCategories() []category {
 						return hc.checkDataPlaneProxiesCertificate()
 					},
 				},
+				{
+					description: "data plane proxies don't use denied identities",
+					hintAnchor:  "l5d-identity-data-plane-proxies-identities-not-denied",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						return hc.checkDataPlaneProxiesDenyList()
+					},
+				},
+			},
+		},
+		{
+			id: LinkerdIdentityDataPlaneCertificates,
+			checkers: []checker{
+				{
+					description: "data plane proxies certificates are issued by the trust anchors",
+					hintAnchor:  "l5d-identity-data-plane-proxies-certs-issued-by-trust-anchors",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						return hc.checkDataPlaneProxiesCertificateChains()
+					},
+				},
+				{
+					description: "data plane proxies certificates are issued by the current issuer",
+					hintAnchor:  "l5d-identity-data-plane-proxies-certs-issued-by-current-issuer",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						return hc.checkDataPlaneProxiesIssuer()
+					},
+				},
 			},
 		},
 		{
@@ -1160,6 +1230,14 @@ func (hc *HealthChecker) allCategories() []category {
 						return nil
 					},
 				},
+				{
+					description: "data plane Jobs are not stuck on the proxy",
+					hintAnchor:  "l5d-data-plane-jobs",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkDataPlaneJobs()
+					},
+				},
 			},
 		},
 		{
@@ -1190,6 +1268,74 @@ func (hc *HealthChecker) allCategories() []category {
 				},
 			},
 		},
+		{
+			id: LinkerdMulticlusterChecks,
+			checkers: []checker{
+				{
+					description: "remote cluster access credentials are valid",
+					hintAnchor:  "l5d-multicluster-remote-credentials",
+					check: func(context.Context) error {
+						return hc.checkRemoteClusterCredentials()
+					},
+				},
+				{
+					description: "remote cluster gateways are reachable",
+					hintAnchor:  "l5d-multicluster-gateways-reachable",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkRemoteGatewaysReachable()
+					},
+				},
+				{
+					description: "all mirrored services have endpoints",
+					hintAnchor:  "l5d-multicluster-services-endpoints",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkMirroredServicesEndpoints()
+					},
+				},
+			},
+		},
+		{
+			id: LinkerdPolicyChecks,
+			checkers: []checker{
+				{
+					description: "ServerAuthorization CRD is installed",
+					hintAnchor:  "l5d-policy-crd-exists",
+					check: func(context.Context) error {
+						return hc.checkServerAuthorizationCRD()
+					},
+				},
+				{
+					description: "server authorizations are valid",
+					hintAnchor:  "l5d-policy-authorizations-valid",
+					check: func(context.Context) error {
+						return hc.checkServerAuthorizations()
+					},
+				},
+				{
+					description: "server authorizations apply to pod ports",
+					hintAnchor:  "l5d-policy-authorizations-selected",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkServerAuthorizationsSelectPods()
+					},
+				},
+			},
+		},
+		{
+			id: LinkerdControlPlaneMTLSChecks,
+			checkers: []checker{
+				{
+					description: "control plane components use mTLS",
+					hintAnchor:  "l5d-control-plane-mtls",
+					warning:     true,
+					check: func(context.Context) error {
+						return hc.checkControlPlaneMTLS()
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1213,6 +1359,107 @@ func (hc *HealthChecker) checkMinReplicasAvailable() error {
 	return nil
 }
 
+// controlPlaneMTLSComponents maps the deployments of the control plane
+// components calling each other over mTLS to the name of their container.
+var controlPlaneMTLSComponents = []struct{ deployment, container string }{
+	{"linkerd-controller", "public-api"},
+	{"linkerd-web", "web"},
+	{"linkerd-tap", "tap"},
+}
+
+// checkControlPlaneMTLS verifies that the control plane components calling
+// each other over mTLS request a certificate from the identity service, and
+// that their pods are meshed by a proxy holding a certificate issued by the
+// trust anchors.
+func (hc *HealthChecker) checkControlPlaneMTLS() error {
+	faulty := []string{}
+	enabled := false
+	deploys := []*appsv1.Deployment{}
+
+	for _, component := range controlPlaneMTLSComponents {
+		deploy, err := hc.kubeAPI.AppsV1().Deployments(hc.ControlPlaneNamespace).Get(component.deployment, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		deploys = append(deploys, deploy)
+
+		hasIdentity := false
+		for _, container := range deploy.Spec.Template.Spec.Containers {
+			if container.Name != component.container {
+				continue
+			}
+			for _, arg := range container.Args {
+				switch {
+				case strings.HasPrefix(arg, "-mtls-addr="):
+					enabled = true
+				case strings.HasPrefix(arg, "-identity-service-account=") && arg != "-identity-service-account=":
+					hasIdentity = true
+				}
+			}
+		}
+		if !hasIdentity {
+			faulty = append(faulty, component.deployment)
+		}
+	}
+
+	if !enabled {
+		return &SkipError{Reason: "not run when control plane mTLS is disabled"}
+	}
+	if len(faulty) > 0 {
+		return fmt.Errorf("no certificate requested from the identity service by %v", faulty)
+	}
+
+	pods := []corev1.Pod{}
+	unmeshed := []string{}
+	for _, deploy := range deploys {
+		selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+		if err != nil {
+			return err
+		}
+		podList, err := hc.kubeAPI.CoreV1().Pods(hc.ControlPlaneNamespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		for _, pod := range podList.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			if !hasProxyContainer(pod) || k8s.IsIdentityDisabled(pod) {
+				unmeshed = append(unmeshed, pod.Name)
+				continue
+			}
+			pods = append(pods, pod)
+		}
+	}
+	if len(unmeshed) > 0 {
+		return fmt.Errorf("no %s container with identity enabled in %v", k8s.ProxyContainerName, unmeshed)
+	}
+
+	certs, err := hc.fetchVerifiedCertificates(pods)
+	if err != nil {
+		return err
+	}
+	offendingPods := []string{}
+	for _, pc := range certs {
+		if pc.Err != nil {
+			offendingPods = append(offendingPods, fmt.Sprintf("* %s: %s", pc.Pod.Name, pc.Err))
+		}
+	}
+	if len(offendingPods) > 0 {
+		return fmt.Errorf("Some control plane pods don't present certificates issued by the trust anchors:\n\t%s", strings.Join(offendingPods, "\n\t"))
+	}
+	return nil
+}
+
+func hasProxyContainer(pod corev1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == k8s.ProxyContainerName {
+			return true
+		}
+	}
+	return false
+}
+
 func (hc *HealthChecker) issuerIdentity() string {
 	return fmt.Sprintf("identity.%s.%s", hc.ControlPlaneNamespace, hc.linkerdConfig.Global.IdentityContext.TrustDomain)
 }
@@ -1386,8 +1633,9 @@ func (hc *HealthChecker) checkLinkerdConfigConfigMap() (string, *configPb.All, e
 // 1. There is a config map present with identity context
 // 2. The scheme in the identity context corresponds to the format of the issuer secret
 // 3. The trust anchors (if scheme == kubernetes.io/tls) in the secret equal the ones in config
-// 4. The certs and key are parsable
-func (hc *HealthChecker) checkCertificatesConfig() (*tls.Cred, []*x509.Certificate, error) {
+// 4. The certs and key are parsable, the key being optional when it's held by
+// an external signer
+func (hc *HealthChecker) checkCertificatesConfig() (*tls.Crt, []*x509.Certificate, error) {
 	_, configPB, err := FetchLinkerdConfigMap(hc.kubeAPI, hc.ControlPlaneNamespace)
 	if err != nil {
 		return nil, nil, err
@@ -1397,9 +1645,9 @@ func (hc *HealthChecker) checkCertificatesConfig() (*tls.Cred, []*x509.Certifica
 	var data *issuercerts.IssuerCertData
 
 	if idctx.Scheme == "" || idctx.Scheme == k8s.IdentityIssuerSchemeLinkerd {
-		data, err = issuercerts.FetchIssuerData(hc.kubeAPI, idctx.TrustAnchorsPem, hc.ControlPlaneNamespace)
+		data, err = issuercerts.FetchIssuerCrtData(hc.kubeAPI, idctx.TrustAnchorsPem, hc.ControlPlaneNamespace)
 	} else {
-		data, err = issuercerts.FetchExternalIssuerData(hc.kubeAPI, hc.ControlPlaneNamespace)
+		data, err = issuercerts.FetchExternalIssuerCrtData(hc.kubeAPI, hc.ControlPlaneNamespace)
 		// ensure trust anchors in config matches whats in the secret
 		if data != nil && strings.TrimSpace(idctx.TrustAnchorsPem) != strings.TrimSpace(data.TrustAnchors) {
 			errFormat := "IdentityContext.TrustAnchorsPem does not match %s in %s"
@@ -1411,9 +1659,18 @@ func (hc *HealthChecker) checkCertificatesConfig() (*tls.Cred, []*x509.Certifica
 		return nil, nil, err
 	}
 
-	issuerCreds, err := tls.ValidateAndCreateCreds(data.IssuerCrt, data.IssuerKey)
-	if err != nil {
-		return nil, nil, err
+	var issuerCrt *tls.Crt
+	if data.IssuerKey == "" {
+		issuerCrt, err = tls.DecodePEMCrt(data.IssuerCrt)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		issuerCreds, err := tls.ValidateAndCreateCreds(data.IssuerCrt, data.IssuerKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		issuerCrt = &issuerCreds.Crt
 	}
 
 	anchors, err := tls.DecodePEMCertificates(data.TrustAnchors)
@@ -1421,7 +1678,7 @@ func (hc *HealthChecker) checkCertificatesConfig() (*tls.Cred, []*x509.Certifica
 		return nil, nil, err
 	}
 
-	return issuerCreds, anchors, nil
+	return issuerCrt, anchors, nil
 }
 
 // FetchLinkerdConfigMap retrieves the `linkerd-config` ConfigMap from
@@ -1444,6 +1701,21 @@ func FetchLinkerdConfigMap(k kubernetes.Interface, controlPlaneNamespace string)
 	return cm, configPB, nil
 }
 
+// FetchIdentityDenyList retrieves the `linkerd-identity-deny-list` ConfigMap
+// from Kubernetes and parses it into an identity.DenyList. A missing ConfigMap
+// holds an empty DenyList.
+func FetchIdentityDenyList(k kubernetes.Interface, controlPlaneNamespace string) (*identity.DenyList, error) {
+	cm, err := k.CoreV1().ConfigMaps(controlPlaneNamespace).Get(k8s.IdentityDenyListConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return identity.NewDenyList(nil, nil)
+		}
+		return nil, err
+	}
+
+	return identity.NewDenyListFromConfigMap(cm)
+}
+
 // checkNamespace checks whether the given namespace exists, and returns an
 // error if it does not match `shouldExist`.
 func (hc *HealthChecker) checkNamespace(namespace string, shouldExist bool) error {
@@ -1680,6 +1952,201 @@ func (hc *HealthChecker) checkDataPlaneProxiesCertificate() error {
 	return fmt.Errorf("Some pods do not have the current trust bundle and must be restarted:\n\t%s", strings.Join(offendingPods, "\n\t"))
 }
 
+// checkDataPlaneJobs looks for the meshed pods of Jobs whose containers have
+// all completed, but which are kept running by their proxy.
+func (hc *HealthChecker) checkDataPlaneJobs() error {
+	podList, err := hc.kubeAPI.CoreV1().Pods(hc.DataPlaneNamespace).List(metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return err
+	}
+
+	stuckPods := []string{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !isStuckJobPod(pod) {
+			continue
+		}
+		if hc.DataPlaneNamespace == "" {
+			stuckPods = append(stuckPods, fmt.Sprintf("* %s/%s", pod.Namespace, pod.Name))
+		} else {
+			stuckPods = append(stuckPods, fmt.Sprintf("* %s", pod.Name))
+		}
+	}
+	if len(stuckPods) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Some Job pods have completed but are kept running by their proxy; consider setting the %s annotation:\n\t%s",
+		k8s.ProxyShutdownCoordinationAnnotation, strings.Join(stuckPods, "\n\t"))
+}
+
+// isStuckJobPod returns true if the pod belongs to a Job, and all of its
+// containers but the proxy have terminated while the proxy is still running.
+func isStuckJobPod(pod *corev1.Pod) bool {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "Job" || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	proxyRunning := false
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == k8s.ProxyContainerName {
+			proxyRunning = status.State.Running != nil
+		} else if status.State.Terminated == nil {
+			return false
+		}
+	}
+	return proxyRunning && len(pod.Status.ContainerStatuses) > 1
+}
+
+// checkDataPlaneProxiesCertificateChains fetches the certificate chain of
+// every meshed pod, and verifies that it's issued by one of the current trust
+// anchors.
+func (hc *HealthChecker) checkDataPlaneProxiesCertificateChains() error {
+	pods, err := hc.getMeshedPodsWithIdentity()
+	if err != nil {
+		return err
+	}
+	hc.proxyCerts, err = hc.fetchVerifiedCertificates(pods)
+	if err != nil {
+		return err
+	}
+
+	offendingPods := []string{}
+	for _, pc := range hc.proxyCerts {
+		if pc.Err != nil {
+			offendingPods = append(offendingPods, fmt.Sprintf("* %s: %s", hc.podName(pc.Pod), pc.Err))
+		}
+	}
+	if len(offendingPods) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Some pods present certificates that aren't issued by the trust anchors:\n\t%s", strings.Join(offendingPods, "\n\t"))
+}
+
+// checkDataPlaneProxiesIssuer verifies that the certificates fetched by
+// checkDataPlaneProxiesCertificateChains are issued by the current issuer,
+// i.e. that the proxies don't rely on a previous issuer anymore.
+func (hc *HealthChecker) checkDataPlaneProxiesIssuer() error {
+	if hc.issuerCert == nil {
+		var err error
+		hc.issuerCert, _, err = hc.checkCertificatesConfig()
+		if err != nil {
+			return err
+		}
+	}
+
+	offendingPods := []string{}
+	for _, pc := range hc.proxyCerts {
+		if pc.Err != nil {
+			// already reported by checkDataPlaneProxiesCertificateChains
+			continue
+		}
+		if err := pc.Certs[0].CheckSignatureFrom(hc.issuerCert.Certificate); err != nil {
+			offendingPods = append(offendingPods, fmt.Sprintf("* %s", hc.podName(pc.Pod)))
+		}
+	}
+	if len(offendingPods) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Some pods have certificates from a previous issuer and must be restarted, or wait for their certificates to be renewed:\n\t%s", strings.Join(offendingPods, "\n\t"))
+}
+
+// checkDataPlaneProxiesDenyList reports the running meshed pods whose
+// identity is denied, as they won't be able to renew their certificates.
+func (hc *HealthChecker) checkDataPlaneProxiesDenyList() error {
+	denyList, err := FetchIdentityDenyList(hc.kubeAPI, hc.ControlPlaneNamespace)
+	if err != nil {
+		return err
+	}
+	if denyList.Empty() {
+		return nil
+	}
+
+	_, configPB, err := FetchLinkerdConfigMap(hc.kubeAPI, hc.ControlPlaneNamespace)
+	if err != nil {
+		return err
+	}
+	trustDomain := configPB.GetGlobal().GetIdentityContext().GetTrustDomain()
+
+	podList, err := hc.kubeAPI.CoreV1().Pods(hc.DataPlaneNamespace).List(metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return err
+	}
+
+	offendingPods := []string{}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase != corev1.PodRunning || !k8s.IsMeshed(&pod, hc.ControlPlaneNamespace) || k8s.IsIdentityDisabled(pod) {
+			continue
+		}
+		if entry, denied := denyList.DeniesIdentity(k8s.ProxyIdentity(pod, hc.ControlPlaneNamespace, trustDomain)); denied {
+			offendingPods = append(offendingPods, fmt.Sprintf("* %s (denied by %s)", hc.podName(pod), entry))
+		}
+	}
+	if len(offendingPods) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Some pods use denied identities and won't be issued new certificates:\n\t%s", strings.Join(offendingPods, "\n\t"))
+}
+
+// getMeshedPodsWithIdentity returns the running meshed pods whose identity
+// isn't disabled, as the proxies of the others have no certificate to present.
+func (hc *HealthChecker) getMeshedPodsWithIdentity() ([]corev1.Pod, error) {
+	podList, err := hc.kubeAPI.CoreV1().Pods(hc.DataPlaneNamespace).List(metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []corev1.Pod{}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodRunning && k8s.IsMeshed(&pod, hc.ControlPlaneNamespace) && !k8s.IsIdentityDisabled(pod) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// fetchVerifiedCertificates fetches the certificate chains presented by the
+// proxies of the given pods, concurrently, and verifies that they're issued by
+// the trust anchors for the identity of their pod. The failures to fetch or
+// verify a chain are reported in its Err.
+func (hc *HealthChecker) fetchVerifiedCertificates(pods []corev1.Pod) ([]k8s.ProxyCertificates, error) {
+	_, configPB, err := FetchLinkerdConfigMap(hc.kubeAPI, hc.ControlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+	idctx := configPB.GetGlobal().GetIdentityContext()
+	anchors, err := tls.DecodePEMCertPool(idctx.GetTrustAnchorsPem())
+	if err != nil {
+		return nil, err
+	}
+
+	fetch := hc.fetchProxyCertificates
+	if fetch == nil {
+		fetch = func(pod corev1.Pod, identity string) ([]*x509.Certificate, error) {
+			return k8s.FetchProxyCertificates(hc.kubeAPI, pod, identity, false)
+		}
+	}
+
+	results := k8s.FetchProxiesCertificates(pods, func(pod corev1.Pod) ([]*x509.Certificate, error) {
+		return fetch(pod, k8s.ProxyIdentity(pod, hc.ControlPlaneNamespace, idctx.GetTrustDomain()))
+	})
+	for i := range results {
+		pc := &results[i]
+		if pc.Err == nil {
+			crt := tls.Crt{Certificate: pc.Certs[0], TrustChain: pc.Certs[1:]}
+			pc.Err = crt.Verify(anchors, k8s.ProxyIdentity(pc.Pod, hc.ControlPlaneNamespace, idctx.GetTrustDomain()), time.Time{})
+		}
+	}
+	return results, nil
+}
+
+func (hc *HealthChecker) podName(pod corev1.Pod) string {
+	if hc.DataPlaneNamespace == "" {
+		return fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+	}
+	return pod.Name
+}
+
 func checkResources(resourceName string, objects []runtime.Object, expectedNames []string, shouldExist bool) error {
 	if !shouldExist {
 		if len(objects) > 0 {
@@ -1940,6 +2407,318 @@ func (hc *HealthChecker) checkClockSkew() error {
 	return nil
 }
 
+// remoteGateway identifies a gateway of a remote cluster that some mirrored
+// services route through.
+type remoteGateway struct {
+	cluster   string
+	name      string
+	namespace string
+}
+
+// checkRemoteClusterCredentials verifies that the credentials of all the
+// linked remote clusters can be used to access their Kubernetes API.
+func (hc *HealthChecker) checkRemoteClusterCredentials() error {
+	secrets, err := hc.kubeAPI.CoreV1().Secrets(hc.ControlPlaneNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	hc.remoteClusters = map[string]kubernetes.Interface{}
+	errs := []string{}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Type != k8s.MirrorSecretType {
+			continue
+		}
+
+		config, err := multicluster.ParseRemoteClusterSecret(secret)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		client, err := newRemoteClusterClient(config)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("cannot access cluster %s: %s", config.ClusterName, err))
+			continue
+		}
+		hc.remoteClusters[config.ClusterName] = client
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n    "))
+	}
+	if len(hc.remoteClusters) == 0 {
+		return &SkipError{Reason: linkerdMulticlusterNoLinksSkipReason}
+	}
+	return nil
+}
+
+// checkRemoteGatewaysReachable verifies that the remote gateways which the
+// mirrored services point to can be connected to.
+func (hc *HealthChecker) checkRemoteGatewaysReachable() error {
+	services, err := hc.getMirroredServices()
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		return &SkipError{Reason: linkerdMulticlusterNoLinksSkipReason}
+	}
+
+	gateways := map[remoteGateway]struct{}{}
+	for _, svc := range services {
+		gateways[remoteGateway{
+			cluster:   svc.Labels[k8s.RemoteClusterNameLabel],
+			name:      svc.Labels[k8s.RemoteGatewayNameLabel],
+			namespace: svc.Labels[k8s.RemoteGatewayNsLabel],
+		}] = struct{}{}
+	}
+
+	errs := []string{}
+	for gateway := range gateways {
+		if err := hc.checkRemoteGateway(gateway); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return errors.New(strings.Join(errs, "\n    "))
+	}
+	return nil
+}
+
+func (hc *HealthChecker) checkRemoteGateway(gateway remoteGateway) error {
+	client, ok := hc.remoteClusters[gateway.cluster]
+	if !ok {
+		return fmt.Errorf("no valid credentials found for cluster %s", gateway.cluster)
+	}
+
+	svc, err := client.CoreV1().Services(gateway.namespace).Get(gateway.name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get gateway %s/%s of cluster %s: %s", gateway.namespace, gateway.name, gateway.cluster, err)
+	}
+
+	ips, port, err := multicluster.GatewayAddresses(svc)
+	if err != nil {
+		return fmt.Errorf("cluster %s: %s", gateway.cluster, err)
+	}
+
+	for _, ip := range ips {
+		addr := net.JoinHostPort(ip, strconv.Itoa(int(port)))
+		conn, err := net.DialTimeout("tcp", addr, gatewayDialTimeout)
+		if err != nil {
+			return fmt.Errorf("cannot connect to gateway %s/%s of cluster %s at %s: %s", gateway.namespace, gateway.name, gateway.cluster, addr, err)
+		}
+		conn.Close()
+	}
+	return nil
+}
+
+// checkMirroredServicesEndpoints verifies that all the mirrored services have
+// endpoints, which is not the case when their remote gateway can't be
+// resolved.
+func (hc *HealthChecker) checkMirroredServicesEndpoints() error {
+	services, err := hc.getMirroredServices()
+	if err != nil {
+		return err
+	}
+	if len(services) == 0 {
+		return &SkipError{Reason: linkerdMulticlusterNoLinksSkipReason}
+	}
+
+	errs := []string{}
+	for _, svc := range services {
+		endpoints, err := hc.kubeAPI.CoreV1().Endpoints(svc.Namespace).Get(svc.Name, metav1.GetOptions{})
+		if err != nil || !hasEndpointAddresses(endpoints) {
+			errs = append(errs, fmt.Sprintf("%s.%s mirrored from cluster %s", svc.Name, svc.Namespace, svc.Labels[k8s.RemoteClusterNameLabel]))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Some mirrored services do not have endpoints:\n    %s", strings.Join(errs, "\n    "))
+	}
+	return nil
+}
+
+func (hc *HealthChecker) getMirroredServices() ([]corev1.Service, error) {
+	selector := labels.Set{k8s.MirroredResourceLabel: "true"}.AsSelector()
+	services, err := hc.kubeAPI.CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	return services.Items, nil
+}
+
+func newRemoteClusterClient(config *multicluster.RemoteClusterConfig) (kubernetes.Interface, error) {
+	restConfig, err := config.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	restConfig.Timeout = requestTimeout
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Discovery().ServerVersion(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// checkServerAuthorizationCRD checks that the ServerAuthorization CRD, which
+// the destination service watches, is installed.
+func (hc *HealthChecker) checkServerAuthorizationCRD() error {
+	_, err := hc.kubeAPI.Apiextensions.ApiextensionsV1beta1().CustomResourceDefinitions().Get(serverAuthorizationCRDName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf("missing CustomResourceDefinition %s", serverAuthorizationCRDName)
+	}
+	return err
+}
+
+// checkServerAuthorizations validates all the ServerAuthorizations of the
+// cluster. Invalid authorizations are ignored by the destination service.
+func (hc *HealthChecker) checkServerAuthorizations() error {
+	authorizations, err := hc.getServerAuthorizations()
+	if err != nil {
+		return err
+	}
+	if len(authorizations) == 0 {
+		return &SkipError{Reason: linkerdPolicyNoAuthorizationsSkipReason}
+	}
+
+	errs := []string{}
+	for _, authorization := range authorizations {
+		if err := validateServerAuthorization(authorization); err != nil {
+			errs = append(errs, fmt.Sprintf("%s/%s: %s", authorization.Namespace, authorization.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Some server authorizations are invalid:\n    %s", strings.Join(errs, "\n    "))
+	}
+	return nil
+}
+
+// checkServerAuthorizationsSelectPods warns about the ServerAuthorizations
+// that don't apply to any existing pod port, which is usually the sign of a
+// typo in their pod selector or port.
+func (hc *HealthChecker) checkServerAuthorizationsSelectPods() error {
+	authorizations, err := hc.getServerAuthorizations()
+	if err != nil {
+		return err
+	}
+	if len(authorizations) == 0 {
+		return &SkipError{Reason: linkerdPolicyNoAuthorizationsSkipReason}
+	}
+
+	errs := []string{}
+	for _, authorization := range authorizations {
+		selector, err := metav1.LabelSelectorAsSelector(authorization.Spec.PodSelector)
+		if err != nil || authorization.Spec.PodSelector == nil {
+			// reported by checkServerAuthorizations
+			continue
+		}
+		pods, err := hc.kubeAPI.CoreV1().Pods(authorization.Namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		if !podsExposePort(pods.Items, authorization.Spec.Port) {
+			errs = append(errs, fmt.Sprintf("%s/%s", authorization.Namespace, authorization.Name))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Some server authorizations don't apply to any pod port:\n    %s", strings.Join(errs, "\n    "))
+	}
+	return nil
+}
+
+func (hc *HealthChecker) getServerAuthorizations() ([]*saz.ServerAuthorization, error) {
+	list, err := hc.kubeAPI.SpClient.PolicyV1alpha1().ServerAuthorizations(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	authorizations := []*saz.ServerAuthorization{}
+	for i := range list.Items {
+		authorizations = append(authorizations, &list.Items[i])
+	}
+	return authorizations, nil
+}
+
+func validateServerAuthorization(authorization *saz.ServerAuthorization) error {
+	spec := authorization.Spec
+	if spec.PodSelector == nil {
+		return errors.New("missing podSelector")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(spec.PodSelector); err != nil {
+		return fmt.Errorf("invalid podSelector: %s", err)
+	}
+
+	if spec.Port.Type == intstr.Int {
+		if spec.Port.IntVal < 1 || spec.Port.IntVal > 65535 {
+			return fmt.Errorf("invalid port %d: must be between 1 and 65535", spec.Port.IntVal)
+		}
+	} else if errs := validation.IsValidPortName(spec.Port.StrVal); len(errs) > 0 {
+		return fmt.Errorf("invalid port name \"%s\": %s", spec.Port.StrVal, strings.Join(errs, ", "))
+	}
+
+	if len(spec.Allow) == 0 && len(spec.Deny) == 0 {
+		return errors.New("at least one allow or deny client is required")
+	}
+	clients := make([]*saz.Client, 0, len(spec.Allow)+len(spec.Deny))
+	clients = append(append(clients, spec.Allow...), spec.Deny...)
+	for _, client := range clients {
+		if client == nil {
+			return errors.New("empty client")
+		}
+		for _, sa := range client.ServiceAccounts {
+			if sa == nil {
+				return errors.New("empty service account")
+			}
+			if errs := validation.IsDNS1123Label(sa.Name); len(errs) > 0 {
+				return fmt.Errorf("invalid service account name \"%s\": %s", sa.Name, strings.Join(errs, ", "))
+			}
+			if sa.Namespace != "" {
+				if errs := validation.IsDNS1123Label(sa.Namespace); len(errs) > 0 {
+					return fmt.Errorf("invalid service account namespace \"%s\": %s", sa.Namespace, strings.Join(errs, ", "))
+				}
+			}
+		}
+		for _, ns := range client.Namespaces {
+			if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+				return fmt.Errorf("invalid namespace \"%s\": %s", ns, strings.Join(errs, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// podsExposePort returns true if one of the pods has the given port. Ports
+// referenced by number don't need to be declared as container ports.
+func podsExposePort(pods []corev1.Pod, port intstr.IntOrString) bool {
+	if port.Type == intstr.Int {
+		return len(pods) > 0
+	}
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == port.StrVal {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func hasEndpointAddresses(endpoints *corev1.Endpoints) bool {
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // getPodStatuses returns a map of all Linkerd container statuses:
 // component =>
 //   pod name =>
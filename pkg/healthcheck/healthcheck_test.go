@@ -2,6 +2,7 @@ package healthcheck
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -26,6 +27,7 @@ import (
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type observer struct {
@@ -1826,6 +1828,80 @@ data:
 	}
 }
 
+func jobPod(name, namespace, ownerKind, mainState, proxyState string) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: %s
+  namespace: %s
+  labels:
+    %s: linkerd
+  ownerReferences:
+  - apiVersion: batch/v1
+    kind: %s
+    name: migrate
+    uid: "1"
+    controller: true
+status:
+  phase: Running
+  containerStatuses:
+  - name: migrate
+    state:
+      %s: {}
+  - name: %s
+    state:
+      %s: {}
+`, name, namespace, k8s.ControllerNSLabel, ownerKind, mainState, k8s.ProxyContainerName, proxyState)
+}
+
+func TestCheckDataPlaneJobs(t *testing.T) {
+	resources := []string{
+		jobPod("stuck", "namespace-0", "Job", "terminated", "running"),
+		jobPod("active", "namespace-0", "Job", "running", "running"),
+		jobPod("completed", "namespace-0", "Job", "terminated", "terminated"),
+		jobPod("restarting", "namespace-1", "ReplicaSet", "terminated", "running"),
+		jobPod("stuck", "namespace-1", "Job", "terminated", "running"),
+	}
+
+	var testCases = []struct {
+		namespace   string
+		expectedErr error
+	}{
+		{
+			namespace:   "",
+			expectedErr: fmt.Errorf("Some Job pods have completed but are kept running by their proxy; consider setting the %s annotation:\n\t* namespace-0/stuck\n\t* namespace-1/stuck", k8s.ProxyShutdownCoordinationAnnotation),
+		},
+		{
+			namespace:   "namespace-1",
+			expectedErr: fmt.Errorf("Some Job pods have completed but are kept running by their proxy; consider setting the %s annotation:\n\t* stuck", k8s.ProxyShutdownCoordinationAnnotation),
+		},
+		{
+			namespace:   "namespace-2",
+			expectedErr: nil,
+		},
+	}
+
+	for id, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%d", id), func(t *testing.T) {
+			hc := NewHealthChecker([]CategoryID{}, &Options{})
+			hc.DataPlaneNamespace = testCase.namespace
+
+			var err error
+			hc.kubeAPI, err = k8s.NewFakeAPI(resources...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %q", err)
+			}
+
+			err = hc.checkDataPlaneJobs()
+			if !reflect.DeepEqual(err, testCase.expectedErr) {
+				t.Fatalf("Error %q does not match expected error: %q", err, testCase.expectedErr)
+			}
+		})
+	}
+}
+
 func TestValidateControlPlanePods(t *testing.T) {
 	pod := func(name string, phase corev1.PodPhase, ready bool) corev1.Pod {
 		return corev1.Pod{
@@ -2535,6 +2611,37 @@ func TestLinkerdIdentityCheckCertConfig(t *testing.T) {
 			schemeInConfig:   string(corev1.SecretTypeTLS),
 			expectedOutput:   []string{"linkerd-identity-test-cat certificate config is valid: key ca.crt containing the trust anchors needs to exist in secret linkerd-identity-issuer if --identity-external-issuer=true"},
 		},
+		{
+			checkDescription: "works without the issuer key in a linkerd.io/tls secret (external signer)",
+			tlsSecretScheme:  k8s.IdentityIssuerSchemeLinkerd,
+			schemeInConfig:   k8s.IdentityIssuerSchemeLinkerd,
+			expectedOutput:   []string{"linkerd-identity-test-cat certificate config is valid"},
+			tlsSecretIssuerDataModifier: func(issuerData issuercerts.IssuerCertData) issuercerts.IssuerCertData {
+				issuerData.IssuerKey = ""
+				return issuerData
+			},
+		},
+		{
+			checkDescription: "works without the issuer key in a kubernetes.io/tls secret (external signer)",
+			tlsSecretScheme:  string(corev1.SecretTypeTLS),
+			schemeInConfig:   string(corev1.SecretTypeTLS),
+			expectedOutput:   []string{"linkerd-identity-test-cat certificate config is valid"},
+			tlsSecretIssuerDataModifier: func(issuerData issuercerts.IssuerCertData) issuercerts.IssuerCertData {
+				issuerData.IssuerKey = ""
+				return issuerData
+			},
+		},
+		{
+			checkDescription: "fails without the issuer key when the issuer certificate is invalid",
+			tlsSecretScheme:  k8s.IdentityIssuerSchemeLinkerd,
+			schemeInConfig:   k8s.IdentityIssuerSchemeLinkerd,
+			expectedOutput:   []string{"linkerd-identity-test-cat certificate config is valid: not a PEM certificate"},
+			tlsSecretIssuerDataModifier: func(issuerData issuercerts.IssuerCertData) issuercerts.IssuerCertData {
+				issuerData.IssuerCrt = "not a certificate"
+				issuerData.IssuerKey = ""
+				return issuerData
+			},
+		},
 		{
 			checkDescription: "does not get influenced by newline differences between trust anchors (missing newline in configMap)",
 			tlsSecretScheme:  string(corev1.SecretTypeTLS),
@@ -2613,7 +2720,7 @@ func TestLinkerdIdentityCheckCertValidity(t *testing.T) {
 				starts: time.Date(2100, 1, 1, 1, 1, 1, 1, time.UTC),
 				ends:   time.Date(2101, 1, 1, 1, 1, 1, 1, time.UTC),
 			},
-			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* 1 identity.linkerd.cluster.local not valid before: 2100-01-01T01:00:51Z"},
+			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* %s identity.linkerd.cluster.local not valid before: 2100-01-01T01:00:51Z"},
 		},
 		{
 			checkerToTest:    "trust anchors are within their validity period",
@@ -2622,7 +2729,7 @@ func TestLinkerdIdentityCheckCertValidity(t *testing.T) {
 				starts: time.Date(1989, 1, 1, 1, 1, 1, 1, time.UTC),
 				ends:   time.Date(1990, 1, 1, 1, 1, 1, 1, time.UTC),
 			},
-			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* 1 identity.linkerd.cluster.local not valid anymore. Expired on 1990-01-01T01:01:11Z"},
+			expectedOutput: []string{"linkerd-identity-test-cat trust anchors are within their validity period: Invalid anchors:\n\t* %s identity.linkerd.cluster.local not valid anymore. Expired on 1990-01-01T01:01:11Z"},
 		},
 		{
 			checkerToTest:    "issuer cert is within its validity period",
@@ -2649,7 +2756,20 @@ func TestLinkerdIdentityCheckCertValidity(t *testing.T) {
 		issuerData := createIssuerData("identity.linkerd.cluster.local", testCase.lifespan.starts, testCase.lifespan.ends)
 		fakeConfigMap := getFakeConfigMap(k8s.IdentityIssuerSchemeLinkerd, issuerData)
 		fakeSecret := getFakeSecret(k8s.IdentityIssuerSchemeLinkerd, issuerData)
-		runIdentityCheckTestCase(t, id, testCase.checkDescription, testCase.checkerToTest, fakeConfigMap, fakeSecret, testCase.expectedOutput)
+
+		// the serial numbers of the certificates are random
+		anchor, err := tls.DecodePEMCrt(issuerData.TrustAnchors)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expectedOutput := []string{}
+		for _, output := range testCase.expectedOutput {
+			if strings.Contains(output, "%s") {
+				output = fmt.Sprintf(output, anchor.Certificate.SerialNumber)
+			}
+			expectedOutput = append(expectedOutput, output)
+		}
+		runIdentityCheckTestCase(t, id, testCase.checkDescription, testCase.checkerToTest, fakeConfigMap, fakeSecret, expectedOutput)
 	}
 }
 
@@ -2662,6 +2782,190 @@ func TestLinkerdIdentityCheckWrongDns(t *testing.T) {
 
 }
 
+func meshedPodWithAnchors(name, anchors string) string {
+	anchorsJSON, _ := json.Marshal(anchors)
+	return fmt.Sprintf(`
+kind: Pod
+apiVersion: v1
+metadata:
+  name: %s
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+spec:
+  serviceAccountName: %s
+  containers:
+  - name: linkerd-proxy
+    env:
+    - name: LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS
+      value: %s
+status:
+  phase: Running
+---
+`, name, name, anchorsJSON)
+}
+
+// identityDisabledPod returns a meshed pod whose proxy has no certificate, so
+// that it's reported if the identity checks don't skip it
+func identityDisabledPod(name, anchors string) string {
+	anchorsJSON, _ := json.Marshal(anchors)
+	return fmt.Sprintf(`
+kind: Pod
+apiVersion: v1
+metadata:
+  name: %s
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/identity-mode: disabled
+spec:
+  serviceAccountName: %s
+  containers:
+  - name: linkerd-proxy
+    env:
+    - name: LINKERD2_PROXY_IDENTITY_DISABLED
+      value: disabled
+    - name: LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS
+      value: %s
+status:
+  phase: Running
+---
+`, name, name, anchorsJSON)
+}
+
+func TestLinkerdIdentityDataPlaneCertificates(t *testing.T) {
+	validity := tls.Validity{Lifetime: time.Hour}
+	newCA := func(name string) *tls.CA {
+		key, _ := tls.GenerateKey()
+		ca, _ := tls.CreateRootCA(name, key, validity)
+		return ca
+	}
+	issueChain := func(ca *tls.CA, sa string) []*x509.Certificate {
+		cred, _ := ca.GenerateEndEntityCred(fmt.Sprintf("%s.emojivoto.serviceaccount.identity.linkerd.cluster.local", sa))
+		return append([]*x509.Certificate{cred.Crt.Certificate}, cred.Crt.TrustChain...)
+	}
+
+	// the old anchor was also the issuer, as in default installs, while the
+	// new anchor has an intermediate issuer
+	oldCA := newCA("identity.linkerd.cluster.local")
+	newRoot := newCA("root.linkerd.cluster.local")
+	newIssuer, _ := newRoot.GenerateCA("identity.linkerd.cluster.local", 0)
+	rogueCA := newCA("rogue.linkerd.cluster.local")
+
+	issuerData := &issuercerts.IssuerCertData{
+		TrustAnchors: oldCA.Cred.Crt.EncodeCertificatePEM() + newRoot.Cred.Crt.EncodeCertificatePEM(),
+		IssuerCrt:    newIssuer.Cred.Crt.EncodeCertificatePEM(),
+		IssuerKey:    newIssuer.Cred.EncodePrivateKeyPEM(),
+	}
+
+	testCases := []struct {
+		description      string
+		chains           map[string][]*x509.Certificate
+		deniedIdentities string
+		expectedOutput   []string
+	}{
+		{
+			description: "passes when all the proxies are issued by the current issuer",
+			chains: map[string][]*x509.Certificate{
+				"emoji": issueChain(newIssuer, "emoji"),
+				"web":   issueChain(newIssuer, "web"),
+			},
+			expectedOutput: []string{
+				"linkerd-identity-data-plane data plane proxies certificate match CA",
+				"linkerd-identity-data-plane data plane proxies don't use denied identities",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the trust anchors",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the current issuer",
+			},
+		},
+		{
+			description: "warns about proxies issued by a previous issuer",
+			chains: map[string][]*x509.Certificate{
+				"emoji": issueChain(oldCA, "emoji"),
+				"web":   issueChain(newIssuer, "web"),
+			},
+			expectedOutput: []string{
+				"linkerd-identity-data-plane data plane proxies certificate match CA",
+				"linkerd-identity-data-plane data plane proxies don't use denied identities",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the trust anchors",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the current issuer: Some pods have certificates from a previous issuer and must be restarted, or wait for their certificates to be renewed:\n\t* emoji",
+			},
+		},
+		{
+			description: "warns about proxies whose chain isn't trusted",
+			chains: map[string][]*x509.Certificate{
+				"emoji": issueChain(rogueCA, "emoji"),
+				"web":   nil,
+			},
+			expectedOutput: []string{
+				"linkerd-identity-data-plane data plane proxies certificate match CA",
+				"linkerd-identity-data-plane data plane proxies don't use denied identities",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the trust anchors: Some pods present certificates that aren't issued by the trust anchors:\n\t* emoji: x509: certificate signed by unknown authority\n\t* web: connection refused",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the current issuer",
+			},
+		},
+		{
+			description: "warns about proxies using denied identities",
+			chains: map[string][]*x509.Certificate{
+				"emoji": issueChain(newIssuer, "emoji"),
+				"web":   issueChain(newIssuer, "web"),
+			},
+			deniedIdentities: "*.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			expectedOutput: []string{
+				"linkerd-identity-data-plane data plane proxies certificate match CA",
+				"linkerd-identity-data-plane data plane proxies don't use denied identities: Some pods use denied identities and won't be issued new certificates:\n\t* emoji (denied by *.emojivoto.serviceaccount.identity.linkerd.cluster.local)\n\t* web (denied by *.emojivoto.serviceaccount.identity.linkerd.cluster.local)",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the trust anchors",
+				"linkerd-identity-data-plane-certificates data plane proxies certificates are issued by the current issuer",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			hc := NewHealthChecker([]CategoryID{LinkerdIdentityDataPlane, LinkerdIdentityDataPlaneCertificates}, &Options{DataPlaneNamespace: "emojivoto"})
+			hc.ControlPlaneNamespace = "linkerd"
+
+			var err error
+			hc.kubeAPI, err = k8s.NewFakeAPI(
+				getFakeConfigMap(k8s.IdentityIssuerSchemeLinkerd, issuerData),
+				getFakeSecret(k8s.IdentityIssuerSchemeLinkerd, issuerData),
+				meshedPodWithAnchors("emoji", issuerData.TrustAnchors),
+				meshedPodWithAnchors("web", issuerData.TrustAnchors),
+				identityDisabledPod("legacy", issuerData.TrustAnchors),
+				fmt.Sprintf(`
+kind: ConfigMap
+apiVersion: v1
+metadata:
+  name: linkerd-identity-deny-list
+  namespace: linkerd
+data:
+  identities: "%s"
+`, tc.deniedIdentities),
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			hc.fetchProxyCertificates = func(pod corev1.Pod, identity string) ([]*x509.Certificate, error) {
+				if expected := fmt.Sprintf("%s.emojivoto.serviceaccount.identity.linkerd.cluster.local", pod.Name); identity != expected {
+					return nil, fmt.Errorf("expected identity %s, got %s", expected, identity)
+				}
+				chain := tc.chains[pod.Name]
+				if chain == nil {
+					return nil, errors.New("connection refused")
+				}
+				return chain, nil
+			}
+
+			obs := newObserver()
+			hc.RunChecks(obs.resultFn)
+			if !reflect.DeepEqual(obs.results, tc.expectedOutput) {
+				t.Fatalf("Expected results %v, but got %v", tc.expectedOutput, obs.results)
+			}
+		})
+	}
+}
+
 type fakeCniResourcesOpts struct {
 	hasConfigMap          bool
 	hasPodSecurityPolicy  bool
@@ -3103,6 +3407,154 @@ func TestMinReplicaCheck(t *testing.T) {
 	}
 }
 
+func TestControlPlaneMTLSCheck(t *testing.T) {
+	validity := tls.Validity{Lifetime: time.Hour}
+	key, _ := tls.GenerateKey()
+	ca, _ := tls.CreateRootCA("identity.linkerd.cluster.local", key, validity)
+	rogueKey, _ := tls.GenerateKey()
+	rogueCA, _ := tls.CreateRootCA("rogue.linkerd.cluster.local", rogueKey, validity)
+	issuerData := &issuercerts.IssuerCertData{
+		TrustAnchors: ca.Cred.Crt.EncodeCertificatePEM(),
+		IssuerCrt:    ca.Cred.Crt.EncodeCertificatePEM(),
+		IssuerKey:    ca.Cred.EncodePrivateKeyPEM(),
+	}
+
+	deployment := func(name, container string, args ...string) string {
+		return fmt.Sprintf(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %s
+  namespace: linkerd
+spec:
+  selector:
+    matchLabels:
+      app: %s
+  template:
+    spec:
+      containers:
+      - name: %s
+        args: [%s]
+---`, name, name, container, strings.Join(args, ", "))
+	}
+	pod := func(deployment, container string, annotations ...string) string {
+		return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: %s-1
+  namespace: linkerd
+  labels:
+    app: %s
+  annotations: {%s}
+spec:
+  serviceAccountName: %s
+  containers:
+  - name: %s
+status:
+  phase: Running
+---`, deployment, deployment, strings.Join(annotations, ", "), deployment, container)
+	}
+	enabled := []string{
+		getFakeConfigMap(k8s.IdentityIssuerSchemeLinkerd, issuerData),
+		deployment("linkerd-controller", "public-api", "-mtls-addr=:8087", "-identity-service-account=linkerd-controller"),
+		deployment("linkerd-web", "web", "-identity-service-account=linkerd-web"),
+		deployment("linkerd-tap", "tap", "-identity-service-account=linkerd-tap"),
+	}
+
+	testCases := []struct {
+		name      string
+		resources []string
+		rogue     map[string]bool
+		expected  string
+	}{
+		{
+			name: "disabled",
+			resources: []string{
+				deployment("linkerd-controller", "public-api", "public-api"),
+				deployment("linkerd-web", "web"),
+				deployment("linkerd-tap", "tap", "tap"),
+			},
+			expected: "not run when control plane mTLS is disabled",
+		},
+		{
+			name: "enabled",
+			resources: append([]string{
+				pod("linkerd-controller", k8s.ProxyContainerName),
+				pod("linkerd-web", k8s.ProxyContainerName),
+				pod("linkerd-tap", k8s.ProxyContainerName),
+			}, enabled...),
+		},
+		{
+			name: "missing identity",
+			resources: []string{
+				deployment("linkerd-controller", "public-api", "-mtls-addr=:8087", "-identity-service-account=linkerd-controller"),
+				deployment("linkerd-web", "web", "-identity-service-account="),
+				deployment("linkerd-tap", "tap"),
+			},
+			expected: "no certificate requested from the identity service by [linkerd-web linkerd-tap]",
+		},
+		{
+			name: "unmeshed pods",
+			resources: append([]string{
+				pod("linkerd-controller", k8s.ProxyContainerName),
+				pod("linkerd-web", "web"),
+				pod("linkerd-tap", k8s.ProxyContainerName, `"linkerd.io/identity-mode": disabled`),
+			}, enabled...),
+			expected: "no linkerd-proxy container with identity enabled in [linkerd-web-1 linkerd-tap-1]",
+		},
+		{
+			name: "untrusted certificate",
+			resources: append([]string{
+				pod("linkerd-controller", k8s.ProxyContainerName),
+				pod("linkerd-web", k8s.ProxyContainerName),
+				pod("linkerd-tap", k8s.ProxyContainerName),
+			}, enabled...),
+			rogue:    map[string]bool{"linkerd-web-1": true},
+			expected: "Some control plane pods don't present certificates issued by the trust anchors:\n\t* linkerd-web-1: x509: certificate signed by unknown authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			hc := NewHealthChecker(
+				[]CategoryID{LinkerdControlPlaneMTLSChecks},
+				&Options{
+					ControlPlaneNamespace: "linkerd",
+				},
+			)
+			var err error
+			hc.kubeAPI, err = k8s.NewFakeAPI(tc.resources...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			hc.fetchProxyCertificates = func(pod corev1.Pod, identity string) ([]*x509.Certificate, error) {
+				issuer := ca
+				if tc.rogue[pod.Name] {
+					issuer = rogueCA
+				}
+				cred, err := issuer.GenerateEndEntityCred(identity)
+				if err != nil {
+					return nil, err
+				}
+				return append([]*x509.Certificate{cred.Crt.Certificate}, cred.Crt.TrustChain...), nil
+			}
+
+			err = hc.checkControlPlaneMTLS()
+			if tc.expected == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expected {
+				t.Fatalf("Expected error: %s, got: %v", tc.expected, err)
+			}
+		})
+	}
+}
+
 type controlPlaneReplicaOptions struct {
 	controller    int
 	destination   int
@@ -3151,3 +3603,257 @@ func generateAllControlPlaneDef(replicaOptions *controlPlaneReplicaOptions, t *t
 	}
 	return resourceDefs
 }
+
+func TestMulticlusterChecks(t *testing.T) {
+	testCases := []struct {
+		description string
+		k8sConfigs  []string
+		results     []string
+	}{
+		{
+			"skips checks when no remote cluster is linked",
+			[]string{},
+			[]string{},
+		},
+		{
+			"fails on invalid credentials and mirrored services without endpoints",
+			[]string{`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: cluster-credentials-east
+  namespace: linkerd
+  annotations:
+    mirror.linkerd.io/remote-cluster-domain: cluster.local
+type: mirror.linkerd.io/remote-kubeconfig
+data:
+  kubeconfig: a3ViZWNvbmZpZw==`,
+				`
+apiVersion: v1
+kind: Service
+metadata:
+  name: books-east
+  namespace: bookapp
+  labels:
+    mirror.linkerd.io/mirrored-service: "true"
+    mirror.linkerd.io/cluster-name: east
+    mirror.linkerd.io/remote-gateway-name: gateway
+    mirror.linkerd.io/remote-gateway-ns: gateway-ns
+spec:
+  ports:
+  - port: 8080`,
+				`
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: books-east
+  namespace: bookapp
+subsets: []`,
+			},
+			[]string{
+				"linkerd-multicluster remote cluster access credentials are valid: secret linkerd/cluster-credentials-east is missing the mirror.linkerd.io/cluster-name label",
+				"linkerd-multicluster remote cluster gateways are reachable: no valid credentials found for cluster east",
+				"linkerd-multicluster all mirrored services have endpoints: Some mirrored services do not have endpoints:\n    books-east.bookapp mirrored from cluster east",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			hc := NewHealthChecker(
+				[]CategoryID{LinkerdMulticlusterChecks},
+				&Options{
+					ControlPlaneNamespace: "linkerd",
+				},
+			)
+
+			var err error
+			hc.kubeAPI, err = k8s.NewFakeAPI(tc.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			obs := newObserver()
+			hc.RunChecks(obs.resultFn)
+			if !reflect.DeepEqual(obs.results, tc.results) {
+				t.Fatalf("Expected results\n%s,\nbut got:\n%s", strings.Join(tc.results, "\n"), strings.Join(obs.results, "\n"))
+			}
+		})
+	}
+}
+
+func TestPolicyChecks(t *testing.T) {
+	crd := `
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: serverauthorizations.policy.linkerd.io
+spec:
+  group: policy.linkerd.io`
+
+	pod := `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-1
+  namespace: ns
+  labels:
+    app: web
+spec:
+  containers:
+  - name: web
+    ports:
+    - name: http
+      containerPort: 8080`
+
+	testCases := []struct {
+		description string
+		k8sConfigs  []string
+		results     []string
+	}{
+		{
+			"fails when the CRD is missing",
+			[]string{},
+			[]string{
+				"linkerd-policy ServerAuthorization CRD is installed: missing CustomResourceDefinition serverauthorizations.policy.linkerd.io",
+			},
+		},
+		{
+			"passes with valid authorizations applying to pod ports",
+			[]string{
+				crd,
+				pod,
+				`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: web-http
+  namespace: ns
+spec:
+  podSelector:
+    matchLabels:
+      app: web
+  port: http
+  allow:
+  - serviceAccounts:
+    - name: client
+      namespace: client-ns
+  - namespaces: [monitoring]
+  deny:
+  - unauthenticated: true`,
+			},
+			[]string{
+				"linkerd-policy ServerAuthorization CRD is installed",
+				"linkerd-policy server authorizations are valid",
+				"linkerd-policy server authorizations apply to pod ports",
+			},
+		},
+		{
+			"fails with invalid authorizations",
+			[]string{
+				crd,
+				pod,
+				`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: no-clients
+  namespace: ns
+spec:
+  podSelector: {}
+  port: 8080`,
+				`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: bad-port
+  namespace: ns
+spec:
+  podSelector: {}
+  port: 70000
+  allow:
+  - unauthenticated: true`,
+				`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: bad-sa
+  namespace: ns
+spec:
+  podSelector: {}
+  port: 8080
+  allow:
+  - serviceAccounts:
+    - name: Client_SA`,
+			},
+			[]string{
+				"linkerd-policy ServerAuthorization CRD is installed",
+				"linkerd-policy server authorizations are valid: Some server authorizations are invalid:\n    ns/no-clients: at least one allow or deny client is required\n    ns/bad-port: invalid port 70000: must be between 1 and 65535\n    ns/bad-sa: invalid service account name \"Client_SA\": " + strings.Join(validation.IsDNS1123Label("Client_SA"), ", "),
+				"linkerd-policy server authorizations apply to pod ports",
+			},
+		},
+		{
+			"warns about authorizations that don't apply to any pod port",
+			[]string{
+				crd,
+				pod,
+				`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: other-app
+  namespace: ns
+spec:
+  podSelector:
+    matchLabels:
+      app: other
+  port: 8080
+  allow:
+  - unauthenticated: true`,
+				`
+apiVersion: policy.linkerd.io/v1alpha1
+kind: ServerAuthorization
+metadata:
+  name: unknown-port-name
+  namespace: ns
+spec:
+  podSelector:
+    matchLabels:
+      app: web
+  port: admin
+  allow:
+  - unauthenticated: true`,
+			},
+			[]string{
+				"linkerd-policy ServerAuthorization CRD is installed",
+				"linkerd-policy server authorizations are valid",
+				"linkerd-policy server authorizations apply to pod ports: Some server authorizations don't apply to any pod port:\n    ns/other-app\n    ns/unknown-port-name",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			hc := NewHealthChecker(
+				[]CategoryID{LinkerdPolicyChecks},
+				&Options{
+					ControlPlaneNamespace: "linkerd",
+				},
+			)
+
+			var err error
+			hc.kubeAPI, err = k8s.NewFakeAPI(tc.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			obs := newObserver()
+			hc.RunChecks(obs.resultFn)
+			if !reflect.DeepEqual(obs.results, tc.results) {
+				t.Fatalf("Expected results\n%s,\nbut got:\n%s", strings.Join(tc.results, "\n"), strings.Join(obs.results, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	auditPb "github.com/linkerd/linkerd2/controller/gen/controller/identity"
+	publicPb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultAuditRecords is the default number of audit records kept in
+	// memory to be served through the Audit API.
+	DefaultAuditRecords = 1000
+
+	reasonIssuerNotReady   = "issuer_not_ready"
+	reasonInvalidRequest   = "invalid_request"
+	reasonInvalidIssuer    = "invalid_issuer"
+	reasonInvalidCSR       = "invalid_csr"
+	reasonNotAuthenticated = "not_authenticated"
+	reasonInvalidToken     = "invalid_token"
+	reasonValidationFailed = "token_validation_failed"
+	reasonIdentityMismatch = "identity_mismatch"
+	reasonIssuanceFailed   = "issuance_failed"
+	reasonDenied           = "denied"
+
+	labelResult = "result"
+	labelReason = "reason"
+	labelCode   = "code"
+
+	resultIssued   = "issued"
+	resultRejected = "rejected"
+
+	auditAuthorizationKey = "authorization"
+	auditBearerPrefix     = "Bearer "
+)
+
+var (
+	certIssuances = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "identity_cert_issuances_total",
+		Help: "A counter for the number of certificates issued by the identity service.",
+	})
+
+	certRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "identity_cert_rejections_total",
+		Help: "A counter for the number of certification requests rejected by the identity service.",
+	}, []string{labelReason, labelCode})
+
+	certifyDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "identity_certify_duration_seconds",
+		Help:    "A histogram of the time taken by the identity service to process certification requests.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{labelResult})
+
+	certLifetime = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "identity_cert_lifetime_seconds",
+		Help: "A histogram of the lifetime of the certificates issued by the identity service, from their issuance to their expiry.",
+		// from 1 minute to ~1 year
+		Buckets: prometheus.ExponentialBuckets(60, 4, 10),
+	})
+)
+
+// Auditor keeps the audit trail of the certification requests. Every record
+// is written as a JSON line to a sink, and the most recent ones are kept in
+// memory to be served through the Audit gRPC API.
+type Auditor struct {
+	sink      io.Writer
+	size      int
+	marshaler *jsonpb.Marshaler
+
+	sync.Mutex
+	records []*publicPb.Issuance
+}
+
+// NewAuditor returns an Auditor writing the records to sink, and keeping the
+// last size records in memory. No record is written when sink is nil.
+func NewAuditor(sink io.Writer, size int) *Auditor {
+	return &Auditor{
+		sink:      sink,
+		size:      size,
+		marshaler: &jsonpb.Marshaler{OrigName: true},
+		records:   make([]*publicPb.Issuance, 0, size),
+	}
+}
+
+// auditServer serves the records of an Auditor to the callers authenticated by
+// their service account token, as done for the certification requests, and
+// whose identity is one of the allowed clients.
+type auditServer struct {
+	auditor   *Auditor
+	validator Validator
+	clients   []string
+}
+
+// RegisterAudit registers an Audit gRPC API implementation in the provided
+// gRPC server, only serving the clients with one of the given identities.
+func RegisterAudit(g *grpc.Server, a *Auditor, validator Validator, clientNames ...string) {
+	auditPb.RegisterAuditServer(g, &auditServer{a, validator, clientNames})
+}
+
+// Issuances returns the records of the auditor to an authorized client.
+func (s *auditServer) Issuances(ctx context.Context, req *publicPb.IssuancesRequest) (*publicPb.IssuancesResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return s.auditor.Issuances(ctx, req)
+}
+
+func (s *auditServer) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(auditAuthorizationKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], auditBearerPrefix) {
+		return status.Error(codes.Unauthenticated, "missing service account token")
+	}
+
+	tokIdentity, err := s.validator.Validate(ctx, []byte(strings.TrimPrefix(values[0], auditBearerPrefix)))
+	if err != nil {
+		switch e := err.(type) {
+		case NotAuthenticated, InvalidToken:
+			log.Infof("authentication failed for an audit request: %s", e)
+			return status.Error(codes.Unauthenticated, e.Error())
+		default:
+			msg := fmt.Sprintf("error validating the token of an audit request: %s", e)
+			log.Error(msg)
+			return status.Error(codes.Internal, msg)
+		}
+	}
+
+	for _, client := range s.clients {
+		if tokIdentity == client {
+			return nil
+		}
+	}
+	log.Warnf("denied the audit records to %s", tokIdentity)
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to read the audit records", tokIdentity)
+}
+
+// auditTokenCredentials authenticates the calls to the Audit API with the
+// service account token read from a file, which is read on every call as it's
+// rotated by Kubernetes.
+type auditTokenCredentials struct {
+	tokenPath string
+}
+
+// NewAuditTokenCredentials returns the credentials authenticating the calls to
+// the Audit API with the service account token read from tokenPath.
+func NewAuditTokenCredentials(tokenPath string) credentials.PerRPCCredentials {
+	return auditTokenCredentials{tokenPath}
+}
+
+func (c auditTokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	token, err := ioutil.ReadFile(c.tokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the service account token: %s", err)
+	}
+	return map[string]string{auditAuthorizationKey: auditBearerPrefix + strings.TrimSpace(string(token))}, nil
+}
+
+// RequireTransportSecurity returns false, as the connections to the identity
+// service are secured by the proxies.
+func (auditTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// Issuances returns the records kept in memory, from the oldest to the most
+// recent.
+func (a *Auditor) Issuances(_ context.Context, req *publicPb.IssuancesRequest) (*publicPb.IssuancesResponse, error) {
+	a.Lock()
+	defer a.Unlock()
+
+	records := a.records
+	if limit := int(req.GetLimit()); limit > 0 && limit < len(records) {
+		records = records[len(records)-limit:]
+	}
+	issuances := make([]*publicPb.Issuance, len(records))
+	copy(issuances, records)
+	return &publicPb.IssuancesResponse{Issuances: issuances}, nil
+}
+
+func (a *Auditor) record(issuance *publicPb.Issuance) {
+	a.Lock()
+	defer a.Unlock()
+
+	if a.size > 0 {
+		if len(a.records) == a.size {
+			copy(a.records, a.records[1:])
+			a.records = a.records[:len(a.records)-1]
+		}
+		a.records = append(a.records, issuance)
+	}
+
+	if a.sink == nil {
+		return
+	}
+	line, err := a.marshaler.MarshalToString(issuance)
+	if err != nil {
+		log.Errorf("failed to marshal audit record: %s", err)
+		return
+	}
+	if _, err := io.WriteString(a.sink, line+"\n"); err != nil {
+		log.Errorf("failed to write audit record: %s", err)
+	}
+}
+
+// audit completes the record of a certification request that started at
+// start, with the outcome of the request, and reports it to the metrics and
+// to the auditor.
+func (svc *Service) audit(issuance *publicPb.Issuance, start time.Time, reason string, rsp *pb.CertifyResponse, err error) {
+	issuance.Timestamp = start.UTC().Format(time.RFC3339)
+	if err != nil {
+		st := status.Convert(err)
+		issuance.Code = st.Code().String()
+		issuance.RejectionReason = reason
+		issuance.Error = st.Message()
+
+		certRejections.With(prometheus.Labels{labelReason: reason, labelCode: issuance.Code}).Inc()
+		certifyDuration.With(prometheus.Labels{labelResult: resultRejected}).Observe(time.Since(start).Seconds())
+	} else {
+		issuance.Code = codes.OK.String()
+
+		certIssuances.Inc()
+		if validUntil, err := ptypes.Timestamp(rsp.GetValidUntil()); err == nil {
+			certLifetime.Observe(validUntil.Sub(start).Seconds())
+		}
+		certifyDuration.With(prometheus.Labels{labelResult: resultIssued}).Observe(time.Since(start).Seconds())
+	}
+
+	if svc.auditor != nil {
+		svc.auditor.record(issuance)
+	}
+}
+
+// serviceAccount returns the service account of a proxy identity, as
+// `namespace/name`.
+func serviceAccount(identity string) string {
+	parts := strings.SplitN(identity, ".", 3)
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[1] + "/" + parts[0]
+}
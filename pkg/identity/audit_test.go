@@ -0,0 +1,206 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	publicPb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const auditedIdentity = "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+
+func newAuditedService(t *testing.T, tokIdentity string, auditor *Auditor) *Service {
+	root, err := tls.GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	validity := tls.Validity{Lifetime: DefaultIssuanceLifetime}
+	recordEvent := func(eventType, reason, message string) {}
+	svc := NewService(&fakeValidator{tokIdentity, nil}, root.Cred.Crt.CertPool(), &validity, recordEvent, "", "", nil, auditor, "", nil, nil)
+	svc.updateIssuer(tls.NewCA(issuer.Cred, validity))
+	return svc
+}
+
+func newCertifyRequest(t *testing.T, identity string) *pb.CertifyRequest {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{identity}}, key)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return &pb.CertifyRequest{
+		Identity:                  identity,
+		Token:                     []byte("token"),
+		CertificateSigningRequest: csr,
+	}
+}
+
+func TestCertifyAudit(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tokIdentity string
+		expected    publicPb.Issuance
+	}{
+		{
+			name:        "issuance",
+			tokIdentity: auditedIdentity,
+			expected: publicPb.Issuance{
+				RequestedIdentity: auditedIdentity,
+				ServiceAccount:    "emojivoto/web",
+				Code:              "OK",
+			},
+		},
+		{
+			name:        "identity mismatch",
+			tokIdentity: "default.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			expected: publicPb.Issuance{
+				RequestedIdentity: auditedIdentity,
+				ServiceAccount:    "emojivoto/default",
+				Code:              "FailedPrecondition",
+				RejectionReason:   reasonIdentityMismatch,
+				Error:             "requested identity did not match provided token: requested=" + auditedIdentity + "; found=default.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			var sink bytes.Buffer
+			svc := newAuditedService(t, tc.tokIdentity, NewAuditor(&sink, DefaultAuditRecords))
+
+			rsp, _ := svc.Certify(context.Background(), newCertifyRequest(t, auditedIdentity))
+
+			var record publicPb.Issuance
+			if err := json.Unmarshal(sink.Bytes(), &record); err != nil {
+				t.Fatalf("Expected a JSON audit record, got %q: %s", sink.String(), err)
+			}
+			if _, err := time.Parse(time.RFC3339, record.Timestamp); err != nil {
+				t.Fatalf("Expected an RFC 3339 timestamp, got %q", record.Timestamp)
+			}
+
+			if rsp != nil {
+				crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if record.Serial != crt.SerialNumber.Text(16) {
+					t.Fatalf("Expected serial %s, got %s", crt.SerialNumber.Text(16), record.Serial)
+				}
+				if record.NotAfter != crt.NotAfter.UTC().Format(time.RFC3339) {
+					t.Fatalf("Expected NotAfter %s, got %s", crt.NotAfter.UTC().Format(time.RFC3339), record.NotAfter)
+				}
+			} else if record.Serial != "" || record.NotAfter != "" {
+				t.Fatalf("Expected no certificate in the record, got %+v", record)
+			}
+
+			record.Timestamp, record.Serial, record.NotAfter = "", "", ""
+			if !proto.Equal(&record, &tc.expected) {
+				t.Fatalf("Expected record %+v, got %+v", tc.expected, record)
+			}
+		})
+	}
+}
+
+func TestCertifyAuditWithoutIssuer(t *testing.T) {
+	var sink bytes.Buffer
+	svc := NewService(&fakeValidator{auditedIdentity, nil}, nil, nil, nil, "", "", nil, NewAuditor(&sink, DefaultAuditRecords), "", nil, nil)
+
+	svc.Certify(context.Background(), &pb.CertifyRequest{Identity: auditedIdentity})
+
+	if !strings.Contains(sink.String(), `"rejection_reason":"issuer_not_ready"`) {
+		t.Fatalf("Expected the rejection to be recorded, got %q", sink.String())
+	}
+}
+
+func TestAuditorIssuances(t *testing.T) {
+	auditor := NewAuditor(nil, 3)
+	for _, identity := range []string{"a", "b", "c", "d"} {
+		auditor.record(&publicPb.Issuance{RequestedIdentity: identity})
+	}
+
+	testCases := []struct {
+		limit    uint32
+		expected []string
+	}{
+		{0, []string{"b", "c", "d"}},
+		{2, []string{"c", "d"}},
+		{5, []string{"b", "c", "d"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		rsp, err := auditor.Issuances(context.Background(), &publicPb.IssuancesRequest{Limit: tc.limit})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var identities []string
+		for _, issuance := range rsp.GetIssuances() {
+			identities = append(identities, issuance.GetRequestedIdentity())
+		}
+		if strings.Join(identities, ",") != strings.Join(tc.expected, ",") {
+			t.Fatalf("Expected records %v with limit %d, got %v", tc.expected, tc.limit, identities)
+		}
+	}
+}
+
+func TestServiceAccount(t *testing.T) {
+	if sa := serviceAccount(auditedIdentity); sa != "emojivoto/web" {
+		t.Fatalf("Expected emojivoto/web, got %s", sa)
+	}
+	if sa := serviceAccount("invalid"); sa != "" {
+		t.Fatalf("Expected no service account, got %s", sa)
+	}
+}
+
+func TestAuditServerAuthorization(t *testing.T) {
+	const controller = "linkerd-controller.linkerd.serviceaccount.identity.linkerd.cluster.local"
+
+	testCases := []struct {
+		name          string
+		authorization string
+		validator     *fakeValidator
+		expectedCode  codes.Code
+	}{
+		{"allowed client", "Bearer token", &fakeValidator{controller, nil}, codes.OK},
+		{"other client", "Bearer token", &fakeValidator{auditedIdentity, nil}, codes.PermissionDenied},
+		{"missing token", "", &fakeValidator{controller, nil}, codes.Unauthenticated},
+		{"invalid token", "Bearer token", &fakeValidator{"", NotAuthenticated{}}, codes.Unauthenticated},
+		{"validation failure", "Bearer token", &fakeValidator{"", errors.New("api unavailable")}, codes.Internal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			server := &auditServer{NewAuditor(nil, 10), tc.validator, []string{controller}}
+
+			ctx := context.Background()
+			if tc.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.authorization))
+			}
+			_, err := server.Issuances(ctx, &publicPb.IssuancesRequest{})
+			if code := status.Code(err); code != tc.expectedCode {
+				t.Fatalf("Expected code %s, got %s (%v)", tc.expectedCode, code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	configPb "github.com/linkerd/linkerd2/controller/gen/config"
+	pkgTls "github.com/linkerd/linkerd2/pkg/tls"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	// componentRefreshRatio is the ratio of the lifetime of the certificate
+	// after which it's renewed, as done by the proxies.
+	componentRefreshRatio = 0.7
+
+	componentRetryInterval = 10 * time.Second
+)
+
+// ComponentCredentials obtains the certificate of a control plane component
+// from the identity service, the same way the proxies do, and renews it before
+// it expires. It provides the TLS configurations securing the calls between
+// the control plane components with mTLS, the peers being authenticated by
+// their identity.
+type ComponentCredentials struct {
+	name         string
+	tokenPath    string
+	trustAnchors *x509.CertPool
+	client       pb.IdentityClient
+	key          crypto.Signer
+	csr          []byte
+
+	sync.RWMutex
+	crt    *tls.Certificate
+	expiry time.Time
+}
+
+// NewComponentCredentials obtains the initial certificate of the given
+// identity, authenticated by the service account token read from tokenPath.
+func NewComponentCredentials(ctx context.Context, client pb.IdentityClient, name, tokenPath string, trustAnchors *x509.CertPool) (*ComponentCredentials, error) {
+	key, err := pkgTls.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{name}}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ComponentCredentials{
+		name:         name,
+		tokenPath:    tokenPath,
+		trustAnchors: trustAnchors,
+		client:       client,
+		key:          key,
+		csr:          csr,
+	}
+	if err := c.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DialComponentCredentials obtains the certificate of the control plane
+// component running under the given service account from the identity service
+// at addr, and renews it until ctx is done.
+func DialComponentCredentials(ctx context.Context, addr, serviceAccount, tokenPath, controllerNamespace string, idctx *configPb.IdentityContext) (*ComponentCredentials, error) {
+	trustAnchors, err := pkgTls.DecodePEMCertPool(idctx.GetTrustAnchorsPem())
+	if err != nil {
+		return nil, err
+	}
+
+	// the identity service is reached through the proxy of the component, as
+	// done by the other control plane clients
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	name := ComponentName(serviceAccount, controllerNamespace, idctx.GetTrustDomain())
+	creds, err := NewComponentCredentials(ctx, pb.NewIdentityClient(conn), name, tokenPath, trustAnchors)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	go creds.Run(ctx)
+	return creds, nil
+}
+
+// ComponentName returns the identity of the control plane component running
+// under the given service account.
+func ComponentName(serviceAccount, controllerNamespace, trustDomain string) string {
+	return fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", serviceAccount, controllerNamespace, controllerNamespace, trustDomain)
+}
+
+// Name returns the identity of the component.
+func (c *ComponentCredentials) Name() string {
+	return c.name
+}
+
+// Refresh requests a new certificate from the identity service. The current
+// certificate is kept on failure.
+func (c *ComponentCredentials) Refresh(ctx context.Context) error {
+	token, err := ioutil.ReadFile(c.tokenPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the service account token: %s", err)
+	}
+
+	rsp, err := c.client.Certify(ctx, &pb.CertifyRequest{
+		Identity:                  c.name,
+		Token:                     token,
+		CertificateSigningRequest: c.csr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to certify %s: %s", c.name, err)
+	}
+
+	chain := append([][]byte{rsp.GetLeafCertificate()}, rsp.GetIntermediateCertificates()...)
+	if err := verifyChain(chain, c.trustAnchors, []string{c.name}); err != nil {
+		return fmt.Errorf("invalid certificate issued for %s: %s", c.name, err)
+	}
+	expiry, err := ptypes.Timestamp(rsp.GetValidUntil())
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.crt = &tls.Certificate{Certificate: chain, PrivateKey: c.key}
+	c.expiry = expiry
+	log.Infof("Certified %s until %s", c.name, expiry)
+	return nil
+}
+
+// Run renews the certificate before it expires, until ctx is done.
+func (c *ComponentCredentials) Run(ctx context.Context) {
+	for {
+		c.RLock()
+		wait := time.Duration(float64(time.Until(c.expiry)) * componentRefreshRatio)
+		c.RUnlock()
+		if wait < componentRetryInterval {
+			wait = componentRetryInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+			if err := c.Refresh(ctx); err != nil {
+				log.Errorf("Failed to renew the certificate of %s: %s", c.name, err)
+			}
+		}
+	}
+}
+
+func (c *ComponentCredentials) certificate() *tls.Certificate {
+	c.RLock()
+	defer c.RUnlock()
+	return c.crt
+}
+
+// ServerTLSConfig returns the configuration of a TLS server presenting the
+// certificate of the component, and only accepting the clients presenting
+// one of the given identities.
+func (c *ComponentCredentials) ServerTLSConfig(clientNames ...string) *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return c.certificate(), nil
+		},
+		// the client certificates are verified against the trust anchors and
+		// the expected names by verifyChain
+		ClientAuth: tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyChain(rawCerts, c.trustAnchors, clientNames)
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// ClientTLSConfig returns the configuration of a TLS client presenting the
+// certificate of the component, and only accepting a server presenting the
+// given identity. The server name sent to the server defaults to the host of
+// the dialed address, so that the proxy of the server forwards the connection
+// untouched; setting it to the identity of the server lets its proxy
+// terminate TLS instead, for the servers that don't handle TLS themselves.
+func (c *ComponentCredentials) ClientTLSConfig(serverIdentity string) *tls.Config {
+	return &tls.Config{
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return c.certificate(), nil
+		},
+		// the server certificate is verified against the trust anchors and the
+		// expected name by verifyChain, as its name doesn't match the host of
+		// the dialed address
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyChain(rawCerts, c.trustAnchors, []string{serverIdentity})
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// verifyChain verifies that the given DER-encoded certificate chain is issued
+// by the trust anchors, for one of the given names.
+func verifyChain(rawCerts [][]byte, trustAnchors *x509.CertPool, names []string) error {
+	if len(rawCerts) == 0 || len(rawCerts[0]) == 0 {
+		return errors.New("no certificate presented")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		crt, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs[i] = crt
+	}
+
+	crt := pkgTls.Crt{Certificate: certs[0], TrustChain: certs[1:]}
+	var err error
+	for _, name := range names {
+		if err = crt.Verify(trustAnchors, name, time.Time{}); err == nil {
+			return nil
+		}
+	}
+	if err == nil {
+		err = errors.New("no identity is allowed")
+	}
+	return err
+}
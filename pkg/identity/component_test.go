@@ -0,0 +1,131 @@
+package identity
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	pkgTls "github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc"
+)
+
+const (
+	webIdentity = "linkerd-web.linkerd.serviceaccount.identity.linkerd.cluster.local"
+	apiIdentity = "linkerd-controller.linkerd.serviceaccount.identity.linkerd.cluster.local"
+)
+
+// serviceClient calls a Service in-process.
+type serviceClient struct {
+	svc *Service
+}
+
+func (c *serviceClient) Certify(ctx context.Context, req *pb.CertifyRequest, _ ...grpc.CallOption) (*pb.CertifyResponse, error) {
+	return c.svc.Certify(ctx, req)
+}
+
+func writeToken(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "component-credentials")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	tokenPath := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenPath, []byte("token"), 0600); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return tokenPath, func() { os.RemoveAll(dir) }
+}
+
+func newComponentCredentials(t *testing.T, svc *Service, tokenPath, identity string) *ComponentCredentials {
+	svc.validator = &fakeValidator{identity, nil}
+	creds, err := NewComponentCredentials(context.Background(), &serviceClient{svc}, identity, tokenPath, svc.trustAnchors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return creds
+}
+
+func handshake(t *testing.T, server, client *tls.Config) error {
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err != nil {
+		<-serverErr
+		return err
+	}
+	defer conn.Close()
+	return <-serverErr
+}
+
+func TestComponentCredentials(t *testing.T) {
+	tokenPath, cleanup := writeToken(t)
+	defer cleanup()
+	svc := newAuditedService(t, webIdentity, nil)
+	web := newComponentCredentials(t, svc, tokenPath, webIdentity)
+	api := newComponentCredentials(t, svc, tokenPath, apiIdentity)
+
+	if web.Name() != webIdentity {
+		t.Fatalf("Expected name %s, got %s", webIdentity, web.Name())
+	}
+
+	testCases := []struct {
+		name   string
+		server *tls.Config
+		client *tls.Config
+		ok     bool
+	}{
+		{"allowed client", api.ServerTLSConfig(webIdentity), web.ClientTLSConfig(apiIdentity), true},
+		{"unexpected client", api.ServerTLSConfig(webIdentity), api.ClientTLSConfig(apiIdentity), false},
+		{"unexpected server", api.ServerTLSConfig(webIdentity), web.ClientTLSConfig("other.linkerd.serviceaccount.identity.linkerd.cluster.local"), false},
+		{"client without certificate", api.ServerTLSConfig(webIdentity), &tls.Config{InsecureSkipVerify: true}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			err := handshake(t, tc.server, tc.client)
+			if tc.ok && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !tc.ok && err == nil {
+				t.Fatal("Expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestComponentCredentialsUntrusted(t *testing.T) {
+	tokenPath, cleanup := writeToken(t)
+	defer cleanup()
+	svc := newAuditedService(t, webIdentity, nil)
+	other, err := pkgTls.GenerateRootCAWithDefaults("other.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// the certificates issued by svc aren't issued by these trust anchors
+	if _, err := NewComponentCredentials(context.Background(), &serviceClient{svc}, webIdentity, tokenPath, other.Cred.Crt.CertPool()); err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+	if _, err := NewComponentCredentials(context.Background(), &serviceClient{svc}, webIdentity, tokenPath+".missing", svc.trustAnchors); err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+}
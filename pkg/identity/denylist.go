@@ -0,0 +1,167 @@
+package identity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DenyListIdentitiesKey is the key of the deny list ConfigMap holding the
+	// denied identities, one per line.
+	DenyListIdentitiesKey = "identities"
+
+	// DenyListAudiencesKey is the key of the deny list ConfigMap holding the
+	// denied token audiences, one per line.
+	DenyListAudiencesKey = "audiences"
+)
+
+// DenyList holds the identities and token audiences the identity service
+// refuses to certify, e.g. because the token of a service account is
+// suspected to be leaked. Its entries are glob patterns, as matched by
+// path.Match, so that `*.emojivoto.serviceaccount.identity.linkerd.cluster.local`
+// denies all the service accounts of the emojivoto namespace. A nil DenyList
+// denies nothing.
+type DenyList struct {
+	sync.RWMutex
+	identities []string
+	audiences  []string
+}
+
+// NewDenyList returns a DenyList with the given entries, or an error if one of
+// them is not a valid pattern.
+func NewDenyList(identities, audiences []string) (*DenyList, error) {
+	dl := &DenyList{}
+	if err := dl.Update(identities, audiences); err != nil {
+		return nil, err
+	}
+	return dl, nil
+}
+
+// NewDenyListFromConfigMap returns the DenyList stored in the given ConfigMap.
+// A nil ConfigMap holds an empty DenyList.
+func NewDenyListFromConfigMap(cm *v1.ConfigMap) (*DenyList, error) {
+	dl := &DenyList{}
+	if err := dl.UpdateFromConfigMap(cm); err != nil {
+		return nil, err
+	}
+	return dl, nil
+}
+
+// UpdateFromConfigMap replaces the entries of the DenyList with the ones
+// stored in the given ConfigMap, one per line. A nil ConfigMap empties the
+// DenyList.
+func (dl *DenyList) UpdateFromConfigMap(cm *v1.ConfigMap) error {
+	if cm == nil {
+		return dl.Update(nil, nil)
+	}
+	return dl.Update(splitLines(cm.Data[DenyListIdentitiesKey]), splitLines(cm.Data[DenyListAudiencesKey]))
+}
+
+// Update replaces the entries of the DenyList. It's left unchanged if one of
+// the new entries is not a valid pattern.
+func (dl *DenyList) Update(identities, audiences []string) error {
+	for _, entry := range append(append([]string{}, identities...), audiences...) {
+		if _, err := path.Match(entry, ""); err != nil {
+			return fmt.Errorf("invalid deny list entry %q: %s", entry, err)
+		}
+	}
+
+	dl.Lock()
+	defer dl.Unlock()
+	dl.identities = identities
+	dl.audiences = audiences
+	return nil
+}
+
+// Empty returns true if the DenyList denies nothing.
+func (dl *DenyList) Empty() bool {
+	if dl == nil {
+		return true
+	}
+	dl.RLock()
+	defer dl.RUnlock()
+	return len(dl.identities) == 0 && len(dl.audiences) == 0
+}
+
+// DeniesIdentity returns the entry denying the given identity, if any.
+func (dl *DenyList) DeniesIdentity(identity string) (string, bool) {
+	if dl == nil {
+		return "", false
+	}
+	dl.RLock()
+	defer dl.RUnlock()
+	return match(dl.identities, identity)
+}
+
+// DeniesAudiences returns the entry denying one of the given token
+// audiences, if any.
+func (dl *DenyList) DeniesAudiences(audiences []string) (string, bool) {
+	if dl == nil {
+		return "", false
+	}
+	dl.RLock()
+	defer dl.RUnlock()
+	for _, audience := range audiences {
+		if entry, ok := match(dl.audiences, audience); ok {
+			return entry, true
+		}
+	}
+	return "", false
+}
+
+func match(entries []string, name string) (string, bool) {
+	for _, entry := range entries {
+		if ok, _ := path.Match(entry, name); ok {
+			return entry, true
+		}
+	}
+	return "", false
+}
+
+func splitLines(s string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// tokenAudiences returns the audiences of a JWT token. The token must have
+// already been validated, so its signature isn't checked. The legacy service
+// account tokens have no audience.
+func tokenAudiences(tok []byte) []string {
+	parts := strings.Split(string(tok), ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+
+	var claims struct {
+		Aud json.RawMessage `json:"aud"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || len(claims.Aud) == 0 {
+		return nil
+	}
+
+	// The audience claim is either a single string or an array of strings.
+	var audience string
+	if err := json.Unmarshal(claims.Aud, &audience); err == nil {
+		return []string{audience}
+	}
+	var audiences []string
+	if err := json.Unmarshal(claims.Aud, &audiences); err == nil {
+		return audiences
+	}
+	return nil
+}
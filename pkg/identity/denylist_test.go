@@ -0,0 +1,148 @@
+package identity
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	publicPb "github.com/linkerd/linkerd2/controller/gen/public"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+)
+
+func newToken(claims string) []byte {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	return []byte(header + "." + payload + ".signature")
+}
+
+func TestDenyList(t *testing.T) {
+	dl, err := NewDenyListFromConfigMap(&v1.ConfigMap{Data: map[string]string{
+		DenyListIdentitiesKey: `
+# leaked on 2020-01-01
+web.emojivoto.serviceaccount.identity.linkerd.cluster.local
+*.books.serviceaccount.identity.linkerd.cluster.local
+`,
+		DenyListAudiencesKey: "leaked-audience",
+	}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		identity string
+		entry    string
+	}{
+		{auditedIdentity, auditedIdentity},
+		{"authors.books.serviceaccount.identity.linkerd.cluster.local", "*.books.serviceaccount.identity.linkerd.cluster.local"},
+		{"voting.emojivoto.serviceaccount.identity.linkerd.cluster.local", ""},
+	}
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.identity, func(t *testing.T) {
+			entry, denied := dl.DeniesIdentity(tc.identity)
+			if entry != tc.entry || denied != (tc.entry != "") {
+				t.Fatalf("Expected entry %q, got %q (denied: %t)", tc.entry, entry, denied)
+			}
+		})
+	}
+
+	if _, denied := dl.DeniesAudiences([]string{"identity.l5d.io", "leaked-audience"}); !denied {
+		t.Fatal("Expected the audience to be denied")
+	}
+	if _, denied := dl.DeniesAudiences(nil); denied {
+		t.Fatal("Expected no audience to be denied")
+	}
+
+	var empty *DenyList
+	if !empty.Empty() {
+		t.Fatal("Expected a nil deny list to be empty")
+	}
+	if _, denied := empty.DeniesIdentity(auditedIdentity); denied {
+		t.Fatal("Expected a nil deny list to deny nothing")
+	}
+}
+
+func TestDenyListUpdate(t *testing.T) {
+	dl, err := NewDenyList([]string{auditedIdentity}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := dl.Update([]string{"[invalid"}, nil); err == nil {
+		t.Fatal("Expected an error, got none")
+	}
+	if _, denied := dl.DeniesIdentity(auditedIdentity); !denied {
+		t.Fatal("Expected the deny list to be left unchanged by an invalid update")
+	}
+
+	if err := dl.Update(nil, nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !dl.Empty() {
+		t.Fatal("Expected the deny list to be empty")
+	}
+}
+
+func TestTokenAudiences(t *testing.T) {
+	testCases := []struct {
+		name     string
+		token    []byte
+		expected []string
+	}{
+		{"single audience", newToken(`{"aud":"identity.l5d.io"}`), []string{"identity.l5d.io"}},
+		{"multiple audiences", newToken(`{"aud":["a","b"]}`), []string{"a", "b"}},
+		{"legacy token", newToken(`{"iss":"kubernetes/serviceaccount"}`), nil},
+		{"opaque token", []byte("token"), nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			if audiences := tokenAudiences(tc.token); !reflect.DeepEqual(audiences, tc.expected) {
+				t.Fatalf("Expected audiences %v, got %v", tc.expected, audiences)
+			}
+		})
+	}
+}
+
+func TestCertifyDenied(t *testing.T) {
+	testCases := []struct {
+		name       string
+		identities []string
+		audiences  []string
+		token      []byte
+		denied     bool
+	}{
+		{"denied identity", []string{auditedIdentity}, nil, []byte("token"), true},
+		{"denied audience", nil, []string{"leaked"}, newToken(`{"aud":["leaked"]}`), true},
+		{"allowed", []string{"other.emojivoto.serviceaccount.identity.linkerd.cluster.local"}, []string{"leaked"}, newToken(`{"aud":"identity.l5d.io"}`), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newAuditedService(t, auditedIdentity, nil)
+			dl, err := NewDenyList(tc.identities, tc.audiences)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			svc.denyList = dl
+
+			req := newCertifyRequest(t, auditedIdentity)
+			req.Token = tc.token
+			_, reason, err := svc.certify(context.Background(), req, &publicPb.Issuance{})
+			if !tc.denied {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if reason != reasonDenied || status.Code(err) != codes.PermissionDenied {
+				t.Fatalf("Expected a %s rejection, got %q and %v", codes.PermissionDenied, reason, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,7 +14,10 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	publicPb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -30,18 +35,30 @@ const (
 	eventTypeSkipped = "IssuerUpdateSkipped"
 	eventTypeUpdated = "IssuerUpdated"
 	eventTypeFailed  = "IssuerValidationFailed"
+
+	eventTypeLifetimeSkipped = "IssuanceLifetimeSkipped"
 )
 
+var issuerExpiry = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "identity_issuer_expiry_timestamp_seconds",
+	Help: "The expiry time of the issuer certificate currently loaded by the identity service, in seconds since the epoch.",
+})
+
 type (
 	// Service implements the gRPC service in terms of a Validator and Issuer.
 	Service struct {
-		validator                                  Validator
-		trustAnchors                               *x509.CertPool
-		issuer                                     *tls.Issuer
-		issuerMutex                                *sync.RWMutex
-		validity                                   *tls.Validity
-		recordEvent                                func(eventType, reason, message string)
-		expectedName, issuerPathCrt, issuerPathKey string
+		validator                   Validator
+		trustAnchors                *x509.CertPool
+		issuer                      *tls.Issuer
+		issuerMutex                 *sync.RWMutex
+		validity                    *tls.Validity
+		recordEvent                 func(eventType, reason, message string)
+		expectedName, issuerPathCrt string
+		signerBackend               SignerBackend
+		auditor                     *Auditor
+		spiffeTrustDomain           string
+		lifetimePolicy              LifetimePolicy
+		denyList                    *DenyList
 	}
 
 	// Validator implementors accept a bearer token, validates it, and returns a
@@ -58,6 +75,16 @@ type (
 		Validate(context.Context, []byte) (string, error)
 	}
 
+	// LifetimePolicy implementors choose the lifetime of the certificates
+	// issued to an identity, overriding the lifetime of the service.
+	LifetimePolicy interface {
+		// Lifetime takes a DNS-form identity, whose token has already been
+		// validated, and returns the lifetime of the certificates issued to it,
+		// or 0 if the default lifetime applies. The default lifetime also
+		// applies when it returns an error.
+		Lifetime(context.Context, string) (time.Duration, error)
+	}
+
 	// InvalidToken is an error type returned by Validators to indicate that the
 	// provided authentication token was not valid.
 	InvalidToken struct{ Reason string }
@@ -105,11 +132,17 @@ func (svc *Service) Run(issuerEvent <-chan struct{}, issuerError <-chan error) {
 }
 
 func (svc *Service) loadCredentials() (tls.Issuer, error) {
-	creds, err := tls.ReadPEMCreds(
-		svc.issuerPathKey,
-		svc.issuerPathCrt,
-	)
+	crt, err := tls.ReadPEMCrt(svc.issuerPathCrt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA from disk: %s", err)
+	}
 
+	signer, err := svc.signerBackend.Signer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the signer of the CA: %s", err)
+	}
+
+	creds, err := tls.NewSignerCred(*crt, signer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read CA from disk: %s", err)
 	}
@@ -119,11 +152,18 @@ func (svc *Service) loadCredentials() (tls.Issuer, error) {
 	}
 
 	log.Debugf("Loaded issuer cert: %s", creds.EncodeCertificatePEM())
+	issuerExpiry.Set(float64(creds.Crt.Certificate.NotAfter.Unix()))
 	return tls.NewCA(*creds, *svc.validity), nil
 }
 
-// NewService creates a new identity service.
-func NewService(validator Validator, trustAnchors *x509.CertPool, validity *tls.Validity, recordEvent func(eventType, reason, message string), expectedName, issuerPathCrt, issuerPathKey string) *Service {
+// NewService creates a new identity service. The issuer certificate is read
+// from issuerPathCrt, and its private key is held by signerBackend. Every
+// certification request is recorded by auditor, when not nil. When
+// spiffeTrustDomain is set, the issued certificates also hold the SPIFFE ID
+// of their identity in that trust domain. When lifetimePolicy is not nil, it
+// may override the lifetime of the certificates issued to each identity. The
+// identities and token audiences on denyList, when not nil, aren't certified.
+func NewService(validator Validator, trustAnchors *x509.CertPool, validity *tls.Validity, recordEvent func(eventType, reason, message string), expectedName, issuerPathCrt string, signerBackend SignerBackend, auditor *Auditor, spiffeTrustDomain string, lifetimePolicy LifetimePolicy, denyList *DenyList) *Service {
 	return &Service{
 		validator,
 		trustAnchors,
@@ -133,7 +173,11 @@ func NewService(validator Validator, trustAnchors *x509.CertPool, validity *tls.
 		recordEvent,
 		expectedName,
 		issuerPathCrt,
-		issuerPathKey,
+		signerBackend,
+		auditor,
+		spiffeTrustDomain,
+		lifetimePolicy,
+		denyList,
 	}
 }
 
@@ -145,8 +189,7 @@ func Register(g *grpc.Server, s *Service) {
 
 // ensureIssuerStillValid should check that the CA is still good time wise
 // and verifies just fine with the provided trust anchors
-func (svc *Service) ensureIssuerStillValid() error {
-	issuer := *svc.issuer
+func (svc *Service) ensureIssuerStillValid(issuer tls.Issuer) error {
 	switch is := issuer.(type) {
 	case *tls.CA:
 		return is.Cred.Verify(svc.trustAnchors, svc.expectedName, time.Time{})
@@ -157,30 +200,44 @@ func (svc *Service) ensureIssuerStillValid() error {
 
 // Certify validates identity and signs certificates.
 func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.CertifyResponse, error) {
+	start := time.Now()
+	issuance := &publicPb.Issuance{RequestedIdentity: req.GetIdentity()}
+	rsp, reason, err := svc.certify(ctx, req, issuance)
+	svc.audit(issuance, start, reason, rsp, err)
+	return rsp, err
+}
+
+// certify processes a certification request, filling the audit record of the
+// request along the way. When the request is rejected, it also returns the
+// reason of the rejection.
+func (svc *Service) certify(ctx context.Context, req *pb.CertifyRequest, issuance *publicPb.Issuance) (*pb.CertifyResponse, string, error) {
+	// The issuer is safe for concurrent issuances, so the lock only guards
+	// its reloads, and isn't held while the request is processed.
 	svc.issuerMutex.RLock()
-	defer svc.issuerMutex.RUnlock()
+	issuer := svc.issuer
+	svc.issuerMutex.RUnlock()
 
-	if svc.issuer == nil {
+	if issuer == nil {
 		log.Warn("Certificate issuer is not ready")
-		return nil, status.Error(codes.Unavailable, "cert issuer not ready yet")
+		return nil, reasonIssuerNotReady, status.Error(codes.Unavailable, "cert issuer not ready yet")
 	}
 
 	// Extract the relevant info from the request.
 	reqIdentity, tok, csr, err := checkRequest(req)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, reasonInvalidRequest, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := svc.ensureIssuerStillValid(); err != nil {
+	if err := svc.ensureIssuerStillValid(*issuer); err != nil {
 		log.Errorf("could not process CSR because of CA cert validation failure: %s - CSR Identity : %s", err, reqIdentity)
 		message := fmt.Sprintf("%s - CSR Identity : %s", err.Error(), reqIdentity)
 		svc.recordEvent(v1.EventTypeWarning, eventTypeFailed, message)
-		return nil, err
+		return nil, reasonInvalidIssuer, err
 	}
 
 	if err = checkCSR(csr, reqIdentity); err != nil {
 		log.Debugf("requester sent invalid CSR: %s", err)
-		return nil, status.Error(codes.FailedPrecondition, err.Error())
+		return nil, reasonInvalidCSR, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	// Authenticate the provided token against the Kubernetes API.
@@ -190,31 +247,59 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 		switch e := err.(type) {
 		case NotAuthenticated:
 			log.Infof("authentication failed for %s: %s", reqIdentity, e)
-			return nil, status.Error(codes.FailedPrecondition, e.Error())
+			return nil, reasonNotAuthenticated, status.Error(codes.FailedPrecondition, e.Error())
 		case InvalidToken:
 			log.Debugf("invalid token provided for %s: %s", reqIdentity, e)
-			return nil, status.Error(codes.InvalidArgument, e.Error())
+			return nil, reasonInvalidToken, status.Error(codes.InvalidArgument, e.Error())
 		default:
 			msg := fmt.Sprintf("error validating token for %s: %s", reqIdentity, e)
 			log.Error(msg)
-			return nil, status.Error(codes.Internal, msg)
+			return nil, reasonValidationFailed, status.Error(codes.Internal, msg)
 		}
 	}
+	issuance.ServiceAccount = serviceAccount(tokIdentity)
 
 	// Ensure the requested identity matches the token's identity.
 	if reqIdentity != tokIdentity {
 		msg := fmt.Sprintf("requested identity did not match provided token: requested=%s; found=%s",
 			reqIdentity, tokIdentity)
 		log.Debug(msg)
-		return nil, status.Error(codes.FailedPrecondition, msg)
+		return nil, reasonIdentityMismatch, status.Error(codes.FailedPrecondition, msg)
+	}
+
+	// Refuse the identities, or the tokens, that have been revoked.
+	if entry, denied := svc.denyList.DeniesIdentity(tokIdentity); denied {
+		msg := fmt.Sprintf("identity %s is denied by the deny list entry %q", tokIdentity, entry)
+		log.Warn(msg)
+		return nil, reasonDenied, status.Error(codes.PermissionDenied, msg)
+	}
+	if entry, denied := svc.denyList.DeniesAudiences(tokenAudiences(tok)); denied {
+		msg := fmt.Sprintf("token of %s is denied by the deny list audience entry %q", tokIdentity, entry)
+		log.Warn(msg)
+		return nil, reasonDenied, status.Error(codes.PermissionDenied, msg)
+	}
+
+	// The CSR can't hold URIs, so the SPIFFE ID is only set by the service.
+	if svc.spiffeTrustDomain != "" {
+		id, err := spiffeID(svc.spiffeTrustDomain, tokIdentity)
+		if err != nil {
+			return nil, reasonIssuanceFailed, status.Error(codes.Internal, err.Error())
+		}
+		csr.URIs = []*url.URL{id}
+	}
+
+	crtIssuer, reason, err := svc.issuerFor(ctx, *issuer, tokIdentity)
+	if err != nil {
+		return nil, reason, err
 	}
 
 	// Create a certificate
-	issuer := *svc.issuer
-	crt, err := issuer.IssueEndEntityCrt(csr)
+	crt, err := crtIssuer.IssueEndEntityCrt(csr)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, reasonIssuanceFailed, status.Error(codes.Internal, err.Error())
 	}
+	issuance.Serial = crt.Certificate.SerialNumber.Text(16)
+	issuance.NotAfter = crt.Certificate.NotAfter.UTC().Format(time.RFC3339)
 	crts := crt.ExtractRaw()
 	if len(crts) == 0 {
 		log.Fatal("the issuer provided a certificate without key material")
@@ -225,7 +310,7 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 	validUntil, err := ptypes.TimestampProto(crt.Certificate.NotAfter)
 	if err != nil {
 		log.Errorf("invalid expiry time: %s", err)
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, reasonIssuanceFailed, status.Error(codes.Internal, err.Error())
 	}
 
 	rsp := &pb.CertifyResponse{
@@ -234,7 +319,50 @@ func (svc *Service) Certify(ctx context.Context, req *pb.CertifyRequest) (*pb.Ce
 
 		ValidUntil: validUntil,
 	}
-	return rsp, nil
+	return rsp, "", nil
+}
+
+// issuerFor returns the issuer of the certificates of the given identity,
+// which issues them for the lifetime chosen by the lifetime policy, if any.
+// The certificates are issued for the default lifetime when the policy can't
+// choose one. When the issuer can't be overridden, it also returns the reason
+// of the rejection.
+func (svc *Service) issuerFor(ctx context.Context, issuer tls.Issuer, identity string) (tls.Issuer, string, error) {
+	if svc.lifetimePolicy == nil {
+		return issuer, "", nil
+	}
+
+	lifetime, err := svc.lifetimePolicy.Lifetime(ctx, identity)
+	if err != nil {
+		message := fmt.Sprintf("Issuing certificates to %s for the default lifetime: %s", identity, err)
+		log.Warn(message)
+		svc.recordEvent(v1.EventTypeWarning, eventTypeLifetimeSkipped, message)
+		return issuer, "", nil
+	}
+	if lifetime <= 0 || lifetime == svc.validity.Lifetime {
+		return issuer, "", nil
+	}
+
+	ca, ok := issuer.(*tls.CA)
+	if !ok {
+		return nil, reasonIssuanceFailed, status.Errorf(codes.Internal, "unsupported issuer type. Expected *tls.CA, got %v", issuer)
+	}
+	log.Debugf("issuing certificates for %s to %s", lifetime, identity)
+	return ca.WithLifetime(lifetime), "", nil
+}
+
+// spiffeID returns the SPIFFE ID of a DNS-form proxy identity, in the given
+// trust domain: `spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>`.
+func spiffeID(trustDomain, identity string) (*url.URL, error) {
+	parts := strings.SplitN(identity, ".", 4)
+	if len(parts) < 4 || parts[2] != "serviceaccount" {
+		return nil, fmt.Errorf("not a service account identity: %s", identity)
+	}
+	return &url.URL{
+		Scheme: "spiffe",
+		Host:   trustDomain,
+		Path:   fmt.Sprintf("/ns/%s/sa/%s", parts[1], parts[0]),
+	}, nil
 }
 
 func checkRequest(req *pb.CertifyRequest) (string, []byte, *x509.CertificateRequest, error) {
@@ -3,9 +3,12 @@ package identity
 import (
 	"context"
 	"crypto/x509"
+	"errors"
 	"testing"
+	"time"
 
 	pb "github.com/linkerd/linkerd2-proxy-api/go/identity"
+	publicPb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/tls"
 )
 
@@ -23,13 +26,22 @@ func (fi *fakeIssuer) IssueEndEntityCrt(*x509.CertificateRequest) (tls.Crt, erro
 	return fi.result, fi.err
 }
 
+type fakeLifetimePolicy struct {
+	lifetime time.Duration
+	err      error
+}
+
+func (fp *fakeLifetimePolicy) Lifetime(context.Context, string) (time.Duration, error) {
+	return fp.lifetime, fp.err
+}
+
 func (fk *fakeValidator) Validate(context.Context, []byte) (string, error) {
 	return fk.result, fk.err
 }
 
 func TestServiceNotReady(t *testing.T) {
 	//ch := make(chan tls.Issuer, 1)
-	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", "")
+	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", nil, nil, "", nil, nil)
 	req := &pb.CertifyRequest{
 		Identity:                  "some-identitiy",
 		Token:                     []byte{},
@@ -50,7 +62,7 @@ func TestServiceNotReady(t *testing.T) {
 }
 
 func TestInvalidRequestArguments(t *testing.T) {
-	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", "")
+	svc := NewService(&fakeValidator{"successful-result", nil}, nil, nil, nil, "", "", nil, nil, "", nil, nil)
 	svc.updateIssuer(&fakeIssuer{tls.Crt{}, nil})
 	fakeData := "fake-data"
 	invalidCsr := pb.CertifyRequest{
@@ -93,3 +105,130 @@ func TestInvalidRequestArguments(t *testing.T) {
 	}
 
 }
+
+func TestCertifyWithSPIFFEIdentities(t *testing.T) {
+	root, err := tls.GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	validity := tls.Validity{Lifetime: DefaultIssuanceLifetime}
+	recordEvent := func(eventType, reason, message string) {}
+	svc := NewService(&fakeValidator{auditedIdentity, nil}, root.Cred.Crt.CertPool(), &validity, recordEvent, "", "", nil, nil, "cluster.local", nil, nil)
+	svc.updateIssuer(tls.NewCA(issuer.Cred, validity))
+
+	rsp, err := svc.Certify(context.Background(), newCertifyRequest(t, auditedIdentity))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := "spiffe://cluster.local/ns/emojivoto/sa/web"
+	if len(crt.URIs) != 1 || crt.URIs[0].String() != expected {
+		t.Fatalf("Expected URI SAN %s, got %v", expected, crt.URIs)
+	}
+	if len(crt.DNSNames) != 1 || crt.DNSNames[0] != auditedIdentity {
+		t.Fatalf("Expected DNS SAN %s, got %v", auditedIdentity, crt.DNSNames)
+	}
+
+	// the URI SAN must not break the name constraints of the issuer
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(issuer.Cred.Crt.Certificate)
+	opts := x509.VerifyOptions{
+		Roots:         root.Cred.Crt.CertPool(),
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := crt.Verify(opts); err != nil {
+		t.Fatalf("Expected the certificate to be valid, got %s", err)
+	}
+}
+
+func TestCertifyWithLifetimePolicy(t *testing.T) {
+	root, err := tls.GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name             string
+		policy           *fakeLifetimePolicy
+		expectedLifetime time.Duration
+		expectedEvent    string
+	}{
+		{"default lifetime", &fakeLifetimePolicy{}, DefaultIssuanceLifetime, ""},
+		{"shorter lifetime", &fakeLifetimePolicy{lifetime: time.Hour}, time.Hour, ""},
+		{"lookup failure", &fakeLifetimePolicy{err: errors.New("api unavailable")}, DefaultIssuanceLifetime, eventTypeLifetimeSkipped},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			validity := tls.Validity{Lifetime: DefaultIssuanceLifetime}
+			event := ""
+			recordEvent := func(eventType, reason, message string) { event = reason }
+			svc := NewService(&fakeValidator{auditedIdentity, nil}, root.Cred.Crt.CertPool(), &validity, recordEvent, "", "", nil, nil, "", tc.policy, nil)
+			svc.updateIssuer(tls.NewCA(issuer.Cred, validity))
+
+			start := time.Now()
+			rsp, _, err := svc.certify(context.Background(), newCertifyRequest(t, auditedIdentity), &publicPb.Issuance{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if event != tc.expectedEvent {
+				t.Fatalf("Expected event %q, got %q", tc.expectedEvent, event)
+			}
+
+			crt, err := x509.ParseCertificate(rsp.GetLeafCertificate())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			lifetime := crt.NotAfter.Sub(start)
+			if lifetime < tc.expectedLifetime || lifetime > tc.expectedLifetime+time.Minute {
+				t.Fatalf("Expected a lifetime of %s, got %s", tc.expectedLifetime, lifetime)
+			}
+		})
+	}
+}
+
+func TestSPIFFEID(t *testing.T) {
+	testCases := []struct {
+		identity      string
+		expected      string
+		expectedError string
+	}{
+		{auditedIdentity, "spiffe://example.com/ns/emojivoto/sa/web", ""},
+		{"linkerd-identity.linkerd.serviceaccount.identity.linkerd.example.com", "spiffe://example.com/ns/linkerd/sa/linkerd-identity", ""},
+		{"web.emojivoto.deployment.identity.linkerd.cluster.local", "", "not a service account identity: web.emojivoto.deployment.identity.linkerd.cluster.local"},
+		{"web.emojivoto", "", "not a service account identity: web.emojivoto"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.identity, func(t *testing.T) {
+			id, err := spiffeID("example.com", tc.identity)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if id.String() != tc.expected {
+				t.Fatalf("Expected SPIFFE ID %s, got %s", tc.expected, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/controller/signer"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SignerBackendFile is the backend that reads the private key of the
+	// issuer from disk, next to its certificate.
+	SignerBackendFile = "file"
+
+	// SignerBackendRemote is the backend that delegates the signatures to a
+	// remote signing service implementing the Signer gRPC API.
+	SignerBackendRemote = "remote"
+
+	remoteSignerTimeout = 10 * time.Second
+)
+
+var (
+	hashes = map[crypto.Hash]pb.Hash{
+		crypto.Hash(0): pb.Hash_NONE,
+		crypto.SHA256:  pb.Hash_SHA256,
+		crypto.SHA384:  pb.Hash_SHA384,
+		crypto.SHA512:  pb.Hash_SHA512,
+	}
+
+	// SignerBackends lists the supported signer backends.
+	SignerBackends = []string{SignerBackendFile, SignerBackendRemote}
+)
+
+type (
+	// SignerBackend provides the signer holding the private key of the issuer.
+	// The issuer certificate is always read from disk, whatever the backend,
+	// so that its rotation and validation don't depend on the backend.
+	SignerBackend interface {
+		// Signer returns the signer of the private key of the issuer. It's
+		// called every time the issuer certificate is reloaded.
+		Signer() (crypto.Signer, error)
+	}
+
+	fileSignerBackend struct {
+		keyPath string
+	}
+
+	remoteSignerBackend struct {
+		client pb.SignerClient
+	}
+
+	// remoteSigner is a crypto.Signer whose private key is held by a remote
+	// signing service.
+	remoteSigner struct {
+		client    pb.SignerClient
+		publicKey crypto.PublicKey
+	}
+
+	signerServer struct {
+		signer crypto.Signer
+	}
+)
+
+// NewFileSignerBackend returns a SignerBackend that reads the PEM-encoded
+// private key of the issuer from keyPath.
+func NewFileSignerBackend(keyPath string) SignerBackend {
+	return &fileSignerBackend{keyPath}
+}
+
+func (b *fileSignerBackend) Signer() (crypto.Signer, error) {
+	keyb, err := ioutil.ReadFile(b.keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return tls.DecodePEMKey(string(keyb))
+}
+
+// NewRemoteSignerBackend returns a SignerBackend that delegates the
+// signatures to the remote signing service at addr. The connection isn't
+// encrypted, so the service is expected to run next to the identity controller
+// (e.g. in a sidecar fronting an HSM or a KMS).
+func NewRemoteSignerBackend(addr string) (SignerBackend, error) {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignerBackend{pb.NewSignerClient(conn)}, nil
+}
+
+func (b *remoteSignerBackend) Signer() (crypto.Signer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	rsp, err := b.client.GetPublicKey(ctx, &pb.PublicKeyRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the public key of the remote signer: %s", err)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(rsp.GetPublicKey())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key from the remote signer: %s", err)
+	}
+	return &remoteSigner{b.client, publicKey}, nil
+}
+
+func (s *remoteSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *remoteSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash, ok := hashes[opts.HashFunc()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported hash function for remote signatures: %v", opts.HashFunc())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	rsp, err := s.client.Sign(ctx, &pb.SignRequest{Digest: digest, Hash: hash})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign: %s", err)
+	}
+	return rsp.GetSignature(), nil
+}
+
+// NewSignerServer returns an implementation of the Signer gRPC API that signs
+// with the given signer. It serves as a local stand-in for the signing
+// services of HSMs and KMSs.
+func NewSignerServer(signer crypto.Signer) pb.SignerServer {
+	return &signerServer{signer}
+}
+
+// RegisterSigner registers a Signer gRPC API implementation in the provided
+// gRPC server.
+func RegisterSigner(g *grpc.Server, s pb.SignerServer) {
+	pb.RegisterSignerServer(g, s)
+}
+
+func (s *signerServer) GetPublicKey(context.Context, *pb.PublicKeyRequest) (*pb.PublicKeyResponse, error) {
+	publicKey, err := x509.MarshalPKIXPublicKey(s.signer.Public())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.PublicKeyResponse{PublicKey: publicKey}, nil
+}
+
+func (s *signerServer) Sign(_ context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	var hash crypto.Hash
+	found := false
+	for h, pbHash := range hashes {
+		if pbHash == req.GetHash() {
+			hash, found = h, true
+			break
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported hash function: %s", req.GetHash())
+	}
+	if hash != 0 && len(req.GetDigest()) != hash.Size() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s digest length: %d", req.GetHash(), len(req.GetDigest()))
+	}
+
+	signature, err := s.signer.Sign(rand.Reader, req.GetDigest(), hash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SignResponse{Signature: signature}, nil
+}
@@ -0,0 +1,117 @@
+package identity
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/controller/signer"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"google.golang.org/grpc"
+)
+
+func TestRemoteSignerBackend(t *testing.T) {
+	root, err := tls.GenerateRootCAWithDefaults("root")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	issuer, err := root.GenerateCA("identity.linkerd.cluster.local", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	srv := grpc.NewServer()
+	RegisterSigner(srv, NewSignerServer(issuer.Cred.PrivateKey))
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	backend, err := NewRemoteSignerBackend(lis.Addr().String())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	signer, err := backend.Signer()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// the remote key must only be accepted for its own certificate
+	if _, err := tls.NewSignerCred(root.Cred.Crt, signer); err == nil {
+		t.Fatalf("Expected the root certificate to be rejected for the issuer's key")
+	}
+	cred, err := tls.NewSignerCred(issuer.Cred.Crt, signer)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ca := tls.NewCA(*cred, tls.Validity{Lifetime: time.Hour})
+	leaf, err := ca.GenerateEndEntityCred("foo.ns.serviceaccount.identity.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := leaf.Crt.Verify(root.Cred.Crt.CertPool(), "foo.ns.serviceaccount.identity.linkerd.cluster.local", time.Time{}); err != nil {
+		t.Fatalf("Expected the certificate signed remotely to be valid, got: %s", err)
+	}
+}
+
+func TestSignerServer(t *testing.T) {
+	key, err := tls.GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	srv := NewSignerServer(key)
+	digest := sha256.Sum256([]byte("linkerd"))
+
+	testCases := []struct {
+		name          string
+		req           *pb.SignRequest
+		expectedError string
+	}{
+		{
+			name: "signs a SHA256 digest",
+			req:  &pb.SignRequest{Digest: digest[:], Hash: pb.Hash_SHA256},
+		},
+		{
+			name:          "rejects unknown hash functions",
+			req:           &pb.SignRequest{Digest: digest[:], Hash: pb.Hash(42)},
+			expectedError: "rpc error: code = InvalidArgument desc = unsupported hash function: 42",
+		},
+		{
+			name:          "rejects digests of the wrong length",
+			req:           &pb.SignRequest{Digest: digest[:], Hash: pb.Hash_SHA384},
+			expectedError: "rpc error: code = InvalidArgument desc = invalid SHA384 digest length: 32",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			rsp, err := srv.Sign(context.Background(), tc.req)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			var sig struct{ R, S *big.Int }
+			if _, err := asn1.Unmarshal(rsp.GetSignature(), &sig); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !ecdsa.Verify(&key.PublicKey, digest[:], sig.R, sig.S) {
+				t.Fatalf("Expected a valid signature")
+			}
+		})
+	}
+}
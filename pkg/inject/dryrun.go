@@ -0,0 +1,18 @@
+package inject
+
+import "encoding/json"
+
+// DryRunPath is the path of the proxy-injector's endpoint returning the
+// injection it would perform on the posted workload manifest, without
+// admitting anything
+const DryRunPath = "/dry-run"
+
+// DryRunResponse is the response of the proxy-injector's dry-run endpoint
+type DryRunResponse struct {
+	// Patch is the JSON patch the proxy-injector would apply to the workload.
+	// It's empty when the workload isn't injectable.
+	Patch json.RawMessage `json:"patch,omitempty"`
+
+	// Report describes the injection of the workload
+	Report Report `json:"report"`
+}
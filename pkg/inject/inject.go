@@ -34,6 +34,9 @@ const (
 	proxyInitResourceLimitMemory   = "50Mi"
 
 	traceDefaultSvcAccount = "default"
+
+	shutdownSignalDir  = "/var/run/linkerd/shutdown"
+	shutdownHelperPath = shutdownSignalDir + "/linkerd2-proxy-shutdown"
 )
 
 var (
@@ -62,6 +65,8 @@ var (
 		k8s.ProxyVersionOverrideAnnotation,
 		k8s.ProxyIgnoreInboundPortsAnnotation,
 		k8s.ProxyIgnoreOutboundPortsAnnotation,
+		k8s.ProxyOutboundOnlyAnnotation,
+		k8s.ProxyShutdownCoordinationAnnotation,
 		k8s.ProxyTraceCollectorSvcAddrAnnotation,
 	}
 )
@@ -92,6 +97,8 @@ type OwnerRetrieverFunc func(*corev1.Pod) (string, string)
 type ResourceConfig struct {
 	configs        *config.All
 	nsAnnotations  map[string]string
+	nsLabels       map[string]string
+	proxyConfigs   []proxyConfigOverrides
 	ownerRetriever OwnerRetrieverFunc
 	origin         Origin
 
@@ -124,6 +131,7 @@ type patch struct {
 	AddRootVolumes        bool                      `json:"addRootVolumes"`
 	Labels                map[string]string         `json:"labels"`
 	DebugContainer        *l5dcharts.DebugContainer `json:"debugContainer"`
+	ShutdownHelper        *l5dcharts.ShutdownHelper `json:"shutdownHelper"`
 }
 
 // NewResourceConfig creates and initializes a ResourceConfig
@@ -152,6 +160,13 @@ func (conf *ResourceConfig) WithNsAnnotations(m map[string]string) *ResourceConf
 	return conf
 }
 
+// WithNsLabels enriches ResourceConfig with the namespace labels, that are
+// matched by the namespace selectors of the injection policy
+func (conf *ResourceConfig) WithNsLabels(m map[string]string) *ResourceConfig {
+	conf.nsLabels = m
+	return conf
+}
+
 // WithOwnerRetriever enriches ResourceConfig with a function that allows to retrieve
 // the kind and name of the workload's owner reference
 func (conf *ResourceConfig) WithOwnerRetriever(f OwnerRetrieverFunc) *ResourceConfig {
@@ -534,6 +549,10 @@ func (conf *ResourceConfig) injectPodSpec(values *patch) {
 		conf.injectProxyInit(values)
 	}
 
+	if conf.shutdownCoordination() {
+		conf.injectShutdownHelper(values)
+	}
+
 	idctx := conf.identityContext()
 	if idctx == nil {
 		values.Global.Proxy.DisableIdentity = true
@@ -541,6 +560,7 @@ func (conf *ResourceConfig) injectPodSpec(values *patch) {
 	}
 	values.Global.IdentityTrustAnchorsPEM = idctx.GetTrustAnchorsPem()
 	values.Global.IdentityTrustDomain = idctx.GetTrustDomain()
+	values.Global.IdentityTokenAudience = idctx.GetTokenAudience()
 	values.Identity = &l5dcharts.Identity{}
 
 	values.AddRootVolumes = len(conf.pod.spec.Volumes) == 0
@@ -560,6 +580,7 @@ func (conf *ResourceConfig) injectProxyInit(values *patch) {
 		},
 		IgnoreInboundPorts:  conf.proxyInboundSkipPorts(),
 		IgnoreOutboundPorts: conf.proxyOutboundSkipPorts(),
+		OutboundOnly:        conf.outboundOnly(),
 		Resources: &l5dcharts.Resources{
 			CPU: l5dcharts.Constraints{
 				Limit:   proxyInitResourceLimitCPU,
@@ -574,8 +595,85 @@ func (conf *ResourceConfig) injectProxyInit(values *patch) {
 		SAMountPath:  values.Global.Proxy.SAMountPath,
 	}
 
+	if values.Global.ProxyInit.OutboundOnly {
+		// the UDP ports are usually also used over TCP with the same peers,
+		// like by gossip protocols, which can't go through the proxy
+		skipPorts := conf.udpPorts()
+		if ports := values.Global.ProxyInit.IgnoreOutboundPorts; ports != "" {
+			skipPorts = append([]string{ports}, skipPorts...)
+		}
+		values.Global.ProxyInit.IgnoreOutboundPorts = strings.Join(skipPorts, ",")
+	}
+
+	values.AddRootInitContainers = len(conf.pod.spec.InitContainers) == 0
+
+}
+
+// injectShutdownHelper wraps the command of the containers with the
+// linkerd2-proxy-shutdown helper of the proxy image, copied into a volume shared
+// with the proxy by an init container. Once the wrapped command exits, the
+// helper creates a signal file in the volume, and the proxy shuts down once
+// all the wrapped containers have signaled it, so that the Job's pod can
+// complete. The entrypoint of the images isn't known at injection time, so the
+// coordination is skipped unless all the containers set a command: the proxy
+// would otherwise shut down under the containers that can't signal it.
+func (conf *ResourceConfig) injectShutdownHelper(values *patch) {
+	if len(conf.shutdownCommandMissing()) > 0 {
+		return
+	}
+
+	// a failed container is restarted in place with the OnFailure policy,
+	// and still needs its proxy
+	signalOnFailure := conf.pod.spec.RestartPolicy != corev1.RestartPolicyOnFailure
+
+	containers := []l5dcharts.WrappedContainer{}
+	names := []string{}
+	for i, container := range conf.pod.spec.Containers {
+		command := []string{
+			shutdownHelperPath,
+			"-signal-file", fmt.Sprintf("%s/%s.exited", shutdownSignalDir, container.Name),
+		}
+		if !signalOnFailure {
+			command = append(command, "-signal-on-failure=false")
+		}
+		command = append(append(command, "--"), container.Command...)
+		containers = append(containers, l5dcharts.WrappedContainer{
+			Index:               i,
+			Command:             command,
+			AddRootVolumeMounts: len(container.VolumeMounts) == 0,
+		})
+		names = append(names, container.Name)
+	}
+
+	// without any wrapped container, the proxy would shut down right away
+	if len(containers) == 0 {
+		return
+	}
+
+	values.Global.Proxy.ShutdownContainers = names
+	values.ShutdownHelper = &l5dcharts.ShutdownHelper{
+		Image: &l5dcharts.Image{
+			Name:       conf.proxyImage(),
+			Version:    conf.proxyVersion(),
+			PullPolicy: conf.proxyImagePullPolicy(),
+		},
+		UID:        conf.proxyUID(),
+		Containers: containers,
+	}
 	values.AddRootInitContainers = len(conf.pod.spec.InitContainers) == 0
+	values.AddRootVolumes = len(conf.pod.spec.Volumes) == 0
+}
 
+// shutdownCommandMissing returns the names of the containers that the shutdown
+// coordination can't wrap, as they don't set a command, and which prevent it
+func (conf *ResourceConfig) shutdownCommandMissing() []string {
+	missing := []string{}
+	for _, container := range conf.pod.spec.Containers {
+		if len(container.Command) == 0 {
+			missing = append(missing, container.Name)
+		}
+	}
+	return missing
 }
 
 func (conf *ResourceConfig) serviceAccountVolumeMount() *corev1.VolumeMount {
@@ -632,6 +730,10 @@ func (conf *ResourceConfig) injectObjectMeta(values *patch) {
 		values.Annotations[k8s.IdentityModeAnnotation] = k8s.IdentityModeDisabled
 	}
 
+	if conf.outboundOnly() {
+		values.Annotations[k8s.ProxyModeAnnotation] = k8s.ProxyModeOutboundOnly
+	}
+
 	if len(conf.pod.labels) > 0 {
 		values.AddRootLabels = len(conf.pod.meta.Labels) == 0
 		for _, k := range sortedKeys(conf.pod.labels) {
@@ -653,10 +755,8 @@ func (conf *ResourceConfig) injectPodAnnotations(values *patch) {
 }
 
 func (conf *ResourceConfig) getOverride(annotation string) string {
-	if override := conf.pod.meta.Annotations[annotation]; override != "" {
-		return override
-	}
-	return conf.nsAnnotations[annotation]
+	override, _ := conf.getOverrideSource(annotation)
+	return override
 }
 
 func (conf *ResourceConfig) proxyImage() string {
@@ -768,6 +868,62 @@ func (conf *ResourceConfig) tapDisabled() bool {
 	return false
 }
 
+func (conf *ResourceConfig) outboundOnly() bool {
+	if override := conf.getOverride(k8s.ProxyOutboundOnlyAnnotation); override != "" {
+		value, err := strconv.ParseBool(override)
+		if err == nil {
+			return value
+		}
+		log.Warnf("unrecognized value used for the %s annotation: %s", k8s.ProxyOutboundOnlyAnnotation, override)
+	}
+	return false
+}
+
+// shutdownCoordination returns true if the proxy should exit once the
+// containers complete. It's only honored for the pods of Jobs and CronJobs, as
+// the pods of the other workloads are restarted when their containers exit.
+func (conf *ResourceConfig) shutdownCoordination() bool {
+	override := conf.getOverride(k8s.ProxyShutdownCoordinationAnnotation)
+	if override == "" {
+		return false
+	}
+	value, err := strconv.ParseBool(override)
+	if err != nil {
+		log.Warnf("unrecognized value used for the %s annotation: %s", k8s.ProxyShutdownCoordinationAnnotation, override)
+		return false
+	}
+	if value && !conf.isJob() {
+		log.Warnf("ignoring the %s annotation on a %s, which isn't a Job or a CronJob", k8s.ProxyShutdownCoordinationAnnotation, conf.workload.metaType.Kind)
+		return false
+	}
+	return value
+}
+
+// isJob returns true if the workload is a Job or a CronJob, or a pod owned by
+// one of them
+func (conf *ResourceConfig) isJob() bool {
+	kind := strings.ToLower(conf.workload.metaType.Kind)
+	if kind == k8s.Pod && conf.workload.ownerRef != nil {
+		kind = strings.ToLower(conf.workload.ownerRef.Kind)
+	}
+	return kind == k8s.Job || kind == k8s.CronJob
+}
+
+// udpPorts returns the container ports of the pod with `protocol: UDP`
+func (conf *ResourceConfig) udpPorts() []string {
+	ports := []string{}
+	seen := map[int32]bool{}
+	for _, container := range conf.pod.spec.Containers {
+		for _, port := range container.Ports {
+			if port.Protocol == corev1.ProtocolUDP && !seen[port.ContainerPort] {
+				seen[port.ContainerPort] = true
+				ports = append(ports, strconv.Itoa(int(port.ContainerPort)))
+			}
+		}
+	}
+	return ports
+}
+
 func (conf *ResourceConfig) proxyWaitBeforeExitSeconds() uint64 {
 	if override := conf.getOverride(k8s.ProxyWaitBeforeExitSecondsAnnotation); override != "" {
 		waitBeforeExitSeconds, err := strconv.ParseUint(override, 10, 64)
@@ -2,6 +2,7 @@ package inject
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/gen/config"
@@ -432,3 +433,271 @@ func TestConfigAccessors(t *testing.T) {
 		})
 	}
 }
+
+func TestOutboundOnly(t *testing.T) {
+	configs := &config.All{
+		Global: &config.Global{LinkerdNamespace: "linkerd"},
+		Proxy: &config.Proxy{
+			ProxyInitImage:      &config.Image{ImageName: "gcr.io/linkerd-io/proxy-init", PullPolicy: "IfNotPresent"},
+			IgnoreOutboundPorts: []*config.PortRange{{PortRange: "25"}},
+			ProxyUid:            2102,
+		},
+	}
+
+	daemonSet := []byte(`
+kind: DaemonSet
+apiVersion: apps/v1
+metadata:
+  name: agent
+  namespace: monitoring
+spec:
+  template:
+    metadata:
+      annotations:
+        config.linkerd.io/outbound-only: "true"
+    spec:
+      containers:
+      - name: agent
+        image: agent
+        ports:
+        - containerPort: 7946
+          protocol: TCP
+        - containerPort: 7946
+          protocol: UDP
+        - containerPort: 8125
+          protocol: UDP`)
+
+	conf := NewResourceConfig(configs, OriginWebhook)
+	report, err := conf.ParseMetaAndYAML(daemonSet)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !report.OutboundOnly {
+		t.Fatal("Expected outbound-only mode")
+	}
+
+	values := &patch{
+		Values:      l5dcharts.Values{Global: &l5dcharts.Global{}},
+		Annotations: map[string]string{},
+		Labels:      map[string]string{},
+	}
+	conf.injectObjectMeta(values)
+	conf.injectPodSpec(values)
+
+	if actual := values.Annotations[k8s.ProxyModeAnnotation]; actual != k8s.ProxyModeOutboundOnly {
+		t.Fatalf("Expected proxy mode %s, got %s", k8s.ProxyModeOutboundOnly, actual)
+	}
+	proxyInit := values.Global.ProxyInit
+	if !proxyInit.OutboundOnly {
+		t.Fatal("Expected proxy-init in outbound-only mode")
+	}
+	if expected := "25,7946,8125"; proxyInit.IgnoreOutboundPorts != expected {
+		t.Fatalf("Expected outbound skip ports %s, got %s", expected, proxyInit.IgnoreOutboundPorts)
+	}
+}
+
+func TestShutdownCoordination(t *testing.T) {
+	configs := &config.All{
+		Global: &config.Global{LinkerdNamespace: "linkerd"},
+		Proxy: &config.Proxy{
+			ProxyImage:   &config.Image{ImageName: "gcr.io/linkerd-io/proxy", PullPolicy: "IfNotPresent"},
+			ProxyVersion: "stable-2.7.0",
+			ProxyUid:     2102,
+			AdminPort:    &config.Port{Port: 4191},
+		},
+	}
+
+	podSpec := `
+      metadata:
+        annotations:
+          config.alpha.linkerd.io/shutdown-coordination: "true"
+      spec:
+        containers:
+        - name: migrate
+          image: migrate
+          command: ["/migrate", "up"]
+          args: ["--all"]
+        - name: sidecar
+          image: sidecar
+          command: ["/sidecar"]
+          volumeMounts:
+          - name: data
+            mountPath: /data`
+
+	testCases := []struct {
+		kind     string
+		workload string
+		expected bool
+	}{
+		{
+			kind: "Job",
+			workload: `
+kind: Job
+apiVersion: batch/v1
+metadata:
+  name: migrate
+  namespace: emojivoto
+spec:
+  template:` + podSpec,
+			expected: true,
+		},
+		{
+			kind: "CronJob",
+			workload: `
+kind: CronJob
+apiVersion: batch/v1beta1
+metadata:
+  name: migrate
+  namespace: emojivoto
+spec:
+  jobTemplate:
+    spec:
+      template:` + strings.Replace(podSpec, "\n", "\n    ", -1),
+			expected: true,
+		},
+		{
+			kind: "Deployment",
+			workload: `
+kind: Deployment
+apiVersion: apps/v1
+metadata:
+  name: migrate
+  namespace: emojivoto
+spec:
+  template:` + podSpec,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.kind, func(t *testing.T) {
+			conf := NewResourceConfig(configs, OriginWebhook)
+			if _, err := conf.ParseMetaAndYAML([]byte(tc.workload)); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			values := &patch{
+				Values:      l5dcharts.Values{Global: &l5dcharts.Global{}},
+				Annotations: map[string]string{},
+				Labels:      map[string]string{},
+			}
+			conf.injectPodSpec(values)
+
+			if !tc.expected {
+				if values.ShutdownHelper != nil || values.Global.Proxy.ShutdownContainers != nil {
+					t.Fatalf("Expected no shutdown helper, got %+v", values.ShutdownHelper)
+				}
+				return
+			}
+
+			if expected := []string{"migrate", "sidecar"}; !reflect.DeepEqual(values.Global.Proxy.ShutdownContainers, expected) {
+				t.Fatalf("Expected the proxy to await %v, got %v", expected, values.Global.Proxy.ShutdownContainers)
+			}
+			expected := []l5dcharts.WrappedContainer{
+				{
+					Index:               0,
+					Command:             []string{"/var/run/linkerd/shutdown/linkerd2-proxy-shutdown", "-signal-file", "/var/run/linkerd/shutdown/migrate.exited", "--", "/migrate", "up"},
+					AddRootVolumeMounts: true,
+				},
+				{
+					Index:   1,
+					Command: []string{"/var/run/linkerd/shutdown/linkerd2-proxy-shutdown", "-signal-file", "/var/run/linkerd/shutdown/sidecar.exited", "--", "/sidecar"},
+				},
+			}
+			if !reflect.DeepEqual(values.ShutdownHelper.Containers, expected) {
+				t.Fatalf("Expected wrapped containers %+v, got %+v", expected, values.ShutdownHelper.Containers)
+			}
+			image := values.ShutdownHelper.Image
+			if image.Name != "gcr.io/linkerd-io/proxy" || image.Version != "stable-2.7.0" || values.ShutdownHelper.UID != 2102 {
+				t.Fatalf("Expected the helper to be copied from the proxy image, got %+v", values.ShutdownHelper)
+			}
+			if !values.AddRootInitContainers || !values.AddRootVolumes {
+				t.Fatalf("Expected the initContainers and volumes roots to be added, got %t and %t", values.AddRootInitContainers, values.AddRootVolumes)
+			}
+		})
+	}
+}
+
+func TestShutdownCoordinationWrapping(t *testing.T) {
+	configs := &config.All{
+		Global: &config.Global{LinkerdNamespace: "linkerd"},
+		Proxy:  &config.Proxy{ProxyImage: &config.Image{ImageName: "gcr.io/linkerd-io/proxy"}},
+	}
+
+	testCases := []struct {
+		name     string
+		spec     string
+		expected []string
+	}{
+		{
+			name: "keeps the proxy of failed containers restarted in place",
+			spec: `
+      restartPolicy: OnFailure
+      containers:
+      - name: migrate
+        image: migrate
+        command: ["/migrate"]`,
+			expected: []string{"/var/run/linkerd/shutdown/linkerd2-proxy-shutdown", "-signal-file", "/var/run/linkerd/shutdown/migrate.exited", "-signal-on-failure=false", "--", "/migrate"},
+		},
+		{
+			name: "doesn't coordinate without any command to wrap",
+			spec: `
+      containers:
+      - name: migrate
+        image: migrate`,
+		},
+		{
+			name: "doesn't coordinate unless every container has a command to wrap",
+			spec: `
+      containers:
+      - name: migrate
+        image: migrate
+        command: ["/migrate"]
+      - name: exporter
+        image: exporter`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			job := `
+kind: Job
+apiVersion: batch/v1
+metadata:
+  name: migrate
+  namespace: emojivoto
+spec:
+  template:
+    metadata:
+      annotations:
+        config.alpha.linkerd.io/shutdown-coordination: "true"
+    spec:` + tc.spec
+
+			conf := NewResourceConfig(configs, OriginWebhook)
+			if _, err := conf.ParseMetaAndYAML([]byte(job)); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			values := &patch{
+				Values:      l5dcharts.Values{Global: &l5dcharts.Global{}},
+				Annotations: map[string]string{},
+				Labels:      map[string]string{},
+			}
+			conf.injectPodSpec(values)
+
+			if tc.expected == nil {
+				if values.ShutdownHelper != nil || values.Global.Proxy.ShutdownContainers != nil {
+					t.Fatalf("Expected no shutdown helper, got %+v", values.ShutdownHelper)
+				}
+				return
+			}
+			if values.ShutdownHelper == nil || len(values.ShutdownHelper.Containers) != 1 {
+				t.Fatalf("Expected a single wrapped container, got %+v", values.ShutdownHelper)
+			}
+			if command := values.ShutdownHelper.Containers[0].Command; !reflect.DeepEqual(command, tc.expected) {
+				t.Fatalf("Expected command %v, got %v", tc.expected, command)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package inject
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/gen/config"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// injectionRule is a rule of the injection policy, with its namespace selector
+// and glob patterns compiled
+type injectionRule struct {
+	nsSelector labels.Selector
+	kinds      map[string]struct{}
+	ownerNames []*regexp.Regexp
+	images     []*regexp.Regexp
+}
+
+// ValidateInjectionPolicy returns an error if a rule of the policy has an
+// invalid namespace selector or a kind that can't be injected
+func ValidateInjectionPolicy(policy *config.InjectionPolicy) error {
+	for i, rule := range policy.GetInclude() {
+		if _, err := newInjectionRule(rule); err != nil {
+			return fmt.Errorf("invalid include rule #%d: %s", i, err)
+		}
+	}
+	for i, rule := range policy.GetExclude() {
+		if _, err := newInjectionRule(rule); err != nil {
+			return fmt.Errorf("invalid exclude rule #%d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+func newInjectionRule(rule *config.InjectionRule) (*injectionRule, error) {
+	nsSelector, err := labels.Parse(rule.GetNamespaceSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := map[string]struct{}{}
+	for _, kind := range rule.GetKinds() {
+		kind = strings.ToLower(kind)
+		switch kind {
+		case k8s.CronJob, k8s.DaemonSet, k8s.Deployment, k8s.Job, k8s.Pod, k8s.ReplicaSet, k8s.ReplicationController, k8s.StatefulSet:
+			kinds[kind] = struct{}{}
+		default:
+			return nil, fmt.Errorf("unsupported kind \"%s\"", kind)
+		}
+	}
+
+	return &injectionRule{
+		nsSelector: nsSelector,
+		kinds:      kinds,
+		ownerNames: globsToRegexps(rule.GetOwnerNames()),
+		images:     globsToRegexps(rule.GetImages()),
+	}, nil
+}
+
+// globsToRegexps compiles glob patterns where '*' matches any sequence of
+// characters, including '/', and '?' matches any single character
+func globsToRegexps(globs []string) []*regexp.Regexp {
+	regexps := []*regexp.Regexp{}
+	for _, glob := range globs {
+		expr := regexp.QuoteMeta(glob)
+		expr = strings.Replace(expr, `\*`, ".*", -1)
+		expr = strings.Replace(expr, `\?`, ".", -1)
+		regexps = append(regexps, regexp.MustCompile("^"+expr+"$"))
+	}
+	return regexps
+}
+
+func matchAny(regexps []*regexp.Regexp, s string) bool {
+	for _, re := range regexps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesInjectionRules returns true if the workload matches any of the given
+// rules of the injection policy. The invalid rules, which are rejected at
+// install time, never match.
+func (conf *ResourceConfig) matchesInjectionRules(rules []*config.InjectionRule) bool {
+	kind, name := conf.ownerKindAndName()
+	for _, r := range rules {
+		rule, err := newInjectionRule(r)
+		if err != nil {
+			log.Warnf("ignoring invalid injection rule: %s", err)
+			continue
+		}
+		if rule.matches(conf, kind, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *injectionRule) matches(conf *ResourceConfig, kind, name string) bool {
+	if !rule.nsSelector.Matches(labels.Set(conf.nsLabels)) {
+		return false
+	}
+	if len(rule.kinds) > 0 {
+		if _, ok := rule.kinds[kind]; !ok {
+			return false
+		}
+	}
+	if len(rule.ownerNames) > 0 && !matchAny(rule.ownerNames, name) {
+		return false
+	}
+	if len(rule.images) > 0 && !rule.matchesImage(conf) {
+		return false
+	}
+	return true
+}
+
+// matchesImage returns true if the image of any container of the pod matches
+// one of the rule's patterns
+func (rule *injectionRule) matchesImage(conf *ResourceConfig) bool {
+	if conf.pod.spec == nil {
+		return false
+	}
+	for _, containers := range [][]corev1.Container{conf.pod.spec.InitContainers, conf.pod.spec.Containers} {
+		for _, container := range containers {
+			if matchAny(rule.images, container.Image) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ownerKindAndName returns the kind and name of the workload, or of the
+// workload owning the pod
+func (conf *ResourceConfig) ownerKindAndName() (string, string) {
+	if conf.workload.ownerRef != nil {
+		return strings.ToLower(conf.workload.ownerRef.Kind), conf.workload.ownerRef.Name
+	}
+	kind := strings.ToLower(conf.workload.metaType.Kind)
+	if conf.workload.Meta != nil {
+		return kind, conf.workload.Meta.Name
+	}
+	return kind, conf.pod.meta.Name
+}
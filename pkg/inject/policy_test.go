@@ -0,0 +1,202 @@
+package inject
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/gen/config"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestInjectionPolicy(t *testing.T) {
+	policy := &config.InjectionPolicy{
+		Include: []*config.InjectionRule{
+			{NamespaceSelector: "env in (dev, staging)"},
+			{Kinds: []string{"StatefulSet"}, Images: []string{"*/redis:*"}},
+		},
+		Exclude: []*config.InjectionRule{
+			{NamespaceSelector: "env=dev", Kinds: []string{"job", "cronjob"}},
+			{OwnerNames: []string{"legacy-*"}},
+			{Images: []string{"registry.example.com/vendor/*"}},
+		},
+	}
+
+	workload := func(kind, name, image, annotation string) string {
+		return fmt.Sprintf(`
+kind: %s
+apiVersion: apps/v1
+metadata:
+  name: %s
+spec:
+  template:
+    metadata:
+      annotations: {%s}
+    spec:
+      containers:
+      - name: app
+        image: %s`, kind, name, annotation, image)
+	}
+
+	testCases := []struct {
+		name           string
+		nsLabels       map[string]string
+		ownerKind      string
+		manifest       string
+		origin         Origin
+		injectable     bool
+		reasons        []string
+		annotationAt   string
+		policyExcluded bool
+	}{
+		{
+			name:         "included by namespace",
+			nsLabels:     map[string]string{"env": "dev"},
+			manifest:     workload("Deployment", "web", "buoyantio/emojivoto-web:v8", ""),
+			origin:       OriginWebhook,
+			injectable:   true,
+			annotationAt: annotationAtInjectionPolicy,
+		},
+		{
+			name:         "included by kind and image",
+			nsLabels:     map[string]string{"env": "prod"},
+			manifest:     workload("StatefulSet", "cache", "docker.io/library/redis:5", ""),
+			origin:       OriginWebhook,
+			injectable:   true,
+			annotationAt: annotationAtInjectionPolicy,
+		},
+		{
+			name:       "not included",
+			nsLabels:   map[string]string{"env": "prod"},
+			manifest:   workload("Deployment", "web", "buoyantio/emojivoto-web:v8", ""),
+			origin:     OriginWebhook,
+			injectable: false,
+			reasons:    []string{injectionPolicyNotIncluded},
+		},
+		{
+			name:         "not included but annotated",
+			nsLabels:     map[string]string{"env": "prod"},
+			manifest:     workload("Deployment", "web", "buoyantio/emojivoto-web:v8", `"linkerd.io/inject": enabled`),
+			origin:       OriginWebhook,
+			injectable:   true,
+			annotationAt: annotationAtWorkload,
+		},
+		{
+			name:       "included but annotated to disable the injection",
+			nsLabels:   map[string]string{"env": "dev"},
+			manifest:   workload("Deployment", "web", "buoyantio/emojivoto-web:v8", `"linkerd.io/inject": disabled`),
+			origin:     OriginWebhook,
+			injectable: false,
+			reasons:    []string{injectEnableAnnotationAbsent},
+		},
+		{
+			name:           "pod excluded by the kind of its owner",
+			nsLabels:       map[string]string{"env": "dev"},
+			ownerKind:      "cronjob",
+			manifest:       "kind: Pod\napiVersion: v1\nmetadata:\n  name: backup-1581273600-x2v9f\nspec:\n  containers:\n  - name: backup\n    image: backup",
+			origin:         OriginWebhook,
+			injectable:     false,
+			reasons:        []string{injectionPolicyExcluded},
+			policyExcluded: true,
+		},
+		{
+			name:           "excluded by owner name despite the annotation",
+			manifest:       workload("Deployment", "legacy-billing", "billing:v1", `"linkerd.io/inject": enabled`),
+			origin:         OriginWebhook,
+			injectable:     false,
+			reasons:        []string{injectionPolicyExcluded},
+			policyExcluded: true,
+		},
+		{
+			name:           "excluded by image with the CLI",
+			manifest:       workload("Deployment", "db", "registry.example.com/vendor/db/server:12", ""),
+			origin:         OriginCLI,
+			injectable:     false,
+			reasons:        []string{injectionPolicyExcluded},
+			policyExcluded: true,
+		},
+		{
+			name:       "not included with the CLI",
+			manifest:   workload("Deployment", "web", "buoyantio/emojivoto-web:v8", ""),
+			origin:     OriginCLI,
+			injectable: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			configs := &config.All{
+				Global: &config.Global{LinkerdNamespace: "linkerd"},
+				Proxy:  &config.Proxy{InjectionPolicy: policy},
+			}
+			conf := NewResourceConfig(configs, tc.origin).WithNsLabels(tc.nsLabels)
+			if tc.ownerKind != "" {
+				conf.WithOwnerRetriever(func(p *corev1.Pod) (string, string) {
+					return tc.ownerKind, "backup"
+				})
+			}
+
+			report, err := conf.ParseMetaAndYAML([]byte(tc.manifest))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			injectable, reasons := report.Injectable()
+			if injectable != tc.injectable {
+				t.Fatalf("Expected injectable to be %t, got %t (reasons: %v)", tc.injectable, injectable, reasons)
+			}
+			if !reflect.DeepEqual(reasons, tc.reasons) {
+				t.Fatalf("Expected reasons %v, got %v", tc.reasons, reasons)
+			}
+			if report.InjectAnnotationAt != tc.annotationAt {
+				t.Fatalf("Expected the injection to be enabled at %q, got %q", tc.annotationAt, report.InjectAnnotationAt)
+			}
+			if report.InjectionPolicyExcluded != tc.policyExcluded {
+				t.Fatalf("Expected InjectionPolicyExcluded to be %t, got %t", tc.policyExcluded, report.InjectionPolicyExcluded)
+			}
+		})
+	}
+}
+
+func TestValidateInjectionPolicy(t *testing.T) {
+	testCases := []struct {
+		policy *config.InjectionPolicy
+		err    string
+	}{
+		{
+			policy: &config.InjectionPolicy{
+				Include: []*config.InjectionRule{{NamespaceSelector: "env=dev,!legacy"}},
+				Exclude: []*config.InjectionRule{{Kinds: []string{"CronJob", "job"}, OwnerNames: []string{"[migrate"}}},
+			},
+		},
+		{
+			policy: &config.InjectionPolicy{
+				Include: []*config.InjectionRule{{NamespaceSelector: "env in (dev"}},
+			},
+			err: "invalid include rule #0: ",
+		},
+		{
+			policy: &config.InjectionPolicy{
+				Exclude: []*config.InjectionRule{{}, {Kinds: []string{"service"}}},
+			},
+			err: "invalid exclude rule #1: unsupported kind \"service\"",
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("test case #%d", i), func(t *testing.T) {
+			err := ValidateInjectionPolicy(tc.policy)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+				t.Fatalf("Expected error starting with %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
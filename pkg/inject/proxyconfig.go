@@ -0,0 +1,169 @@
+package inject
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pcv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/proxyconfig/v1alpha1"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// SourceGlobal is the source of the proxy settings taken from the global
+	// configuration
+	SourceGlobal = "global config"
+
+	// SourceNamespaceAnnotation is the source of the proxy settings taken from
+	// an annotation of the namespace
+	SourceNamespaceAnnotation = "namespace annotation"
+
+	// SourcePodAnnotation is the source of the proxy settings taken from an
+	// annotation of the pod
+	SourcePodAnnotation = "pod annotation"
+)
+
+// ConfigSource describes the value of a proxy setting and where it comes from
+type ConfigSource struct {
+	Value  string
+	Source string
+}
+
+// proxyConfigOverrides holds the settings of a ProxyConfig, as the
+// config.linkerd.io annotations they're equivalent to
+type proxyConfigOverrides struct {
+	name        string
+	selector    labels.Selector
+	annotations map[string]string
+}
+
+// WithProxyConfigs enriches ResourceConfig with the ProxyConfigs of the
+// workload's namespace. Their settings take precedence over the global
+// configuration, and are overridden by the namespace and pod annotations.
+func (conf *ResourceConfig) WithProxyConfigs(pcs []*pcv1alpha1.ProxyConfig) *ResourceConfig {
+	overrides := []proxyConfigOverrides{}
+	for _, pc := range pcs {
+		selector := labels.Everything()
+		if pc.Spec.PodSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(pc.Spec.PodSelector)
+			if err != nil {
+				log.Warnf("ignoring ProxyConfig %s/%s with an invalid pod selector: %s", pc.Namespace, pc.Name, err)
+				continue
+			}
+		}
+
+		overrides = append(overrides, proxyConfigOverrides{
+			name:        pc.Name,
+			selector:    selector,
+			annotations: proxyConfigAnnotations(&pc.Spec),
+		})
+	}
+
+	// when several ProxyConfigs select the pod, the first one defining a
+	// setting wins
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].name < overrides[j].name
+	})
+
+	conf.proxyConfigs = overrides
+	return conf
+}
+
+// proxyConfigAnnotations returns the config.linkerd.io annotations equivalent
+// to the settings of a ProxyConfig
+func proxyConfigAnnotations(spec *pcv1alpha1.ProxyConfigSpec) map[string]string {
+	annotations := map[string]string{}
+	set := func(annotation, value string) {
+		if value != "" {
+			annotations[annotation] = value
+		}
+	}
+	setPort := func(annotation string, port uint32) {
+		if port != 0 {
+			annotations[annotation] = strconv.FormatUint(uint64(port), 10)
+		}
+	}
+
+	if image := spec.ProxyImage; image != nil {
+		set(k8s.ProxyImageAnnotation, image.Name)
+		set(k8s.ProxyVersionOverrideAnnotation, image.Version)
+		set(k8s.ProxyImagePullPolicyAnnotation, image.PullPolicy)
+	}
+	if image := spec.ProxyInitImage; image != nil {
+		set(k8s.ProxyInitImageAnnotation, image.Name)
+		set(k8s.ProxyInitImageVersionAnnotation, image.Version)
+	}
+	if image := spec.DebugImage; image != nil {
+		set(k8s.DebugImageAnnotation, image.Name)
+		set(k8s.DebugImageVersionAnnotation, image.Version)
+		set(k8s.DebugImagePullPolicyAnnotation, image.PullPolicy)
+	}
+
+	setPort(k8s.ProxyControlPortAnnotation, spec.ControlPort)
+	setPort(k8s.ProxyInboundPortAnnotation, spec.InboundPort)
+	setPort(k8s.ProxyAdminPortAnnotation, spec.AdminPort)
+	setPort(k8s.ProxyOutboundPortAnnotation, spec.OutboundPort)
+	set(k8s.ProxyIgnoreInboundPortsAnnotation, strings.Join(spec.IgnoreInboundPorts, ","))
+	set(k8s.ProxyIgnoreOutboundPortsAnnotation, strings.Join(spec.IgnoreOutboundPorts, ","))
+
+	if res := spec.Resources; res != nil {
+		set(k8s.ProxyCPURequestAnnotation, res.RequestCPU)
+		set(k8s.ProxyMemoryRequestAnnotation, res.RequestMemory)
+		set(k8s.ProxyCPULimitAnnotation, res.LimitCPU)
+		set(k8s.ProxyMemoryLimitAnnotation, res.LimitMemory)
+	}
+
+	if spec.ProxyUID != 0 {
+		annotations[k8s.ProxyUIDAnnotation] = strconv.FormatInt(spec.ProxyUID, 10)
+	}
+	set(k8s.ProxyLogLevelAnnotation, spec.LogLevel)
+	if spec.EnableExternalProfiles != nil {
+		annotations[k8s.ProxyEnableExternalProfilesAnnotation] = strconv.FormatBool(*spec.EnableExternalProfiles)
+	}
+
+	return annotations
+}
+
+// getOverrideSource returns the value overriding the global configuration
+// for the given annotation, and where it comes from. The pod annotations take
+// precedence over the namespace annotations, which take precedence over the
+// ProxyConfigs selecting the pod.
+func (conf *ResourceConfig) getOverrideSource(annotation string) (string, string) {
+	if override := conf.pod.meta.Annotations[annotation]; override != "" {
+		return override, SourcePodAnnotation
+	}
+	if override := conf.nsAnnotations[annotation]; override != "" {
+		return override, SourceNamespaceAnnotation
+	}
+
+	podLabels := labels.Set(conf.pod.meta.Labels)
+	for _, pc := range conf.proxyConfigs {
+		if override := pc.annotations[annotation]; override != "" && pc.selector.Matches(podLabels) {
+			return override, fmt.Sprintf("ProxyConfig %s", pc.name)
+		}
+	}
+	return "", SourceGlobal
+}
+
+// GetConfigSources returns the value and the source of the proxy settings
+// overriding the global configuration, keyed by their config.linkerd.io
+// annotation
+func (conf *ResourceConfig) GetConfigSources() map[string]ConfigSource {
+	sources := map[string]ConfigSource{}
+	annotations := append([]string{
+		k8s.DebugImageAnnotation,
+		k8s.DebugImageVersionAnnotation,
+		k8s.DebugImagePullPolicyAnnotation,
+	}, ProxyAnnotations...)
+	for _, annotation := range annotations {
+		if value, source := conf.getOverrideSource(annotation); source != SourceGlobal {
+			sources[annotation] = ConfigSource{Value: value, Source: source}
+		}
+	}
+	return sources
+}
@@ -0,0 +1,136 @@
+package inject
+
+import (
+	"reflect"
+	"testing"
+
+	pcv1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/proxyconfig/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/gen/config"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProxyConfigPrecedence(t *testing.T) {
+	configs := &config.All{
+		Global: &config.Global{LinkerdNamespace: "linkerd"},
+		Proxy: &config.Proxy{
+			AdminPort:    &config.Port{Port: 4191},
+			InboundPort:  &config.Port{Port: 4143},
+			OutboundPort: &config.Port{Port: 4140},
+			ControlPort:  &config.Port{Port: 4190},
+			LogLevel:     &config.LogLevel{Level: "warn,linkerd2_proxy=info"},
+		},
+	}
+
+	pcs := []*pcv1alpha1.ProxyConfig{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-web", Namespace: "emojivoto"},
+			Spec: pcv1alpha1.ProxyConfigSpec{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				LogLevel:    "debug",
+				AdminPort:   5191,
+				InboundPort: 5143,
+				ControlPort: 5190,
+			},
+		},
+		{
+			// sorted before b-web, so it wins for the settings both define
+			ObjectMeta: metav1.ObjectMeta{Name: "a-all", Namespace: "emojivoto"},
+			Spec: pcv1alpha1.ProxyConfigSpec{
+				ControlPort:        6190,
+				IgnoreInboundPorts: []string{"25", "3306-3307"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "c-voting", Namespace: "emojivoto"},
+			Spec: pcv1alpha1.ProxyConfigSpec{
+				PodSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"app": "voting"}},
+				OutboundPort: 7140,
+			},
+		},
+	}
+
+	deployment := []byte(`
+kind: Deployment
+apiVersion: apps/v1
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  template:
+    metadata:
+      labels:
+        app: web
+      annotations:
+        config.linkerd.io/inbound-port: "8143"
+    spec:
+      containers:
+      - name: web
+        image: web`)
+
+	conf := NewResourceConfig(configs, OriginWebhook).
+		WithNsAnnotations(map[string]string{k8s.ProxyAdminPortAnnotation: "9191", k8s.ProxyInboundPortAnnotation: "9143"}).
+		WithProxyConfigs(pcs)
+	if _, err := conf.ParseMetaAndYAML(deployment); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if actual := conf.proxyInboundPort(); actual != 8143 {
+		t.Fatalf("Expected the pod annotation inbound port 8143, got %d", actual)
+	}
+	if actual := conf.proxyAdminPort(); actual != 9191 {
+		t.Fatalf("Expected the namespace annotation admin port 9191, got %d", actual)
+	}
+	if actual := conf.proxyControlPort(); actual != 6190 {
+		t.Fatalf("Expected the ProxyConfig control port 6190, got %d", actual)
+	}
+	if actual := conf.proxyLogLevel(); actual != "debug" {
+		t.Fatalf("Expected the ProxyConfig log level debug, got %s", actual)
+	}
+	if actual := conf.proxyOutboundPort(); actual != 4140 {
+		t.Fatalf("Expected the global outbound port 4140, got %d", actual)
+	}
+
+	expected := map[string]ConfigSource{
+		k8s.ProxyInboundPortAnnotation:        {"8143", SourcePodAnnotation},
+		k8s.ProxyAdminPortAnnotation:          {"9191", SourceNamespaceAnnotation},
+		k8s.ProxyControlPortAnnotation:        {"6190", "ProxyConfig a-all"},
+		k8s.ProxyIgnoreInboundPortsAnnotation: {"25,3306-3307", "ProxyConfig a-all"},
+		k8s.ProxyLogLevelAnnotation:           {"debug", "ProxyConfig b-web"},
+	}
+	if actual := conf.GetConfigSources(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected config sources %v, got %v", expected, actual)
+	}
+}
+
+func TestProxyConfigAnnotations(t *testing.T) {
+	enabled := true
+	spec := &pcv1alpha1.ProxyConfigSpec{
+		ProxyImage:             &pcv1alpha1.Image{Name: "proxy", Version: "v1", PullPolicy: "Always"},
+		ProxyInitImage:         &pcv1alpha1.Image{Name: "proxy-init", Version: "v2", PullPolicy: "Never"},
+		DebugImage:             &pcv1alpha1.Image{Name: "debug"},
+		OutboundPort:           4140,
+		IgnoreOutboundPorts:    []string{"443"},
+		Resources:              &pcv1alpha1.ResourceRequirements{RequestCPU: "100m", LimitMemory: "250Mi"},
+		ProxyUID:               2102,
+		EnableExternalProfiles: &enabled,
+	}
+
+	expected := map[string]string{
+		k8s.ProxyImageAnnotation:                  "proxy",
+		k8s.ProxyVersionOverrideAnnotation:        "v1",
+		k8s.ProxyImagePullPolicyAnnotation:        "Always",
+		k8s.ProxyInitImageAnnotation:              "proxy-init",
+		k8s.ProxyInitImageVersionAnnotation:       "v2",
+		k8s.DebugImageAnnotation:                  "debug",
+		k8s.ProxyOutboundPortAnnotation:           "4140",
+		k8s.ProxyIgnoreOutboundPortsAnnotation:    "443",
+		k8s.ProxyCPURequestAnnotation:             "100m",
+		k8s.ProxyMemoryLimitAnnotation:            "250Mi",
+		k8s.ProxyUIDAnnotation:                    "2102",
+		k8s.ProxyEnableExternalProfilesAnnotation: "true",
+	}
+	if actual := proxyConfigAnnotations(spec); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected annotations %v, got %v", expected, actual)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 const (
 	hostNetworkEnabled               = "host_network_enabled"
+	outboundOnlyHostNetwork          = "outbound_only_host_network"
 	sidecarExists                    = "sidecar_already_exists"
 	unsupportedResource              = "unsupported_resource"
 	injectEnableAnnotationAbsent     = "injection_enable_annotation_absent"
@@ -19,18 +20,28 @@ const (
 	annotationAtWorkload             = "workload"
 	invalidInjectAnnotationWorkload  = "invalid_inject_annotation_at_workload"
 	invalidInjectAnnotationNamespace = "invalid_inject_annotation_at_ns"
+	injectionPolicyExcluded          = "injection_policy_excluded"
+	injectionPolicyNotIncluded       = "injection_policy_not_included"
+
+	// annotationAtInjectionPolicy is reported in place of the resource holding
+	// the inject annotation when an include rule of the injection policy
+	// enables the injection
+	annotationAtInjectionPolicy = "injection_policy"
 )
 
 var (
 	// Reasons is a map of inject skip reasons with human readable sentences
 	Reasons = map[string]string{
 		hostNetworkEnabled:               "hostNetwork is enabled",
+		outboundOnlyHostNetwork:          "hostNetwork is enabled, which isn't supported in outbound-only mode as the proxy-init rules and the proxy ports would be shared with the whole host",
 		sidecarExists:                    "pod has a sidecar injected already",
 		unsupportedResource:              "this resource kind is unsupported",
 		injectEnableAnnotationAbsent:     fmt.Sprintf("neither the namespace nor the pod have the annotation \"%s:%s\"", k8s.ProxyInjectAnnotation, k8s.ProxyInjectEnabled),
 		injectDisableAnnotationPresent:   fmt.Sprintf("pod has the annotation \"%s:%s\"", k8s.ProxyInjectAnnotation, k8s.ProxyInjectDisabled),
 		invalidInjectAnnotationWorkload:  fmt.Sprintf("invalid value for annotation \"%s\" at workload", k8s.ProxyInjectAnnotation),
 		invalidInjectAnnotationNamespace: fmt.Sprintf("invalid value for annotation \"%s\" at namespace", k8s.ProxyInjectAnnotation),
+		injectionPolicyExcluded:          "the workload matches an exclude rule of the injection policy",
+		injectionPolicyNotIncluded:       fmt.Sprintf("neither the namespace nor the pod have the annotation \"%s:%s\", and the workload matches no include rule of the injection policy", k8s.ProxyInjectAnnotation, k8s.ProxyInjectEnabled),
 	}
 )
 
@@ -42,12 +53,25 @@ type Report struct {
 	HostNetwork          bool
 	Sidecar              bool
 	UDP                  bool // true if any port in any container has `protocol: UDP`
+	OutboundOnly         bool // true if the proxy is injected in outbound-only mode
 	UnsupportedResource  bool
 	InjectDisabled       bool
 	InjectDisabledReason string
 	InjectAnnotationAt   string
 	TracingEnabled       bool
 
+	// InjectionPolicyExcluded is true if the workload matches an exclude rule
+	// of the injection policy, which takes precedence over its annotations
+	InjectionPolicyExcluded bool
+
+	// ShutdownCommandMissing holds the containers without a command, which the
+	// shutdown coordination can't wrap and which cause it to be skipped
+	ShutdownCommandMissing []string
+
+	// ConfigSources holds the proxy settings overriding the global
+	// configuration, keyed by their config.linkerd.io annotation
+	ConfigSources map[string]ConfigSource
+
 	// Uninjected consists of two boolean flags to indicate if a proxy and
 	// proxy-init containers have been uninjected in this report
 	Uninjected struct {
@@ -78,10 +102,18 @@ func newReport(conf *ResourceConfig) *Report {
 	}
 
 	if conf.pod.meta != nil && conf.pod.spec != nil {
-		report.InjectDisabled, report.InjectDisabledReason, report.InjectAnnotationAt = report.disableByAnnotation(conf)
+		if conf.matchesInjectionRules(conf.configs.GetProxy().GetInjectionPolicy().GetExclude()) {
+			report.InjectionPolicyExcluded = true
+		} else {
+			report.InjectDisabled, report.InjectDisabledReason, report.InjectAnnotationAt = report.disableByAnnotation(conf)
+		}
 		report.HostNetwork = conf.pod.spec.HostNetwork
 		report.Sidecar = healthcheck.HasExistingSidecars(conf.pod.spec)
 		report.UDP = checkUDPPorts(conf.pod.spec)
+		report.OutboundOnly = conf.outboundOnly()
+		if conf.shutdownCoordination() {
+			report.ShutdownCommandMissing = conf.shutdownCommandMissing()
+		}
 		report.TracingEnabled = conf.pod.meta.Annotations[k8s.ProxyTraceCollectorSvcAddrAnnotation] != "" || conf.nsAnnotations[k8s.ProxyTraceCollectorSvcAddrAnnotation] != ""
 	} else if report.Kind != k8s.Namespace {
 		report.UnsupportedResource = true
@@ -96,12 +128,17 @@ func (r *Report) ResName() string {
 }
 
 // Injectable returns false if the report flags indicate that the workload is on a host network
-// or there is already a sidecar or the resource is not supported or inject is explicitly disabled.
+// or there is already a sidecar or the resource
+// is not supported or inject is explicitly disabled, by annotation or by the injection policy.
 // If false, the second returned value describes the reason.
 func (r *Report) Injectable() (bool, []string) {
 	var reasons []string
 	if r.HostNetwork {
-		reasons = append(reasons, hostNetworkEnabled)
+		if r.OutboundOnly {
+			reasons = append(reasons, outboundOnlyHostNetwork)
+		} else {
+			reasons = append(reasons, hostNetworkEnabled)
+		}
 	}
 	if r.Sidecar {
 		reasons = append(reasons, sidecarExists)
@@ -112,6 +149,9 @@ func (r *Report) Injectable() (bool, []string) {
 	if r.InjectDisabled {
 		reasons = append(reasons, r.InjectDisabledReason)
 	}
+	if r.InjectionPolicyExcluded {
+		reasons = append(reasons, injectionPolicyExcluded)
+	}
 
 	if len(reasons) > 0 {
 		return false, reasons
@@ -132,24 +172,26 @@ func checkUDPPorts(t *v1.PodSpec) bool {
 }
 
 // disabledByAnnotation checks annotations for both workload, namespace and returns
-// if disabled, Inject Disabled reason and the resource where that annotation was present
+// if disabled, Inject Disabled reason and the resource where that annotation was present.
+// The include rules of the injection policy stand in for the absent annotations.
 func (r *Report) disableByAnnotation(conf *ResourceConfig) (bool, string, string) {
 	// truth table of the effects of the inject annotation:
 	//
-	// origin  | namespace | pod      | inject?  | return
-	// ------- | --------- | -------- | -------- | ------
-	// webhook | enabled   | enabled  | yes      | false
-	// webhook | enabled   | ""       | yes      | false
-	// webhook | enabled   | disabled | no       | true
-	// webhook | disabled  | enabled  | yes      | false
-	// webhook | ""        | enabled  | yes      | false
-	// webhook | disabled  | disabled | no       | true
-	// webhook | ""        | disabled | no       | true
-	// webhook | disabled  | ""       | no       | true
-	// webhook | ""        | ""       | no       | true
-	// cli     | n/a       | enabled  | yes      | false
-	// cli     | n/a       | ""       | yes      | false
-	// cli     | n/a       | disabled | no       | true
+	// origin  | namespace | pod      | include rule | inject?  | return
+	// ------- | --------- | -------- | ------------ | -------- | ------
+	// webhook | enabled   | enabled  | n/a          | yes      | false
+	// webhook | enabled   | ""       | n/a          | yes      | false
+	// webhook | enabled   | disabled | n/a          | no       | true
+	// webhook | disabled  | enabled  | n/a          | yes      | false
+	// webhook | ""        | enabled  | n/a          | yes      | false
+	// webhook | disabled  | disabled | n/a          | no       | true
+	// webhook | ""        | disabled | n/a          | no       | true
+	// webhook | disabled  | ""       | n/a          | no       | true
+	// webhook | ""        | ""       | matches      | yes      | false
+	// webhook | ""        | ""       | no match     | no       | true
+	// cli     | n/a       | enabled  | n/a          | yes      | false
+	// cli     | n/a       | ""       | n/a          | yes      | false
+	// cli     | n/a       | disabled | n/a          | no       | true
 
 	podAnnotation := conf.pod.meta.Annotations[k8s.ProxyInjectAnnotation]
 	nsAnnotation := conf.nsAnnotations[k8s.ProxyInjectAnnotation]
@@ -173,6 +215,15 @@ func (r *Report) disableByAnnotation(conf *ResourceConfig) (bool, string, string
 		return false, "", annotationAtNamespace
 	}
 
+	if podAnnotation == "" && nsAnnotation == "" {
+		if include := conf.configs.GetProxy().GetInjectionPolicy().GetInclude(); len(include) > 0 {
+			if conf.matchesInjectionRules(include) {
+				return false, "", annotationAtInjectionPolicy
+			}
+			return true, injectionPolicyNotIncluded, ""
+		}
+	}
+
 	if podAnnotation != k8s.ProxyInjectEnabled {
 		return true, injectEnableAnnotationAbsent, ""
 	}
@@ -37,6 +37,37 @@ func TestInjectable(t *testing.T) {
 			injectable: false,
 			reasons:    []string{hostNetworkEnabled},
 		},
+		{
+			podSpec: &corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  "agent",
+					Ports: []corev1.ContainerPort{{ContainerPort: 8125, Protocol: corev1.ProtocolUDP}},
+				}},
+			},
+			podMeta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					k8s.ProxyInjectAnnotation:       k8s.ProxyInjectEnabled,
+					k8s.ProxyOutboundOnlyAnnotation: "true",
+				},
+			},
+			injectable: true,
+		},
+		{
+			podSpec: &corev1.PodSpec{
+				HostNetwork: true,
+				Containers:  []corev1.Container{{Name: "agent"}},
+			},
+			podMeta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					k8s.ProxyInjectAnnotation: k8s.ProxyInjectEnabled,
+				},
+			},
+			nsAnnotations: map[string]string{
+				k8s.ProxyOutboundOnlyAnnotation: "true",
+			},
+			injectable: false,
+			reasons:    []string{outboundOnlyHostNetwork},
+		},
 		{
 			podSpec: &corev1.PodSpec{
 				Containers: []corev1.Container{
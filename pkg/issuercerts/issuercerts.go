@@ -28,6 +28,16 @@ type IssuerCertData struct {
 
 // FetchIssuerData fetches the issuer data from the linkerd-identitiy-issuer secrets (used for linkerd.io/tls schemed secrets)
 func FetchIssuerData(api kubernetes.Interface, trustAnchors, controlPlaneNamespace string) (*IssuerCertData, error) {
+	return fetchIssuerData(api, trustAnchors, controlPlaneNamespace, true)
+}
+
+// FetchIssuerCrtData is like FetchIssuerData, but doesn't require the issuer
+// key, which is left out of the secret when it's held by an external signer
+func FetchIssuerCrtData(api kubernetes.Interface, trustAnchors, controlPlaneNamespace string) (*IssuerCertData, error) {
+	return fetchIssuerData(api, trustAnchors, controlPlaneNamespace, false)
+}
+
+func fetchIssuerData(api kubernetes.Interface, trustAnchors, controlPlaneNamespace string, requireKey bool) (*IssuerCertData, error) {
 	secret, err := api.CoreV1().Secrets(controlPlaneNamespace).Get(k8s.IdentityIssuerSecretName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -39,7 +49,7 @@ func FetchIssuerData(api kubernetes.Interface, trustAnchors, controlPlaneNamespa
 	}
 
 	key, ok := secret.Data[k8s.IdentityIssuerKeyName]
-	if !ok {
+	if !ok && requireKey {
 		return nil, fmt.Errorf(keyMissingError, k8s.IdentityIssuerKeyName, "issuer key", k8s.IdentityIssuerSecretName, true)
 	}
 
@@ -48,6 +58,17 @@ func FetchIssuerData(api kubernetes.Interface, trustAnchors, controlPlaneNamespa
 
 // FetchExternalIssuerData fetches the issuer data from the linkerd-identitiy-issuer secrets (used for kubernetes.io/tls schemed secrets)
 func FetchExternalIssuerData(api kubernetes.Interface, controlPlaneNamespace string) (*IssuerCertData, error) {
+	return fetchExternalIssuerData(api, controlPlaneNamespace, true)
+}
+
+// FetchExternalIssuerCrtData is like FetchExternalIssuerData, but doesn't
+// require the issuer key, which is left out of the secret when it's held by an
+// external signer
+func FetchExternalIssuerCrtData(api kubernetes.Interface, controlPlaneNamespace string) (*IssuerCertData, error) {
+	return fetchExternalIssuerData(api, controlPlaneNamespace, false)
+}
+
+func fetchExternalIssuerData(api kubernetes.Interface, controlPlaneNamespace string, requireKey bool) (*IssuerCertData, error) {
 	secret, err := api.CoreV1().Secrets(controlPlaneNamespace).Get(k8s.IdentityIssuerSecretName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -64,7 +85,7 @@ func FetchExternalIssuerData(api kubernetes.Interface, controlPlaneNamespace str
 	}
 
 	key, ok := secret.Data[corev1.TLSPrivateKeyKey]
-	if !ok {
+	if !ok && requireKey {
 		return nil, fmt.Errorf(keyMissingError, corev1.TLSPrivateKeyKey, "issuer key", k8s.IdentityIssuerSecretName, true)
 	}
 
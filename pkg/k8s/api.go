@@ -5,7 +5,8 @@ import (
 	"net/http"
 	"time"
 
-	tsclient "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
+	tsclient "github.com/linkerd/linkerd2/controller/gen/client/split/clientset/versioned"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -24,14 +25,15 @@ import (
 var minAPIVersion = [3]int{1, 13, 0}
 
 // KubernetesAPI provides a client for accessing a Kubernetes cluster.
-// TODO: support ServiceProfile ClientSet. A prerequisite is moving the
-// ServiceProfile client code from `./controller` to `./pkg` (#2751). This will
-// also allow making `NewFakeClientSets` private, as KubernetesAPI will support
-// all relevant k8s resources.
+// TODO: move the ServiceProfile and ServerAuthorization client code from
+// `./controller` to `./pkg` (#2751). This will also allow making
+// `NewFakeClientSets` private, as KubernetesAPI will support all relevant k8s
+// resources.
 type KubernetesAPI struct {
 	*rest.Config
 	kubernetes.Interface
 	Apiextensions apiextensionsclient.Interface // for CRDs
+	SpClient      spclient.Interface            // for ServiceProfiles, ServerAuthorizations and ProxyConfigs
 	TsClient      tsclient.Interface
 	DynamicClient dynamic.Interface
 }
@@ -66,6 +68,10 @@ func NewAPI(configPath, kubeContext string, impersonate string, impersonateGroup
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Kubernetes API Extensions clientset: %v", err)
 	}
+	spClient, err := spclient.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Linkerd clientset: %v", err)
+	}
 	tsClient, err := tsclient.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Traffic Split clientset: %v", err)
@@ -79,6 +85,7 @@ func NewAPI(configPath, kubeContext string, impersonate string, impersonateGroup
 		Config:        config,
 		Interface:     clientset,
 		Apiextensions: apiextensions,
+		SpClient:      spClient,
 		TsClient:      tsClient,
 		DynamicClient: dynamicClient,
 	}, nil
@@ -105,6 +105,45 @@ func ServiceProfilesAccess(k8sClient kubernetes.Interface) error {
 	return errors.New("ServiceProfile CRD not found")
 }
 
+// ServerAuthorizationsAccess checks whether the ServerAuthorization CRD is
+// installed on the cluster and the client is authorized to access
+// ServerAuthorizations.
+func ServerAuthorizationsAccess(k8sClient kubernetes.Interface) error {
+	res, err := k8sClient.Discovery().ServerResourcesForGroupVersion(ServerAuthorizationAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	if res.GroupVersion == ServerAuthorizationAPIVersion {
+		for _, apiRes := range res.APIResources {
+			if apiRes.Kind == ServerAuthorizationKind {
+				return ResourceAuthz(k8sClient, "", "list", "policy.linkerd.io", "", "serverauthorizations", "")
+			}
+		}
+	}
+
+	return errors.New("ServerAuthorization CRD not found")
+}
+
+// ProxyConfigsAccess checks whether the ProxyConfig CRD is installed on the
+// cluster and the client is authorized to access ProxyConfigs.
+func ProxyConfigsAccess(k8sClient kubernetes.Interface) error {
+	res, err := k8sClient.Discovery().ServerResourcesForGroupVersion(ProxyConfigAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	if res.GroupVersion == ProxyConfigAPIVersion {
+		for _, apiRes := range res.APIResources {
+			if apiRes.Kind == ProxyConfigKind {
+				return ResourceAuthz(k8sClient, "", "list", "config.linkerd.io", "", "proxyconfigs", "")
+			}
+		}
+	}
+
+	return errors.New("ProxyConfig CRD not found")
+}
+
 // ClusterAccess verifies whether k8sClient is authorized to access all pods in
 // all namespaces in the cluster.
 func ClusterAccess(k8sClient kubernetes.Interface) error {
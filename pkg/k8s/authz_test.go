@@ -93,3 +93,89 @@ resources:
 		t.Fatalf("unexpected error: %s", err)
 	}
 }
+
+func TestServerAuthorizationsAccess(t *testing.T) {
+	fakeResources := []string{`
+kind: APIResourceList
+apiVersion: v1
+groupVersion: policy.linkerd.io/v1alpha1
+resources:
+- name: serverauthorizations
+  singularName: serverauthorization
+  namespaced: true
+  kind: ServerAuthorization
+  verbs:
+  - delete
+  - deletecollection
+  - get
+  - list
+  - patch
+  - create
+  - update
+  - watch
+  shortNames:
+  - saz`}
+
+	api, err := NewFakeAPI(fakeResources...)
+	if err != nil {
+		t.Fatalf("NewFakeAPI error: %s", err)
+	}
+
+	err = ServerAuthorizationsAccess(api)
+	// RBAC SSAR request failed, but the Discovery lookup succeeded
+	if !reflect.DeepEqual(err, errors.New("not authorized to access serverauthorizations.policy.linkerd.io")) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	api, err = NewFakeAPI()
+	if err != nil {
+		t.Fatalf("NewFakeAPI error: %s", err)
+	}
+
+	err = ServerAuthorizationsAccess(api)
+	if err == nil {
+		t.Fatal("expected an error when the ServerAuthorization CRD is not installed")
+	}
+}
+
+func TestProxyConfigsAccess(t *testing.T) {
+	fakeResources := []string{`
+kind: APIResourceList
+apiVersion: v1
+groupVersion: config.linkerd.io/v1alpha1
+resources:
+- name: proxyconfigs
+  singularName: proxyconfig
+  namespaced: true
+  kind: ProxyConfig
+  verbs:
+  - delete
+  - deletecollection
+  - get
+  - list
+  - patch
+  - create
+  - update
+  - watch`}
+
+	api, err := NewFakeAPI(fakeResources...)
+	if err != nil {
+		t.Fatalf("NewFakeAPI error: %s", err)
+	}
+
+	err = ProxyConfigsAccess(api)
+	// RBAC SSAR request failed, but the Discovery lookup succeeded
+	if !reflect.DeepEqual(err, errors.New("not authorized to access proxyconfigs.config.linkerd.io")) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	api, err = NewFakeAPI()
+	if err != nil {
+		t.Fatalf("NewFakeAPI error: %s", err)
+	}
+
+	err = ProxyConfigsAccess(api)
+	if err == nil {
+		t.Fatal("expected an error when the ProxyConfig CRD is not installed")
+	}
+}
@@ -5,13 +5,13 @@ import (
 	"io"
 	"strings"
 
-	tsclient "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
-	tsfake "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
 	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	spfake "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/fake"
+	tsclient "github.com/linkerd/linkerd2/controller/gen/client/split/clientset/versioned"
+	tsfake "github.com/linkerd/linkerd2/controller/gen/client/split/clientset/versioned/fake"
 
-	tsscheme "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned/scheme"
 	spscheme "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/scheme"
+	tsscheme "github.com/linkerd/linkerd2/controller/gen/client/split/clientset/versioned/scheme"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -34,7 +34,7 @@ import (
 
 // NewFakeAPI provides a mock KubernetesAPI backed by hard-coded resources
 func NewFakeAPI(configs ...string) (*KubernetesAPI, error) {
-	client, apiextClient, _, _, _, err := NewFakeClientSets(configs...)
+	client, apiextClient, _, spClient, _, err := NewFakeClientSets(configs...)
 	if err != nil {
 		return nil, err
 	}
@@ -43,13 +43,14 @@ func NewFakeAPI(configs ...string) (*KubernetesAPI, error) {
 		Config:        &rest.Config{},
 		Interface:     client,
 		Apiextensions: apiextClient,
+		SpClient:      spClient,
 	}, nil
 }
 
 // NewFakeAPIFromManifests reads from a slice of readers, each representing a
 // manifest or collection of manifests, and returns a mock KubernetesAPI.
 func NewFakeAPIFromManifests(readers []io.Reader) (*KubernetesAPI, error) {
-	client, apiextClient, _, _, _, err := newFakeClientSetsFromManifests(readers)
+	client, apiextClient, _, spClient, _, err := newFakeClientSetsFromManifests(readers)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +58,7 @@ func NewFakeAPIFromManifests(readers []io.Reader) (*KubernetesAPI, error) {
 	return &KubernetesAPI{
 		Interface:     client,
 		Apiextensions: apiextClient,
+		SpClient:      spClient,
 	}, nil
 }
 
@@ -88,7 +90,7 @@ func NewFakeClientSets(configs ...string) (
 			apiRegObjs = append(apiRegObjs, obj)
 		case "apiresourcelist":
 			discoveryObjs = append(discoveryObjs, obj)
-		case ServiceProfile:
+		case ServiceProfile, strings.ToLower(ServerAuthorizationKind), strings.ToLower(ProxyConfigKind):
 			spObjs = append(spObjs, obj)
 		case TrafficSplit:
 			tsObjs = append(tsObjs, obj)
@@ -0,0 +1,128 @@
+package k8s
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	proxyHandshakeTimeout = 10 * time.Second
+
+	proxyIdentityDisabledEnv = "LINKERD2_PROXY_IDENTITY_DISABLED"
+
+	// maxConcurrentProxyFetches bounds the port-forwards opened concurrently
+	// by FetchProxiesCertificates
+	maxConcurrentProxyFetches = 10
+)
+
+// ProxyCertificates holds the certificate chain presented by the proxy of a
+// pod, or the error that prevented fetching it.
+type ProxyCertificates struct {
+	Pod   corev1.Pod
+	Certs []*x509.Certificate
+	Err   error
+}
+
+// ProxyIdentity returns the TLS identity of the proxy of the given pod, which
+// is derived from its service account.
+func ProxyIdentity(pod corev1.Pod, controlPlaneNamespace, trustDomain string) string {
+	sa := pod.Spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	return fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", sa, pod.GetNamespace(), controlPlaneNamespace, trustDomain)
+}
+
+// IsIdentityDisabled returns whether the proxy of the given meshed pod runs
+// without identity, in which case it has no certificate to present.
+func IsIdentityDisabled(pod corev1.Pod) bool {
+	if pod.GetAnnotations()[IdentityModeAnnotation] == IdentityModeDisabled {
+		return true
+	}
+	for _, container := range pod.Spec.Containers {
+		if container.Name != ProxyContainerName {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name == proxyIdentityDisabledEnv {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FetchProxyCertificates returns the certificate chain that the proxy of the
+// given pod presents for the given identity, from the leaf to the root. It
+// port-forwards to the admin port of the proxy, and stops at the TLS handshake.
+// The chain isn't verified, so that callers can report why it's invalid.
+func FetchProxyCertificates(k8sAPI *KubernetesAPI, pod corev1.Pod, identity string, emitLogs bool) ([]*x509.Certificate, error) {
+	var proxy *corev1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == ProxyContainerName {
+			proxy = &pod.Spec.Containers[i]
+			break
+		}
+	}
+	if proxy == nil {
+		return nil, fmt.Errorf("no %s container found for pod %s", ProxyContainerName, pod.GetName())
+	}
+
+	portForward, err := NewContainerMetricsForward(k8sAPI, pod, *proxy, emitLogs, ProxyAdminPortName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer portForward.Stop()
+	if err = portForward.Init(); err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: proxyHandshakeTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", portForward.AddressAndPort(), &tls.Config{
+		ServerName: identity,
+		// the chain is returned to be verified by the caller
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("the proxy of pod %s didn't present any certificate", pod.GetName())
+	}
+	return certs, nil
+}
+
+// FetchProxiesCertificates fetches the certificate chains presented by the
+// proxies of the given pods with fetch, concurrently, and returns them in the
+// order of the pods. fetch is typically a call to FetchProxyCertificates.
+func FetchProxiesCertificates(pods []corev1.Pod, fetch func(pod corev1.Pod) ([]*x509.Certificate, error)) []ProxyCertificates {
+	results := make([]ProxyCertificates, len(pods))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentProxyFetches)
+	for i := range pods {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(pc *ProxyCertificates, pod corev1.Pod) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			pc.Pod = pod
+			pc.Certs, pc.Err = fetch(pod)
+		}(&results[i], pods[i])
+	}
+	wg.Wait()
+
+	return results
+}
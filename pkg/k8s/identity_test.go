@@ -0,0 +1,132 @@
+package k8s
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProxyIdentity(t *testing.T) {
+	testCases := []struct {
+		sa       string
+		expected string
+	}{
+		{"web", "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"},
+		{"", "default.emojivoto.serviceaccount.identity.linkerd.cluster.local"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.expected, func(t *testing.T) {
+			pod := corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "emojivoto"},
+				Spec:       corev1.PodSpec{ServiceAccountName: tc.sa},
+			}
+			if identity := ProxyIdentity(pod, "linkerd", "cluster.local"); identity != tc.expected {
+				t.Fatalf("Expected identity %q, got %q", tc.expected, identity)
+			}
+		})
+	}
+}
+
+func TestIsIdentityDisabled(t *testing.T) {
+	testCases := []struct {
+		description string
+		pod         corev1.Pod
+		expected    bool
+	}{
+		{
+			description: "identity enabled",
+			pod: corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: ProxyContainerName}},
+				},
+			},
+			expected: false,
+		},
+		{
+			description: "identity mode annotation",
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{IdentityModeAnnotation: IdentityModeDisabled},
+				},
+			},
+			expected: true,
+		},
+		{
+			description: "proxy environment",
+			pod: corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: ProxyContainerName,
+						Env:  []corev1.EnvVar{{Name: "LINKERD2_PROXY_IDENTITY_DISABLED", Value: "disabled"}},
+					}},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.description, func(t *testing.T) {
+			if disabled := IsIdentityDisabled(tc.pod); disabled != tc.expected {
+				t.Fatalf("Expected %t, got %t", tc.expected, disabled)
+			}
+		})
+	}
+}
+
+func TestFetchProxyCertificatesWithoutProxy(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "emojivoto"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "web"}},
+		},
+	}
+
+	_, err := FetchProxyCertificates(&KubernetesAPI{}, pod, "web.emojivoto.serviceaccount.identity.linkerd.cluster.local", false)
+	expected := "no linkerd-proxy container found for pod web-1"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestFetchProxiesCertificates(t *testing.T) {
+	pods := []corev1.Pod{}
+	for i := 0; i < 25; i++ {
+		pods = append(pods, corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("web-%d", i), Namespace: "emojivoto"},
+		})
+	}
+
+	results := FetchProxiesCertificates(pods, func(pod corev1.Pod) ([]*x509.Certificate, error) {
+		if pod.GetName() == "web-3" {
+			return nil, errors.New("connection refused")
+		}
+		return []*x509.Certificate{{Subject: pkix.Name{CommonName: pod.GetName()}}}, nil
+	})
+
+	if len(results) != len(pods) {
+		t.Fatalf("Expected %d results, got %d", len(pods), len(results))
+	}
+	for i, pc := range results {
+		if pc.Pod.GetName() != pods[i].GetName() {
+			t.Fatalf("Expected result %d for pod %s, got %s", i, pods[i].GetName(), pc.Pod.GetName())
+		}
+		if pc.Pod.GetName() == "web-3" {
+			if pc.Err == nil || pc.Err.Error() != "connection refused" {
+				t.Fatalf("Expected error for pod %s, got %v", pc.Pod.GetName(), pc.Err)
+			}
+			continue
+		}
+		if pc.Err != nil || pc.Certs[0].Subject.CommonName != pc.Pod.GetName() {
+			t.Fatalf("Unexpected result for pod %s: %v, %v", pc.Pod.GetName(), pc.Certs, pc.Err)
+		}
+	}
+}
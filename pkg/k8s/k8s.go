@@ -28,6 +28,12 @@ const (
 	ServiceProfileAPIVersion = "linkerd.io/v1alpha2"
 	ServiceProfileKind       = "ServiceProfile"
 
+	ServerAuthorizationAPIVersion = "policy.linkerd.io/v1alpha1"
+	ServerAuthorizationKind       = "ServerAuthorization"
+
+	ProxyConfigAPIVersion = "config.linkerd.io/v1alpha1"
+	ProxyConfigKind       = "ProxyConfig"
+
 	// special case k8s job label, to not conflict with Prometheus' job label
 	l5dJob = "k8s_job"
 )
@@ -74,6 +74,12 @@ const (
 	// issuer credentials will cease to be valid.
 	IdentityIssuerExpiryAnnotation = Prefix + "/identity-issuer-expiry"
 
+	// IdentityIssuanceLifetimeAnnotation can be set on ServiceAccounts and
+	// Namespaces to set the lifetime of the certificates issued to their
+	// proxies (e.g. 1h), up to the cluster-wide maximum. The ServiceAccount's
+	// annotation takes precedence over the Namespace's.
+	IdentityIssuanceLifetimeAnnotation = Prefix + "/identity-issuance-lifetime"
+
 	// ProxyVersionAnnotation indicates the version of the injected data plane
 	// (e.g. v0.1.3).
 	ProxyVersionAnnotation = Prefix + "/proxy-version"
@@ -95,6 +101,10 @@ const (
 	// in service identity.
 	IdentityModeAnnotation = Prefix + "/identity-mode"
 
+	// ProxyModeAnnotation is set on the pods whose proxy is injected in a mode
+	// other than the default one.
+	ProxyModeAnnotation = Prefix + "/proxy-mode"
+
 	/*
 	 * Proxy config annotations
 	 */
@@ -178,6 +188,12 @@ const (
 	// ProxyDisableIdentityAnnotation can be used to disable identity on the injected proxy.
 	ProxyDisableIdentityAnnotation = ProxyConfigAnnotationsPrefix + "/disable-identity"
 
+	// ProxyOutboundOnlyAnnotation can be set to true to inject the proxy in
+	// outbound-only mode, where only the outbound TCP traffic of the pod is
+	// redirected to the proxy, except to the ports the pod uses over UDP. It
+	// isn't supported on the host network.
+	ProxyOutboundOnlyAnnotation = ProxyConfigAnnotationsPrefix + "/outbound-only"
+
 	// ProxyDisableTapAnnotation can be used to disable tap on the injected proxy.
 	ProxyDisableTapAnnotation = ProxyConfigAnnotationsPrefix + "/disable-tap"
 
@@ -195,11 +211,27 @@ const (
 	// configured for the Pod
 	ProxyWaitBeforeExitSecondsAnnotation = ProxyConfigAnnotationsPrefixAlpha + "/proxy-wait-before-exit-seconds"
 
+	// ProxyShutdownCoordinationAnnotation can be set to true on Jobs and
+	// CronJobs so that the proxy exits once their containers complete. The
+	// containers are wrapped by a helper of the proxy image, which signals the
+	// proxy through a shared volume when the wrapped command exits, so every
+	// container must set a command.
+	ProxyShutdownCoordinationAnnotation = ProxyConfigAnnotationsPrefixAlpha + "/shutdown-coordination"
+
 	// ProxyTraceCollectorSvcAccountAnnotation is used to specify the service account
 	// associated with the trace collector. It is used to create the service's
 	// mTLS identity.
 	ProxyTraceCollectorSvcAccountAnnotation = ProxyConfigAnnotationsPrefixAlpha + "/trace-collector-service-account"
 
+	// TopologyModeAnnotation can be set on a Service to make the destination
+	// service take the zone of the client proxy into account when weighting
+	// the Service's endpoints.
+	TopologyModeAnnotation = ProxyConfigAnnotationsPrefixAlpha + "/topology-mode"
+
+	// TopologyModePreferZone is assigned to TopologyModeAnnotation so that
+	// endpoints in the same zone as the client are given a higher weight.
+	TopologyModePreferZone = "prefer-zone"
+
 	// IdentityModeDefault is assigned to IdentityModeAnnotation to
 	// use the control plane's default identity scheme.
 	IdentityModeDefault = "default"
@@ -208,6 +240,10 @@ const (
 	// disable the proxy from participating in automatic identity.
 	IdentityModeDisabled = "disabled"
 
+	// ProxyModeOutboundOnly is assigned to ProxyModeAnnotation on the pods
+	// whose proxy is injected in outbound-only mode.
+	ProxyModeOutboundOnly = "outbound-only"
+
 	/*
 	 * Component Names
 	 */
@@ -234,6 +270,10 @@ const (
 	// IdentityIssuerSecretName is the name of the Secret that stores issuer credentials.
 	IdentityIssuerSecretName = "linkerd-identity-issuer"
 
+	// IdentityDenyListConfigMapName is the name of the ConfigMap that stores the
+	// identities and token audiences the identity controller refuses to certify.
+	IdentityDenyListConfigMapName = "linkerd-identity-deny-list"
+
 	// IdentityIssuerSchemeLinkerd is the issuer secret scheme used by linkerd
 	IdentityIssuerSchemeLinkerd = "linkerd.io/tls"
 
@@ -270,6 +310,105 @@ const (
 	// AdmissionWebhookLabel indicates whether admission webhooks are enabled for a namespace
 	AdmissionWebhookLabel = ProxyConfigAnnotationsPrefix + "/admission-webhooks"
 
+	/*
+	 * Service mirroring
+	 */
+
+	// SvcMirrorPrefix is the prefix common to all labels and annotations
+	// used by the service mirror controller
+	SvcMirrorPrefix = "mirror.linkerd.io"
+
+	// MirrorSecretType is the type of the Secrets that hold the credentials
+	// used to access a remote cluster
+	MirrorSecretType = SvcMirrorPrefix + "/remote-kubeconfig"
+
+	// ConfigKeyName is the key in the remote cluster Secret that holds the
+	// kubeconfig
+	ConfigKeyName = "kubeconfig"
+
+	// RemoteClusterNameLabel is set on the remote cluster Secret and on the
+	// mirrored resources, identifying the cluster they belong to
+	RemoteClusterNameLabel = SvcMirrorPrefix + "/cluster-name"
+
+	// RemoteClusterDomainAnnotation is set on the remote cluster Secret and
+	// indicates the cluster domain of the remote cluster
+	RemoteClusterDomainAnnotation = SvcMirrorPrefix + "/remote-cluster-domain"
+
+	// MirroredResourceLabel indicates that this resource is the mirror of a
+	// resource in a remote cluster
+	MirroredResourceLabel = SvcMirrorPrefix + "/mirrored-service"
+
+	// RemoteResourceVersionAnnotation is the last observed resource version of
+	// the remote Service that a mirrored Service was created from
+	RemoteResourceVersionAnnotation = SvcMirrorPrefix + "/remote-resource-version"
+
+	// RemoteServiceFqName is the fully qualified name of the remote Service
+	// that a mirrored Service was created from
+	RemoteServiceFqName = SvcMirrorPrefix + "/remote-svc-fq-name"
+
+	// GatewayNameAnnotation is set on remote Services to export them,
+	// indicating the name of the gateway Service that routes traffic to them
+	GatewayNameAnnotation = SvcMirrorPrefix + "/gateway-name"
+
+	// GatewayNsAnnotation is set on remote Services to export them,
+	// indicating the namespace of the gateway Service that routes traffic to
+	// them
+	GatewayNsAnnotation = SvcMirrorPrefix + "/gateway-ns"
+
+	// RemoteGatewayNameLabel is set on mirrored Services, identifying the
+	// remote gateway their endpoints point to
+	RemoteGatewayNameLabel = SvcMirrorPrefix + "/remote-gateway-name"
+
+	// RemoteGatewayNsLabel is set on mirrored Services, identifying the
+	// namespace of the remote gateway their endpoints point to
+	RemoteGatewayNsLabel = SvcMirrorPrefix + "/remote-gateway-ns"
+
+	// GatewayPortName is the name of the port on the gateway Service that
+	// accepts incoming traffic from other clusters
+	GatewayPortName = "incoming-port"
+
+	/*
+	 * Canary promotion
+	 */
+
+	// CanaryPrefix is the prefix common to all the annotations used by the
+	// canary promotion controller
+	CanaryPrefix = "canary.linkerd.io"
+
+	// CanaryLeafAnnotation is set on TrafficSplits to have their weights
+	// managed by the canary promotion controller, and indicates the backend
+	// being promoted
+	CanaryLeafAnnotation = CanaryPrefix + "/leaf"
+
+	// CanaryStepWeightAnnotation indicates the fraction of the total weight
+	// of the backends that is moved to the canary after each healthy interval
+	// (e.g. 100m)
+	CanaryStepWeightAnnotation = CanaryPrefix + "/step-weight"
+
+	// CanaryMinSuccessRateAnnotation indicates the success rate under which
+	// the canary is rolled back (e.g. 0.99)
+	CanaryMinSuccessRateAnnotation = CanaryPrefix + "/min-success-rate"
+
+	// CanaryMaxLatencyAnnotation indicates the p99 latency over which the
+	// canary is rolled back (e.g. 500ms)
+	CanaryMaxLatencyAnnotation = CanaryPrefix + "/max-latency-p99"
+
+	// CanaryMinRequestsAnnotation indicates the number of requests the canary
+	// must receive during an interval for its weight to be changed
+	CanaryMinRequestsAnnotation = CanaryPrefix + "/min-requests"
+
+	// CanaryStatusAnnotation is set by the canary promotion controller once
+	// the canary is either promoted or rolled back, after which the
+	// TrafficSplit isn't managed anymore
+	CanaryStatusAnnotation = CanaryPrefix + "/status"
+
+	// CanaryStatusPromoted indicates that the canary receives all the traffic
+	CanaryStatusPromoted = "promoted"
+
+	// CanaryStatusRolledBack indicates that the canary doesn't receive any
+	// traffic anymore, after breaching its SLO
+	CanaryStatusRolledBack = "rolled-back"
+
 	/*
 	 * Mount paths
 	 */
@@ -353,6 +492,12 @@ func IsMeshed(pod *corev1.Pod, controllerNS string) bool {
 	return pod.Labels[ControllerNSLabel] == controllerNS
 }
 
+// IsOutboundOnly returns whether the proxy of a given meshed Pod is injected
+// in outbound-only mode.
+func IsOutboundOnly(pod *corev1.Pod) bool {
+	return pod.Annotations[ProxyModeAnnotation] == ProxyModeOutboundOnly
+}
+
 // IsTapDisabled returns true if the pod has an annotation for explicitly
 // disabling tap
 func IsTapDisabled(pod *corev1.Pod) bool {
@@ -0,0 +1,128 @@
+package multicluster
+
+import (
+	"fmt"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RemoteClusterConfig holds the information required to access a remote
+// cluster whose services are mirrored into the local one.
+type RemoteClusterConfig struct {
+	ClusterName   string
+	ClusterDomain string
+	KubeConfig    []byte
+}
+
+// SecretName returns the name of the Secret that holds the credentials of the
+// given remote cluster.
+func SecretName(clusterName string) string {
+	return fmt.Sprintf("cluster-credentials-%s", clusterName)
+}
+
+// MirroredServiceName returns the name of the local Service that mirrors the
+// given Service of a remote cluster.
+func MirroredServiceName(remoteName, clusterName string) string {
+	return fmt.Sprintf("%s-%s", remoteName, clusterName)
+}
+
+// NewRemoteClusterSecret returns the Secret holding the given remote cluster
+// configuration, to be created in the local cluster.
+func NewRemoteClusterSecret(namespace string, config *RemoteClusterConfig) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecretName(config.ClusterName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				k8s.RemoteClusterNameLabel: config.ClusterName,
+			},
+			Annotations: map[string]string{
+				k8s.RemoteClusterDomainAnnotation: config.ClusterDomain,
+			},
+		},
+		Type: k8s.MirrorSecretType,
+		Data: map[string][]byte{
+			k8s.ConfigKeyName: config.KubeConfig,
+		},
+	}
+}
+
+// ParseRemoteClusterSecret extracts the remote cluster configuration out of
+// the given Secret.
+func ParseRemoteClusterSecret(secret *corev1.Secret) (*RemoteClusterConfig, error) {
+	if secret.Type != k8s.MirrorSecretType {
+		return nil, fmt.Errorf("secret %s/%s is of type %s, expected %s", secret.Namespace, secret.Name, secret.Type, k8s.MirrorSecretType)
+	}
+
+	clusterName, ok := secret.Labels[k8s.RemoteClusterNameLabel]
+	if !ok || clusterName == "" {
+		return nil, fmt.Errorf("secret %s/%s is missing the %s label", secret.Namespace, secret.Name, k8s.RemoteClusterNameLabel)
+	}
+
+	clusterDomain, ok := secret.Annotations[k8s.RemoteClusterDomainAnnotation]
+	if !ok || clusterDomain == "" {
+		return nil, fmt.Errorf("secret %s/%s is missing the %s annotation", secret.Namespace, secret.Name, k8s.RemoteClusterDomainAnnotation)
+	}
+
+	kubeConfig, ok := secret.Data[k8s.ConfigKeyName]
+	if !ok || len(kubeConfig) == 0 {
+		return nil, fmt.Errorf("secret %s/%s is missing the %s key", secret.Namespace, secret.Name, k8s.ConfigKeyName)
+	}
+
+	return &RemoteClusterConfig{
+		ClusterName:   clusterName,
+		ClusterDomain: clusterDomain,
+		KubeConfig:    kubeConfig,
+	}, nil
+}
+
+// RESTConfig returns the client configuration used to access the remote
+// cluster.
+func (c *RemoteClusterConfig) RESTConfig() (*rest.Config, error) {
+	return clientcmd.RESTConfigFromKubeConfig(c.KubeConfig)
+}
+
+// GatewayAddresses returns the external IPs and the incoming port of the
+// given gateway Service. The port is the one named "incoming-port", or the
+// only port of the Service if it exposes a single one.
+func GatewayAddresses(gateway *corev1.Service) ([]string, int32, error) {
+	ips := []string{}
+	for _, ingress := range gateway.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			ips = append(ips, ingress.IP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, 0, fmt.Errorf("gateway %s/%s has no external IPs", gateway.Namespace, gateway.Name)
+	}
+
+	port, err := gatewayPort(gateway)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ips, port, nil
+}
+
+func gatewayPort(gateway *corev1.Service) (int32, error) {
+	for _, port := range gateway.Spec.Ports {
+		if port.Name == k8s.GatewayPortName {
+			return port.Port, nil
+		}
+	}
+	if len(gateway.Spec.Ports) == 1 {
+		return gateway.Spec.Ports[0].Port, nil
+	}
+	if len(gateway.Spec.Ports) == 0 {
+		return 0, fmt.Errorf("gateway %s/%s exposes no ports", gateway.Namespace, gateway.Name)
+	}
+	return 0, fmt.Errorf("gateway %s/%s exposes multiple ports and none is named %s", gateway.Namespace, gateway.Name, k8s.GatewayPortName)
+}
@@ -0,0 +1,136 @@
+package multicluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseRemoteClusterSecret(t *testing.T) {
+	config := &RemoteClusterConfig{
+		ClusterName:   "east",
+		ClusterDomain: "cluster.local",
+		KubeConfig:    []byte("kubeconfig"),
+	}
+
+	t.Run("Parses a secret created by NewRemoteClusterSecret", func(t *testing.T) {
+		secret := NewRemoteClusterSecret("linkerd", config)
+		if secret.Name != "cluster-credentials-east" {
+			t.Fatalf("Expected secret name cluster-credentials-east, got %s", secret.Name)
+		}
+
+		parsed, err := ParseRemoteClusterSecret(secret)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(parsed, config) {
+			t.Fatalf("Expected %+v, got %+v", config, parsed)
+		}
+	})
+
+	for _, tt := range []struct {
+		name   string
+		mutate func(*corev1.Secret)
+	}{
+		{
+			name:   "wrong type",
+			mutate: func(s *corev1.Secret) { s.Type = corev1.SecretTypeOpaque },
+		},
+		{
+			name:   "missing cluster name",
+			mutate: func(s *corev1.Secret) { delete(s.Labels, k8s.RemoteClusterNameLabel) },
+		},
+		{
+			name:   "missing cluster domain",
+			mutate: func(s *corev1.Secret) { delete(s.Annotations, k8s.RemoteClusterDomainAnnotation) },
+		},
+		{
+			name:   "missing kubeconfig",
+			mutate: func(s *corev1.Secret) { delete(s.Data, k8s.ConfigKeyName) },
+		},
+	} {
+		tt := tt // pin
+		t.Run("Fails on "+tt.name, func(t *testing.T) {
+			secret := NewRemoteClusterSecret("linkerd", config)
+			tt.mutate(secret)
+			if _, err := ParseRemoteClusterSecret(secret); err == nil {
+				t.Fatalf("Expected error, got nothing")
+			}
+		})
+	}
+}
+
+func TestGatewayAddresses(t *testing.T) {
+	gateway := func(ports []corev1.ServicePort, ingress []corev1.LoadBalancerIngress) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "gateway-ns"},
+			Spec:       corev1.ServiceSpec{Ports: ports},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{Ingress: ingress},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name         string
+		gateway      *corev1.Service
+		expectedIPs  []string
+		expectedPort int32
+		expectedErr  bool
+	}{
+		{
+			name: "named incoming port",
+			gateway: gateway(
+				[]corev1.ServicePort{{Name: "probe", Port: 4181}, {Name: k8s.GatewayPortName, Port: 4180}},
+				[]corev1.LoadBalancerIngress{{IP: "10.0.0.1"}, {Hostname: "gateway.example.com"}, {IP: "10.0.0.2"}},
+			),
+			expectedIPs:  []string{"10.0.0.1", "10.0.0.2"},
+			expectedPort: 4180,
+		},
+		{
+			name: "single port",
+			gateway: gateway(
+				[]corev1.ServicePort{{Name: "http", Port: 80}},
+				[]corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+			),
+			expectedIPs:  []string{"10.0.0.1"},
+			expectedPort: 80,
+		},
+		{
+			name: "no external IPs",
+			gateway: gateway(
+				[]corev1.ServicePort{{Name: k8s.GatewayPortName, Port: 4180}},
+				[]corev1.LoadBalancerIngress{{Hostname: "gateway.example.com"}},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "ambiguous ports",
+			gateway: gateway(
+				[]corev1.ServicePort{{Name: "a", Port: 1}, {Name: "b", Port: 2}},
+				[]corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+			),
+			expectedErr: true,
+		},
+	} {
+		tt := tt // pin
+		t.Run(tt.name, func(t *testing.T) {
+			ips, port, err := GatewayAddresses(tt.gateway)
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatalf("Expected error, got nothing")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(ips, tt.expectedIPs) || port != tt.expectedPort {
+				t.Fatalf("Expected %v and %d, got %v and %d", tt.expectedIPs, tt.expectedPort, ips, port)
+			}
+		})
+	}
+}
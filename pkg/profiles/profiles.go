@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"text/template"
 	"time"
 
@@ -145,6 +146,13 @@ func ValidateRequestMatch(reqMatch *sp.RequestMatch) error {
 	if reqMatch.PathRegex != "" {
 		matchKindSet = true
 	}
+	if reqMatch.Authority != nil {
+		matchKindSet = true
+		err := validateValueMatch("authority", reqMatch.Authority.Exact, reqMatch.Authority.Regex)
+		if err != nil {
+			return err
+		}
+	}
 
 	if !matchKindSet {
 		return errRequestMatchField
@@ -153,6 +161,20 @@ func ValidateRequestMatch(reqMatch *sp.RequestMatch) error {
 	return nil
 }
 
+// validateValueMatch checks that at most one of exact and regex is set, and
+// that regex compiles.
+func validateValueMatch(kind, exact, regex string) error {
+	if exact != "" && regex != "" {
+		return fmt.Errorf("The %s match cannot have both an exact and a regex value", kind)
+	}
+	if regex != "" {
+		if _, err := regexp.Compile(regex); err != nil {
+			return fmt.Errorf("The %s match has an invalid regex: %s", kind, err)
+		}
+	}
+	return nil
+}
+
 // ValidateResponseMatch validates whether a ServiceProfile ResponseMatch has at
 // least one field set, and sanity checks the Status Range.
 func ValidateResponseMatch(rspMatch *sp.ResponseMatch) error {
@@ -405,6 +405,54 @@ spec:
       method: GET
       pathRegex: /route-1`,
 		},
+		{
+			err: nil,
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      method: GET
+      pathRegex: /route-1
+      authority:
+        regex: 'name\.ns\.svc\.cluster\.local(:\d+)?'`,
+		},
+		{
+			err: errors.New("failed to validate ServiceProfile: error unmarshaling JSON: while decoding JSON: json: unknown field \"header\""),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      all:
+      - method: GET
+      - header:
+          name: x-api-version
+          exact: "2"`,
+		},
+		{
+			err: errors.New("ServiceProfile \"name.ns.svc.cluster.local\" has a route with an invalid condition: The authority match has an invalid regex: error parsing regexp: missing closing ): `web(`"),
+			sp: `apiVersion: linkerd.io/v1alpha2
+kind: ServiceProfile
+metadata:
+  name: name.ns.svc.cluster.local
+  namespace: linkerd-ns
+spec:
+  routes:
+  - name: name-1
+    condition:
+      not:
+        authority:
+          regex: web(`,
+		},
 	}
 
 	for id, exp := range expectations {
@@ -39,6 +39,11 @@ spec:
       #       method: DELETE
       #   - pathRegex: /info.txt
 
+      # Conditions can also check the request authority, by exact value or by
+      # regular expression.
+      # authority:
+      #   exact: '{{.ServiceName}}.{{.ServiceNamespace}}.svc.{{.ClusterDomain}}:80'
+
     # A route may be marked as retryable.  This indicates that requests to this
     # route are always safe to retry and will cause the proxy to retry failed
     # requests on this route whenever possible.
@@ -1,9 +1,12 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
@@ -13,7 +16,7 @@ import (
 
 type (
 	// CA provides a certificate authority for TLS-enabled installs.
-	// Issuing certificates concurrently is not supported.
+	// It's safe to issue certificates concurrently.
 	CA struct {
 		// Cred contains the CA's credentials.
 		Cred Cred
@@ -26,16 +29,6 @@ type (
 		// validity.
 		Validity Validity
 
-		// nextSerialNumber is the serial number of the next certificate to issue.
-		// Serial numbers must not be reused.
-		//
-		// It is assumed there is only one instance of CA and it is assumed that a
-		// given CA object isn't requested to issue certificates concurrently.
-		//
-		// For now we do not attempt to meet CABForum requirements (e.g. regarding
-		// randomness).
-		nextSerialNumber uint64
-
 		// firstCrtExpiration is the time when the first expiration of a certificate
 		// in the trust chain occurs
 		firstCrtExpiration time.Time
@@ -62,6 +55,27 @@ type (
 		ValidFrom *time.Time
 	}
 
+	// CAOptions configures the generation of CAs.
+	CAOptions struct {
+		// KeyAlgorithm is the algorithm of the key generated for the CA.
+		// Defaults to KeyAlgorithmECDSAP256.
+		KeyAlgorithm KeyAlgorithm
+
+		// PermittedDNSDomains constrains the DNS names of the certificates
+		// issued under an intermediate CA, so that a compromised intermediate
+		// can only issue certificates for the identities it's responsible
+		// for. Intermediates aren't name constrained by default.
+		PermittedDNSDomains []string
+
+		// DNSNames are the subject alternative names of an intermediate CA,
+		// which verifiers require instead of its Common Name to check the
+		// name of the CA itself.
+		DNSNames []string
+	}
+
+	// KeyAlgorithm is the algorithm of a generated key.
+	KeyAlgorithm string
+
 	// Issuer implementors signs certificate requests.
 	Issuer interface {
 		IssueEndEntityCrt(*x509.CertificateRequest) (Crt, error)
@@ -85,8 +99,26 @@ const (
 	// verifier; since both are trying to account for clock skew, there is
 	// somewhat of an over-correction.
 	DefaultClockSkewAllowance = 10 * time.Second
+
+	// KeyAlgorithmECDSAP256 generates ECDSA keys on the P-256 curve, which is
+	// the only algorithm supported by the proxies for their own keys.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ecdsa-p256"
+
+	// KeyAlgorithmECDSAP384 generates ECDSA keys on the P-384 curve.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ecdsa-p384"
+
+	// KeyAlgorithmEd25519 generates Ed25519 keys. The proxies don't support
+	// Ed25519 signatures, so these keys are only meant for the roots of tests.
+	KeyAlgorithmEd25519 KeyAlgorithm = "ed25519"
+
+	// serialNumberBits is the size of the random serial numbers of the issued
+	// certificates, which makes collisions negligible without coordination
+	// between concurrent issuances or CA instances.
+	serialNumberBits = 128
 )
 
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), serialNumberBits)
+
 // Finds the time at which the first certificate
 // from the chain will expire
 func findFirstExpiration(cred *Cred) time.Time {
@@ -101,7 +133,7 @@ func findFirstExpiration(cred *Cred) time.Time {
 
 // NewCA initializes a new CA with default settings.
 func NewCA(cred Cred, validity Validity) *CA {
-	return &CA{cred, validity, uint64(1), findFirstExpiration(&cred)}
+	return &CA{cred, validity, findFirstExpiration(&cred)}
 }
 
 func init() {
@@ -112,11 +144,14 @@ func init() {
 // CreateRootCA configures a new root CA with the given settings
 func CreateRootCA(
 	name string,
-	key *ecdsa.PrivateKey,
+	key crypto.Signer,
 	validity Validity,
 ) (*CA, error) {
 	// Configure the root certificate.
-	t := createTemplate(1, &key.PublicKey, validity)
+	t, err := createTemplate(key.Public(), key.Public(), validity)
+	if err != nil {
+		return nil, err
+	}
 	t.Subject = pkix.Name{CommonName: name}
 	t.IsCA = true
 	t.MaxPathLen = -1
@@ -135,9 +170,7 @@ func CreateRootCA(
 
 	// The Crt has an empty TrustChain because it's at the root.
 	cred := validCredOrPanic(key, Crt{Certificate: c})
-	ca := NewCA(cred, validity)
-	ca.nextSerialNumber++ // Because we've already created the root cert.
-	return ca, nil
+	return NewCA(cred, validity), nil
 }
 
 // GenerateKey creates a new P-256 ECDSA private key from the default random
@@ -146,31 +179,73 @@ func GenerateKey() (*ecdsa.PrivateKey, error) {
 	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 }
 
+// GenerateKeyWithAlgorithm creates a new private key of the given algorithm
+// from the default random source. An empty algorithm defaults to
+// KeyAlgorithmECDSAP256.
+func GenerateKeyWithAlgorithm(algorithm KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmECDSAP256:
+		return GenerateKey()
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyAlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm: %s", algorithm)
+	}
+}
+
+// WithLifetime returns a copy of the CA that issues certificates for the given
+// lifetime, still bounded by the expiry of its trust chain.
+func (ca *CA) WithLifetime(lifetime time.Duration) *CA {
+	validity := ca.Validity
+	validity.Lifetime = lifetime
+	return &CA{ca.Cred, validity, ca.firstCrtExpiration}
+}
+
 // GenerateRootCAWithDefaults generates a new root CA with default settings.
 func GenerateRootCAWithDefaults(name string) (*CA, error) {
+	return GenerateRootCA(name, Validity{}, CAOptions{})
+}
+
+// GenerateRootCA generates a new root CA, whose key is generated according to
+// opts. Roots aren't name constrained.
+func GenerateRootCA(name string, validity Validity, opts CAOptions) (*CA, error) {
 	// Generate a new root key.
-	key, err := GenerateKey()
+	key, err := GenerateKeyWithAlgorithm(opts.KeyAlgorithm)
 	if err != nil {
 		return nil, err
 	}
 
-	return CreateRootCA(name, key, Validity{})
+	return CreateRootCA(name, key, validity)
 }
 
-// GenerateCA generates a new intermdiary CA.
+// GenerateCA generates a new intermdiary CA, with the default options.
 func (ca *CA) GenerateCA(name string, maxPathLen int) (*CA, error) {
-	key, err := GenerateKey()
+	return ca.GenerateCAWithOptions(name, maxPathLen, CAOptions{})
+}
+
+// GenerateCAWithOptions generates a new intermdiary CA, whose key and name
+// constraints are configured by opts.
+func (ca *CA) GenerateCAWithOptions(name string, maxPathLen int, opts CAOptions) (*CA, error) {
+	key, err := GenerateKeyWithAlgorithm(opts.KeyAlgorithm)
 	if err != nil {
 		return nil, err
 	}
 
-	t := ca.createTemplate(&key.PublicKey)
+	t, err := ca.createTemplate(key.Public())
+	if err != nil {
+		return nil, err
+	}
 	t.Subject = pkix.Name{CommonName: name}
 	t.IsCA = true
 	t.MaxPathLen = maxPathLen
 	t.MaxPathLenZero = true // 0-values are actually 0
 	t.BasicConstraintsValid = true
 	t.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	t.PermittedDNSDomains = opts.PermittedDNSDomains
+	t.DNSNames = opts.DNSNames
 	crt, err := ca.Cred.SignCrt(t)
 	if err != nil {
 		return nil, err
@@ -209,7 +284,10 @@ func (ca *CA) IssueEndEntityCrt(csr *x509.CertificateRequest) (Crt, error) {
 		return Crt{}, fmt.Errorf("CSR must contain an ECDSA public key: %+v", csr.PublicKey)
 	}
 
-	t := ca.createTemplate(pubkey)
+	t, err := ca.createTemplate(pubkey)
+	if err != nil {
+		return Crt{}, err
+	}
 	t.Issuer = ca.Cred.Crt.Certificate.Subject
 	t.Subject = csr.Subject
 	t.Extensions = csr.Extensions
@@ -225,9 +303,11 @@ func (ca *CA) IssueEndEntityCrt(csr *x509.CertificateRequest) (Crt, error) {
 // createTemplate returns a certificate t for a non-CA certificate with
 // no subject name, no subjectAltNames. The t can then be modified into
 // a (root) CA t or an end-entity t by the caller.
-func (ca *CA) createTemplate(pubkey *ecdsa.PublicKey) *x509.Certificate {
-	c := createTemplate(ca.nextSerialNumber, pubkey, ca.Validity)
-	ca.nextSerialNumber++
+func (ca *CA) createTemplate(pubkey crypto.PublicKey) (*x509.Certificate, error) {
+	c, err := createTemplate(pubkey, ca.Cred.Certificate.PublicKey, ca.Validity)
+	if err != nil {
+		return nil, err
+	}
 	// if our trust chain contains a certificate that expires
 	// sooner than the one we intend to issue, we clamp the
 	// NotAfter time of our newly issued certificate. That ensures
@@ -236,29 +316,24 @@ func (ca *CA) createTemplate(pubkey *ecdsa.PublicKey) *x509.Certificate {
 	if ca.firstCrtExpiration.Before(c.NotAfter) {
 		c.NotAfter = ca.firstCrtExpiration
 	}
-	return c
+	return c, nil
 }
 
 // createTemplate returns a certificate t for a non-CA certificate with
-// no subject name, no subjectAltNames. The t can then be modified into
-// a (root) CA t or an end-entity t by the caller.
+// no subject name, no subjectAltNames, to be signed by the key of
+// issuerKey. The t can then be modified into a (root) CA t or an
+// end-entity t by the caller.
 func createTemplate(
-	serialNumber uint64,
-	k *ecdsa.PublicKey,
+	k crypto.PublicKey,
+	issuerKey crypto.PublicKey,
 	v Validity,
-) *x509.Certificate {
-	// ECDSA is used instead of RSA because ECDSA key generation is
-	// straightforward and fast whereas RSA key generation is extremely slow
-	// and error-prone.
-	//
-	// CA certificates are signed with the same algorithm as end-entity
-	// certificates because they are relatively short-lived, because using one
-	// algorithm minimizes exposure to implementation flaws, and to speed up
-	// signature verification time.
-	//
-	// SHA-256 is used because any larger digest would be truncated to 256 bits
-	// anyway since a P-256 scalar is only 256 bits long.
-	const SignatureAlgorithm = x509.ECDSAWithSHA256
+) (*x509.Certificate, error) {
+	// Serial numbers must not be reused. They're random so that concurrent
+	// issuances don't need to coordinate, as recommended by the CABForum.
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate a serial number: %s", err)
+	}
 
 	if v.ValidFrom == nil {
 		now := time.Now()
@@ -267,8 +342,9 @@ func createTemplate(
 	notBefore, notAfter := v.Window(*v.ValidFrom)
 
 	return &x509.Certificate{
-		SerialNumber:       big.NewInt(int64(serialNumber)),
-		SignatureAlgorithm: SignatureAlgorithm,
+		// serial numbers must be positive
+		SerialNumber:       serialNumber.Add(serialNumber, big.NewInt(1)),
+		SignatureAlgorithm: signatureAlgorithm(issuerKey),
 		NotBefore:          notBefore,
 		NotAfter:           notAfter,
 		PublicKey:          k,
@@ -277,6 +353,36 @@ func createTemplate(
 			x509.ExtKeyUsageServerAuth,
 			x509.ExtKeyUsageClientAuth,
 		},
+	}, nil
+}
+
+// signatureAlgorithm returns the algorithm of the signatures made with the
+// private key of k.
+func signatureAlgorithm(k crypto.PublicKey) x509.SignatureAlgorithm {
+	switch k := k.(type) {
+	case *ecdsa.PublicKey:
+		// ECDSA is used instead of RSA because ECDSA key generation is
+		// straightforward and fast whereas RSA key generation is extremely
+		// slow and error-prone.
+		//
+		// CA certificates are signed with the same algorithm as end-entity
+		// certificates because they are relatively short-lived, because
+		// using one algorithm minimizes exposure to implementation flaws,
+		// and to speed up signature verification time.
+		//
+		// The digest matches the size of the curve, since any larger digest
+		// would be truncated to the size of its scalars anyway.
+		if k.Curve == elliptic.P384() {
+			return x509.ECDSAWithSHA384
+		}
+		return x509.ECDSAWithSHA256
+	case ed25519.PublicKey:
+		return x509.PureEd25519
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA
+	default:
+		// let the x509 package pick the algorithm from the key
+		return x509.UnknownSignatureAlgorithm
 	}
 }
 
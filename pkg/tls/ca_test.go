@@ -1,6 +1,9 @@
 package tls
 
 import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"sync"
 	"testing"
 	"time"
 )
@@ -65,3 +68,230 @@ func TestCaIssuesCertsWithCorrectExpiration(t *testing.T) {
 	}
 
 }
+
+func TestCaWithLifetime(t *testing.T) {
+	validFrom := time.Now().UTC().Round(time.Second)
+	ca, err := getCa(validFrom, time.Hour*48, time.Hour*24)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		desc                   string
+		ca                     *CA
+		expectedCertExpiration time.Time
+	}{
+		{
+			desc:                   "shorter lifetime",
+			ca:                     ca.WithLifetime(time.Hour),
+			expectedCertExpiration: validFrom.Add(time.Hour).Add(DefaultClockSkewAllowance),
+		},
+		{
+			desc:                   "lifetime beyond the issuer expiry",
+			ca:                     ca.WithLifetime(time.Hour * 72),
+			expectedCertExpiration: validFrom.Add(time.Hour * 48).Add(DefaultClockSkewAllowance),
+		},
+		{
+			desc:                   "original lifetime",
+			ca:                     ca,
+			expectedCertExpiration: validFrom.Add(time.Hour * 24).Add(DefaultClockSkewAllowance),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.desc, func(t *testing.T) {
+			crt, err := tc.ca.GenerateEndEntityCred("fake-name")
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if crt.Certificate.NotAfter != tc.expectedCertExpiration {
+				t.Fatalf("Expected cert expiration %v but got %v", tc.expectedCertExpiration, crt.Certificate.NotAfter)
+			}
+		})
+	}
+}
+
+func newIssuer(t testing.TB, algorithm KeyAlgorithm) *CA {
+	root, err := GenerateRootCA("root.linkerd.cluster.local", Validity{}, CAOptions{KeyAlgorithm: algorithm})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	issuer, err := root.GenerateCAWithOptions("identity.linkerd.cluster.local", 0, CAOptions{KeyAlgorithm: algorithm})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return issuer
+}
+
+func newCSR(t testing.TB, name string) *x509.CertificateRequest {
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return &x509.CertificateRequest{
+		Subject:   pkix.Name{CommonName: name},
+		DNSNames:  []string{name},
+		PublicKey: &key.PublicKey,
+	}
+}
+
+func TestCaIssuesCertsConcurrently(t *testing.T) {
+	issuer := newIssuer(t, KeyAlgorithmECDSAP256)
+	csr := newCSR(t, "web.emojivoto.serviceaccount.identity.linkerd.cluster.local")
+
+	const issuances = 100
+	crts := make(chan Crt, issuances)
+	errs := make(chan error, issuances)
+	var wg sync.WaitGroup
+	for i := 0; i < issuances; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			crt, err := issuer.IssueEndEntityCrt(csr)
+			if err != nil {
+				errs <- err
+				return
+			}
+			crts <- crt
+		}()
+	}
+	wg.Wait()
+	close(crts)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	serials := map[string]struct{}{}
+	for crt := range crts {
+		serial := crt.Certificate.SerialNumber
+		if serial.Sign() <= 0 || serial.BitLen() > serialNumberBits+1 {
+			t.Fatalf("Expected a positive serial number of at most %d bits, got %s", serialNumberBits+1, serial)
+		}
+		serials[serial.String()] = struct{}{}
+	}
+	if len(serials) != issuances {
+		t.Fatalf("Expected %d distinct serial numbers, got %d", issuances, len(serials))
+	}
+}
+
+func TestGenerateCAWithKeyAlgorithms(t *testing.T) {
+	name := "web.emojivoto.serviceaccount.identity.linkerd.cluster.local"
+	for _, algorithm := range []KeyAlgorithm{"", KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384, KeyAlgorithmEd25519} {
+		algorithm := algorithm // pin
+		t.Run(string(algorithm), func(t *testing.T) {
+			root, err := GenerateRootCA("root.linkerd.cluster.local", Validity{}, CAOptions{KeyAlgorithm: algorithm})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			// the proxies only support ECDSA issuers, whatever the root
+			issuer, err := root.GenerateCA("identity.linkerd.cluster.local", 0)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			leaf, err := issuer.GenerateEndEntityCred(name)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := leaf.Crt.Verify(root.Cred.Crt.CertPool(), name, time.Time{}); err != nil {
+				t.Fatalf("Expected the leaf to be issued by the root, got %s", err)
+			}
+
+			if _, err := ValidateAndCreateCreds(root.Cred.EncodeCertificatePEM(), root.Cred.EncodePrivateKeyPEM()); err != nil {
+				t.Fatalf("Expected the root credentials to be PEM-encodable, got %s", err)
+			}
+		})
+	}
+
+	_, err := GenerateRootCA("root.linkerd.cluster.local", Validity{}, CAOptions{KeyAlgorithm: "rsa"})
+	expected := "unsupported key algorithm: rsa"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestGenerateCAWithNameConstraints(t *testing.T) {
+	root, err := GenerateRootCAWithDefaults("root.linkerd.cluster.local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		desc      string
+		opts      CAOptions
+		name      string
+		permitted bool
+	}{
+		{
+			desc:      "isn't constrained by default",
+			name:      "web.emojivoto.serviceaccount.identity.other.cluster.local",
+			permitted: true,
+		},
+		{
+			desc:      "uses the permitted domains",
+			opts:      CAOptions{PermittedDNSDomains: []string{"emojivoto.serviceaccount.identity.linkerd.cluster.local"}},
+			name:      "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			permitted: true,
+		},
+		{
+			desc: "rejects names outside of the permitted domains",
+			opts: CAOptions{PermittedDNSDomains: []string{"emojivoto.serviceaccount.identity.linkerd.cluster.local"}},
+			name: "web.books.serviceaccount.identity.linkerd.cluster.local",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.desc, func(t *testing.T) {
+			issuer, err := root.GenerateCAWithOptions("identity.linkerd.cluster.local", 0, tc.opts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			leaf, err := issuer.GenerateEndEntityCred(tc.name)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			err = leaf.Crt.Verify(root.Cred.Crt.CertPool(), tc.name, time.Time{})
+			if tc.permitted && err != nil {
+				t.Fatalf("Expected %s to be permitted, got %s", tc.name, err)
+			}
+			if !tc.permitted {
+				invalid, ok := err.(x509.CertificateInvalidError)
+				if !ok || invalid.Reason != x509.CANotAuthorizedForThisName {
+					t.Fatalf("Expected %s not to be permitted, got %v", tc.name, err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkIssueEndEntityCrt measures the throughput of the issuance of the
+// certificates of the proxies, e.g. when all the pods of a cluster restart at
+// once.
+func BenchmarkIssueEndEntityCrt(b *testing.B) {
+	for _, algorithm := range []KeyAlgorithm{KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384} {
+		issuer := newIssuer(b, algorithm)
+		csr := newCSR(b, "web.emojivoto.serviceaccount.identity.linkerd.cluster.local")
+
+		b.Run(string(algorithm), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := issuer.IssueEndEntityCrt(csr); err != nil {
+					b.Fatalf("Unexpected error: %s", err)
+				}
+			}
+		})
+
+		b.Run(string(algorithm)+"-parallel", func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := issuer.IssueEndEntityCrt(csr); err != nil {
+						b.Fatalf("Unexpected error: %s", err)
+					}
+				}
+			})
+		})
+	}
+}
@@ -3,6 +3,7 @@ package tls
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
@@ -67,11 +68,33 @@ func DecodePEMKey(txt string) (GenericPrivateKey, error) {
 			return nil, err
 		}
 		return privateKeyRSA{k}, nil
+	case "PRIVATE KEY":
+		k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported PKCS#8 private key: %T", k)
+		}
+		return privateKeyEd25519{key}, nil
 	default:
 		return nil, fmt.Errorf("unsupported block type: '%s'", block.Type)
 	}
 }
 
+// pemKeyType returns the type of the PEM block of the given key.
+func pemKeyType(k GenericPrivateKey) string {
+	switch k.(type) {
+	case privateKeyRSA:
+		return "RSA PRIVATE KEY"
+	case privateKeyEd25519:
+		return "PRIVATE KEY"
+	default:
+		return "EC PRIVATE KEY"
+	}
+}
+
 // DecodePEMCertificates parses a string containing PEM-encoded certificates.
 func DecodePEMCertificates(txt string) (certs []*x509.Certificate, err error) {
 	buf := []byte(txt)
@@ -2,7 +2,9 @@ package tls
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -24,8 +26,21 @@ type (
 		*rsa.PrivateKey
 	}
 
-	// GenericPrivateKey represents either an EC or an RSA private key
+	// privateKeyEd25519 wraps an Ed25519 private key
+	privateKeyEd25519 struct {
+		ed25519.PrivateKey
+	}
+
+	// privateKeySigner wraps the signer of a private key that can't be
+	// exported, e.g. because it's held by a hardware security module
+	privateKeySigner struct {
+		crypto.Signer
+	}
+
+	// GenericPrivateKey represents either an EC, an RSA or an Ed25519 private
+	// key, or the private key of an external signer
 	GenericPrivateKey interface {
+		crypto.Signer
 		matchesCertificate(*x509.Certificate) bool
 		marshal() ([]byte, error)
 	}
@@ -64,13 +79,37 @@ func (k privateKeyRSA) marshal() ([]byte, error) {
 	return x509.MarshalPKCS1PrivateKey(k.PrivateKey), nil
 }
 
+func (k privateKeyEd25519) matchesCertificate(c *x509.Certificate) bool {
+	pub, ok := c.PublicKey.(ed25519.PublicKey)
+	return ok && bytes.Equal(pub, k.Public().(ed25519.PublicKey))
+}
+
+func (k privateKeyEd25519) marshal() ([]byte, error) {
+	return x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+}
+
+func (k privateKeySigner) matchesCertificate(c *x509.Certificate) bool {
+	// the public keys of the standard library don't support comparisons in
+	// this version of Go, so their encodings are compared instead
+	pub, err := x509.MarshalPKIXPublicKey(k.Public())
+	if err != nil {
+		return false
+	}
+	crtPub, err := x509.MarshalPKIXPublicKey(c.PublicKey)
+	return err == nil && bytes.Equal(pub, crtPub)
+}
+
+func (k privateKeySigner) marshal() ([]byte, error) {
+	return nil, errors.New("the private key of an external signer can't be exported")
+}
+
 // validCredOrPanic creates a  Cred, panicking if the key does not match the certificate.
-func validCredOrPanic(ecKey *ecdsa.PrivateKey, crt Crt) Cred {
-	k := privateKeyEC{ecKey}
-	if !k.matchesCertificate(crt.Certificate) {
+func validCredOrPanic(key crypto.Signer, crt Crt) Cred {
+	cred, err := NewSignerCred(crt, key)
+	if err != nil {
 		panic("Cert's public key does not match private key")
 	}
-	return Cred{Crt: crt, PrivateKey: k}
+	return *cred
 }
 
 // CertPool returns a CertPool containing this Crt.
@@ -139,7 +178,7 @@ func (cred *Cred) EncodePrivateKeyPEM() string {
 		panic(fmt.Sprintf("Invalid private key: %s", err))
 	}
 
-	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}))
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemKeyType(cred.PrivateKey), Bytes: b}))
 }
 
 // EncodePrivateKeyP8 encodes the provided key to the PKCS#8 binary form.
@@ -192,6 +231,30 @@ func ValidateAndCreateCreds(crt, key string) (*Cred, error) {
 	return &Cred{PrivateKey: k, Crt: *c}, nil
 }
 
+// NewSignerCred creates a Cred whose private key is held by the given signer,
+// e.g. a hardware security module or a remote signing service. It fails if the
+// public key of the signer doesn't match the certificate.
+func NewSignerCred(crt Crt, signer crypto.Signer) (*Cred, error) {
+	var k GenericPrivateKey
+	switch key := signer.(type) {
+	case GenericPrivateKey:
+		k = key
+	case *ecdsa.PrivateKey:
+		k = privateKeyEC{key}
+	case *rsa.PrivateKey:
+		k = privateKeyRSA{key}
+	case ed25519.PrivateKey:
+		k = privateKeyEd25519{key}
+	default:
+		k = privateKeySigner{signer}
+	}
+
+	if !k.matchesCertificate(crt.Certificate) {
+		return nil, errors.New("tls: Public key of the signer and certificate do not match")
+	}
+	return &Cred{PrivateKey: k, Crt: crt}, nil
+}
+
 // ReadPEMCreds reads PEM-encoded credentials from the named files.
 func ReadPEMCreds(keyPath, crtPath string) (*Cred, error) {
 	keyb, err := ioutil.ReadFile(keyPath)
@@ -207,6 +270,17 @@ func ReadPEMCreds(keyPath, crtPath string) (*Cred, error) {
 	return ValidateAndCreateCreds(string(crtb), string(keyb))
 }
 
+// ReadPEMCrt reads PEM-encoded certificates, from leaf to root, from the named
+// file.
+func ReadPEMCrt(crtPath string) (*Crt, error) {
+	crtb, err := ioutil.ReadFile(crtPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodePEMCrt(string(crtb))
+}
+
 // DecodePEMCrt decodes PEM-encoded certificates from leaf to root.
 func DecodePEMCrt(txt string) (*Crt, error) {
 	certs, err := DecodePEMCertificates(txt)
@@ -1,6 +1,7 @@
 package tls
 
 import (
+	"crypto"
 	"crypto/x509"
 	"fmt"
 	"testing"
@@ -15,6 +16,40 @@ func newRoot(t *testing.T) CA {
 	return *root
 }
 
+// opaqueSigner hides the type of the private key of a signer, like the
+// signers of hardware security modules.
+type opaqueSigner struct {
+	crypto.Signer
+}
+
+func TestSignerCred(t *testing.T) {
+	root := newRoot(t)
+	rootTrust := root.Cred.Crt.CertPool()
+
+	cred, err := NewSignerCred(root.Cred.Crt, opaqueSigner{root.Cred.PrivateKey})
+	if err != nil {
+		t.Fatalf("failed to create signer cred: %s", err)
+	}
+	if _, ok := cred.PrivateKey.(privateKeySigner); !ok {
+		t.Fatalf("Expected the private key of an external signer, got %T", cred.PrivateKey)
+	}
+
+	endEntity, err := NewCA(*cred, Validity{}).GenerateEndEntityCred("endentity.test")
+	if err != nil {
+		t.Fatalf("failed to create end entity cred: %s", err)
+	}
+	if err := endEntity.Crt.Verify(rootTrust, "endentity.test", time.Time{}); err != nil {
+		t.Fatalf("Failed to verify certificate signed by the signer: %s", err)
+	}
+
+	other := newRoot(t)
+	_, err = NewSignerCred(root.Cred.Crt, opaqueSigner{other.Cred.PrivateKey})
+	expected := "tls: Public key of the signer and certificate do not match"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q, got %v", expected, err)
+	}
+}
+
 func TestCrtRoundtrip(t *testing.T) {
 	root := newRoot(t)
 	rootTrust := root.Cred.Crt.CertPool()
@@ -7,4 +7,35 @@ if [ -z "${LINKERD2_PROXY_IDENTITY_DISABLED:-}" ]; then
         -name "$LINKERD2_PROXY_IDENTITY_LOCAL_NAME"
 fi
 
-exec /usr/lib/linkerd/linkerd2-proxy
+if [ -z "${LINKERD2_PROXY_SHUTDOWN_SIGNAL_DIR:-}" ]; then
+    exec /usr/lib/linkerd/linkerd2-proxy
+fi
+
+# The containers of a Job's pod are wrapped by linkerd2-proxy-shutdown, which
+# creates a signal file once their command exits. The proxy is shut down once
+# all the wrapped containers have exited, so that the pod can complete.
+/usr/lib/linkerd/linkerd2-proxy &
+proxy=$!
+trap 'kill -TERM "$proxy"' INT TERM
+
+while kill -0 "$proxy" 2>/dev/null; do
+    exited=true
+    for container in $LINKERD2_PROXY_SHUTDOWN_CONTAINERS; do
+        if [ ! -e "$LINKERD2_PROXY_SHUTDOWN_SIGNAL_DIR/$container.exited" ]; then
+            exited=false
+        fi
+    done
+    if [ "$exited" = true ]; then
+        kill -TERM "$proxy"
+        break
+    fi
+    sleep 1
+done
+
+# A trapped signal interrupts wait, so the proxy is awaited until it's drained
+status=0
+wait "$proxy" || status=$?
+while kill -0 "$proxy" 2>/dev/null; do
+    wait "$proxy" || status=$?
+done
+exit "$status"
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"github.com/linkerd/linkerd2/pkg/flags"
+	log "github.com/sirupsen/logrus"
+)
+
+// proxy-shutdown wraps the command of a container of a Job's pod. Once the
+// command exits, it creates a signal file in the volume shared with the proxy
+// container, whose run script shuts the proxy down once the signal files of
+// all the wrapped containers exist.
+func main() {
+	cmd := flag.NewFlagSet("proxy-shutdown", flag.ExitOnError)
+
+	signalFile := cmd.String("signal-file", "", "file created once the command exits, to signal the proxy to shut down")
+	signalOnFailure := cmd.Bool("signal-on-failure", true, "signal the proxy to shut down when the command fails, instead of only when it succeeds")
+
+	flags.ConfigureAndParse(cmd, os.Args[1:])
+
+	args := cmd.Args()
+	if *signalFile == "" || len(args) == 0 {
+		log.Fatal("Usage: proxy-shutdown -signal-file <file> [-signal-on-failure=false] -- <command> [args...]")
+	}
+
+	code := run(args)
+	if code == 0 || *signalOnFailure {
+		if err := ioutil.WriteFile(*signalFile, nil, 0644); err != nil {
+			log.Errorf("Failed to signal the proxy to shut down: %s", err)
+		}
+	}
+	os.Exit(code)
+}
+
+// run runs the command, forwarding the termination signals to it, and returns
+// its exit code
+func run(args []string) int {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		log.Errorf("Failed to start %s: %s", args[0], err)
+		return 127
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP, syscall.SIGQUIT)
+	go func() {
+		for s := range signals {
+			cmd.Process.Signal(s)
+		}
+	}()
+
+	err := cmd.Wait()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return exitErr.ExitCode()
+	}
+	if err != nil {
+		log.Errorf("Failed to wait for %s: %s", args[0], err)
+		return 1
+	}
+	return 0
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
@@ -15,6 +16,7 @@ import (
 	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/flags"
 	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/identity"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/trace"
@@ -35,8 +37,12 @@ func main() {
 	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
 	enforcedHost := cmd.String("enforced-host", "", "regexp describing the allowed values for the Host header; protects from DNS-rebinding attacks")
 	kubeConfigPath := cmd.String("kubeconfig", "", "path to kube config")
+	apiMTLSAddr := cmd.String("api-mtls-addr", "", "address of the mTLS server of the linkerd-controller-api service; requires -identity-service-account")
+	apiServiceAccount := cmd.String("api-service-account", "linkerd-controller", "service account of the linkerd-controller-api service, whose identity is verified by the mTLS client")
+	identityAddr := cmd.String("identity-addr", "", "address of the identity service (defaults to the linkerd-identity service of the controller namespace)")
 
 	traceCollector := flags.AddTraceFlags(cmd)
+	serviceAccount, tokenPath := flags.AddComponentIdentityFlags(cmd)
 
 	flags.ConfigureAndParse(cmd, os.Args[1:])
 
@@ -44,10 +50,6 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to parse API server address: %s", *apiAddr)
 	}
-	client, err := public.NewInternalClient(*controllerNamespace, *apiAddr)
-	if err != nil {
-		log.Fatalf("failed to construct client for API server URL %s", *apiAddr)
-	}
 
 	globalConfig, err := config.Global(pkgK8s.MountPathGlobalConfig)
 	clusterDomain := globalConfig.GetClusterDomain()
@@ -56,6 +58,30 @@ func main() {
 		log.Warnf("failed to load cluster domain from global config: [%s] (falling back to %s)", err, clusterDomain)
 	}
 
+	var client public.APIClient
+	if *apiMTLSAddr != "" {
+		if *serviceAccount == "" {
+			log.Fatal("-api-mtls-addr requires -identity-service-account")
+		}
+		if *identityAddr == "" {
+			*identityAddr = fmt.Sprintf("linkerd-identity.%s.svc.%s:8080", *controllerNamespace, clusterDomain)
+		}
+		creds, err := identity.DialComponentCredentials(context.Background(), *identityAddr, *serviceAccount, *tokenPath, *controllerNamespace, globalConfig.GetIdentityContext())
+		if err != nil {
+			log.Fatalf("failed to obtain the identity of the component: %s", err)
+		}
+		apiIdentity := identity.ComponentName(*apiServiceAccount, *controllerNamespace, globalConfig.GetIdentityContext().GetTrustDomain())
+		client, err = public.NewInternalMTLSClient(*controllerNamespace, *apiMTLSAddr, creds.ClientTLSConfig(apiIdentity))
+		if err != nil {
+			log.Fatalf("failed to construct client for API server URL %s", *apiMTLSAddr)
+		}
+	} else {
+		client, err = public.NewInternalClient(*controllerNamespace, *apiAddr)
+		if err != nil {
+			log.Fatalf("failed to construct client for API server URL %s", *apiAddr)
+		}
+	}
+
 	k8sAPI, err := k8s.NewAPI(*kubeConfigPath, "", "", []string{}, 0)
 	if err != nil {
 		log.Fatalf("failed to construct Kubernetes API client: [%s]", err)